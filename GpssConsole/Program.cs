@@ -1,6 +1,7 @@
 ﻿using System.CommandLine;
 using System.CommandLine.Parsing;
 using System.Text.Json;
+using GpssConsole.models;
 using GpssConsole.utils;
 using PKHeX.Core;
 
@@ -12,20 +13,18 @@ class Program
     {
         Option<String> modeOption = new("--mode")
         {
-            Description = "The mode to start GPSS Console for, legality | legalize",
+            Description = "The mode to start GPSS Console for, legality | legalize | version | capabilities | worker",
             Required = true,
         };
         
         Option<String> pokemonBase64Option = new("--pokemon")
         {
             Description = "The Base64 encoded pokemon data to use for GPSS Console",
-            Required = true,
         };
         
         Option<String> generationOption = new("--generation")
         {
             Description = "The generation of the pokemon to use for GPSS Console",
-            Required = true,
         };
         
         Option<String> versionOption = new("--ver")
@@ -41,9 +40,23 @@ class Program
         rootCommand.Validators.Add(result =>
         {
             String? mode = result.GetValue(modeOption);
-            if (mode is not "legalize" and  not "legality")
+            if (mode is not "legalize" and  not "legality" and not "version" and not "capabilities" and not "worker")
             {
-                result.AddError("--mode must be legalize or legality");
+                result.AddError("--mode must be legalize, legality, version, capabilities or worker");
+            }
+
+            // Worker mode receives the pokemon over stdin instead
+            if (mode is "legalize" or "legality")
+            {
+                if (result.GetValue(pokemonBase64Option) == null)
+                {
+                    result.AddError("--pokemon is required");
+                }
+
+                if (result.GetValue(generationOption) == null)
+                {
+                    result.AddError("--generation is required");
+                }
             }
             
             String? version  = result.GetValue(versionOption);
@@ -58,24 +71,55 @@ class Program
         {
             
             String mode = parseResult.GetRequiredValue(modeOption);
-            String generation =  parseResult.GetRequiredValue(generationOption);
-            String pokemon = parseResult.GetRequiredValue(pokemonBase64Option);
-            // Get the entity context from the generation
-            var ctx = Helpers.EntityContextFromString(generation);
-            // Do the init
-            Helpers.Init();
+            if (mode == "worker")
+            {
+                return Worker.Run();
+            }
 
-            if (mode == "legality")
+            if (mode == "version")
             {
-                var result = Pkhex.LegalityCheck(pokemon, ctx);
+                Console.WriteLine(JsonSerializer.Serialize(Helpers.GetEngineVersion()));
+                return 0;
+            }
 
-                Console.WriteLine(JsonSerializer.Serialize(result));
+            if (mode == "capabilities")
+            {
+                Console.WriteLine(JsonSerializer.Serialize(Helpers.GetCapabilities()));
+                return 0;
+            }
+
+            try
+            {
+                String generation = parseResult.GetRequiredValue(generationOption);
+                String pokemon = parseResult.GetRequiredValue(pokemonBase64Option);
+                // Get the entity context from the generation
+                var ctx = Helpers.EntityContextFromString(generation);
+                // Do the init
+                Helpers.Init();
+
+                if (mode == "legality")
+                {
+                    var result = Pkhex.LegalityCheck(pokemon, ctx);
+
+                    Console.WriteLine(JsonSerializer.Serialize(result));
+                }
+                else
+                {
+                    GameVersion version = Helpers.GameVersionFromString(parseResult.GetRequiredValue(versionOption));
+                    var result = Pkhex.Legalize(pokemon, ctx, version);
+                    Console.WriteLine(JsonSerializer.Serialize(result));
+                }
+            }
+            catch (GpssException e)
+            {
+                Console.WriteLine(JsonSerializer.Serialize(new { error = e.Message, code = e.Code }));
+                return 1;
             }
-            else
+            catch (Exception e)
             {
-                GameVersion version = Helpers.GameVersionFromString(parseResult.GetRequiredValue(versionOption));
-                var result = Pkhex.Legalize(pokemon, ctx, version);
-                Console.WriteLine(JsonSerializer.Serialize(result));
+                Console.Error.WriteLine(e);
+                Console.WriteLine(JsonSerializer.Serialize(new { error = e.Message, code = GpssException.EngineCrash }));
+                return 1;
             }
 
             return 0;
@@ -0,0 +1,12 @@
+namespace GpssConsole.models;
+
+// GpssException is a failure that Local GPSS knows how to report back to the user, the code is machine-readable.
+public class GpssException(string code, string message) : Exception(message)
+{
+    public const string InvalidData = "invalid_data";
+    public const string UnsupportedGeneration = "unsupported_generation";
+    public const string BadVersion = "bad_version";
+    public const string EngineCrash = "engine_crash";
+
+    public string Code { get; } = code;
+}
@@ -29,4 +29,17 @@ public struct AutoLegalizationResult
             ? pokemon.DecryptedPartyData
             : pokemon.DecryptedBoxData);
     }
+}
+
+public struct EngineVersion
+{
+    [JsonPropertyName("pkhex")] public string PKHeX { get; set; }
+    [JsonPropertyName("automod")] public string AutoMod { get; set; }
+}
+
+public struct Capabilities
+{
+    [JsonPropertyName("pkhex")] public string PKHeX { get; set; }
+    [JsonPropertyName("automod")] public string AutoMod { get; set; }
+    [JsonPropertyName("modes")] public string[] Modes { get; set; }
 }
\ No newline at end of file
@@ -0,0 +1,20 @@
+using System.Text.Json.Serialization;
+
+namespace GpssConsole.models;
+
+public struct WorkerRequest
+{
+    [JsonPropertyName("id")] public ulong Id { get; set; }
+    [JsonPropertyName("mode")] public string Mode { get; set; }
+    [JsonPropertyName("pokemon")] public string? Pokemon { get; set; }
+    [JsonPropertyName("generation")] public string? Generation { get; set; }
+    [JsonPropertyName("version")] public string? Version { get; set; }
+}
+
+public struct WorkerResponse
+{
+    [JsonPropertyName("id")] public ulong Id { get; set; }
+    [JsonPropertyName("result")] public object? Result { get; set; }
+    [JsonPropertyName("error")] public string? Error { get; set; }
+    [JsonPropertyName("code")] public string? Code { get; set; }
+}
@@ -1,4 +1,5 @@
 using System.Dynamic;
+using GpssConsole.models;
 using PKHeX.Core;
 using PKHeX.Core.AutoMod;
 
@@ -12,6 +13,29 @@ public static class Helpers
         Legalizer.EnableEasterEggs = false;
     }
 
+    public static EngineVersion GetEngineVersion()
+    {
+        return new EngineVersion
+        {
+            PKHeX = typeof(PKM).Assembly.GetName().Version?.ToString() ?? "unknown",
+            AutoMod = typeof(Legalizer).Assembly.GetName().Version?.ToString() ?? "unknown",
+        };
+    }
+
+    // Modes lists everything GPSS Console can do, so Local GPSS can tell an outdated binary apart.
+    public static readonly string[] Modes = ["legality", "legalize", "version", "capabilities", "ping", "worker"];
+
+    public static Capabilities GetCapabilities()
+    {
+        var version = GetEngineVersion();
+        return new Capabilities
+        {
+            PKHeX = version.PKHeX,
+            AutoMod = version.AutoMod,
+            Modes = Modes,
+        };
+    }
+
     public static EntityContext EntityContextFromString(string generation)
     {
         switch (generation)
@@ -34,17 +58,23 @@ public static class Helpers
                 return EntityContext.Gen8;
             case "9":
                 return EntityContext.Gen9;
+            case "LGPE":
+            case "7.1":
+                return EntityContext.Gen7b;
             case "BDSP":
+            case "8.2":
                 return EntityContext.Gen8b;
             case "PLA":
+            case "9.1":
                 return EntityContext.Gen8a;
             default:
-                return EntityContext.None;
+                throw new GpssException(GpssException.UnsupportedGeneration, $"unsupported generation: {generation}");
         }
     }
 
     public static GameVersion GameVersionFromString(string version)
     {
+        // Versions PKHeX doesn't know fall back to Any like they always have, so legalizing isn't tied to one game.
         if (!Enum.TryParse(version, out GameVersion gameVersion)) return GameVersion.Any;
 
         return gameVersion;
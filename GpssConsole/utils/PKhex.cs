@@ -6,26 +6,20 @@ namespace GpssConsole.utils;
 
 public class Pkhex
 {
-    public static dynamic LegalityCheck(String pokemon, EntityContext? context)
+    public static LegalityCheckReport LegalityCheck(String pokemon, EntityContext? context)
     {
         var pkmn = Helpers.PokemonFromBase64(pokemon, context ?? EntityContext.None);
         if (pkmn == null)
-            return new
-            {
-                error = "not a pokemon!"
-            };
+            throw new GpssException(GpssException.InvalidData, "not a pokemon!");
 
         return new LegalityCheckReport(CheckLegality(pkmn));
     }
 
-    public static dynamic Legalize(String pokemon, EntityContext? context, GameVersion? version)
+    public static AutoLegalizationResult Legalize(String pokemon, EntityContext? context, GameVersion? version)
     {
         var pkmn = Helpers.PokemonFromBase64(pokemon, context ?? EntityContext.None);
         if (pkmn == null)
-            return new
-            {
-                error = "not a pokemon!"
-            };
+            throw new GpssException(GpssException.InvalidData, "not a pokemon!");
 
         var report = CheckLegality(pkmn);
         if (report.Valid)
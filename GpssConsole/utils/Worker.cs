@@ -0,0 +1,96 @@
+using System.Text.Json;
+using GpssConsole.models;
+
+namespace GpssConsole.utils;
+
+// Worker keeps GPSS Console alive and answers requests sent as one JSON object per line on stdin,
+// this way PKHeX and the MGDB only have to be initialised once.
+public static class Worker
+{
+    public static int Run()
+    {
+        Helpers.Init();
+
+        // Let Local GPSS know we're ready to take requests
+        Write(new WorkerResponse { Id = 0, Result = new { ready = true } });
+
+        string? line;
+        while ((line = Console.ReadLine()) != null)
+        {
+            if (string.IsNullOrWhiteSpace(line)) continue;
+
+            WorkerRequest request;
+            try
+            {
+                request = JsonSerializer.Deserialize<WorkerRequest>(line);
+            }
+            catch (JsonException e)
+            {
+                Write(new WorkerResponse
+                    { Id = 0, Error = $"invalid request: {e.Message}", Code = GpssException.InvalidData });
+                continue;
+            }
+
+            Write(Handle(request));
+        }
+
+        return 0;
+    }
+
+    private static WorkerResponse Handle(WorkerRequest request)
+    {
+        var response = new WorkerResponse { Id = request.Id };
+
+        try
+        {
+            switch (request.Mode)
+            {
+                case "ping":
+                    response.Result = new { pong = true };
+                    break;
+                case "version":
+                    response.Result = Helpers.GetEngineVersion();
+                    break;
+                case "capabilities":
+                    response.Result = Helpers.GetCapabilities();
+                    break;
+                case "legality":
+                    response.Result = Pkhex.LegalityCheck(request.Pokemon ?? string.Empty,
+                        Helpers.EntityContextFromString(request.Generation ?? string.Empty));
+                    break;
+                case "legalize":
+                    if (request.Version == null)
+                    {
+                        throw new GpssException(GpssException.BadVersion, "version is required for auto legalization");
+                    }
+
+                    response.Result = Pkhex.Legalize(request.Pokemon ?? string.Empty,
+                        Helpers.EntityContextFromString(request.Generation ?? string.Empty),
+                        Helpers.GameVersionFromString(request.Version));
+                    break;
+                default:
+                    throw new GpssException(GpssException.InvalidData, $"unknown mode: {request.Mode}");
+            }
+        }
+        catch (GpssException e)
+        {
+            response.Result = null;
+            response.Error = e.Message;
+            response.Code = e.Code;
+        }
+        catch (Exception e)
+        {
+            response.Result = null;
+            response.Error = e.Message;
+            response.Code = GpssException.EngineCrash;
+        }
+
+        return response;
+    }
+
+    private static void Write(WorkerResponse response)
+    {
+        Console.Out.WriteLine(JsonSerializer.Serialize(response));
+        Console.Out.Flush();
+    }
+}
@@ -40,8 +40,8 @@ func httpServer(ctx context.Context) *http.Server {
 		r.Mount("/debug", middleware.Profiler())
 	}
 
-	r.Route("/api/v2/gpss", gpss.NewHandler().Route)
-	r.Route("/api/v2/pksm", legality.NewHandler().Route)
+	r.Route("/api/v2/gpss", gpss.NewHandler(legalityChecker).Route)
+	r.Route("/api/v2/pksm", legality.NewHandler(ctx, legalityChecker, &cfg.Legality).Route)
 
 	return &http.Server{
 		Addr:    fmt.Sprintf("%s:%d", cfg.HTTP.ListeningAddr, cfg.HTTP.Port),
@@ -0,0 +1,184 @@
+package checker
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/FlagBrew/local-gpss/internal/database/ent"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/legalitycache"
+	"github.com/FlagBrew/local-gpss/internal/models"
+	"github.com/apex/log"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	defaultCacheSize = 1024
+	// versionRefresh is how often the engine version is re-read, as GpssConsole can be swapped out
+	// while Local GPSS is running (the workers pick up the new binary when they restart).
+	versionRefresh = 5 * time.Minute
+	// versionTimeout is how long a refresh of the engine version can take. The refresh is shared by every
+	// call waiting on it, so it runs on its own rather than on the context of whichever call started it.
+	versionTimeout = 30 * time.Second
+)
+
+// Cached wraps another checker and caches legality check results by the hash of the Pokémon,
+// its generation and the engine version, both in memory and in the database.
+type Cached struct {
+	next   LegalityChecker
+	db     *ent.Client
+	memory *lru
+
+	mu          sync.Mutex
+	version     *models.EngineVersion
+	versionRead time.Time
+	refresh     singleflight.Group
+}
+
+func NewCached(next LegalityChecker, db *ent.Client, size int) *Cached {
+	if size <= 0 {
+		size = defaultCacheSize
+	}
+
+	return &Cached{
+		next:   next,
+		db:     db,
+		memory: newLRU(size),
+	}
+}
+
+func (c *Cached) Check(ctx context.Context, pokemon, generation string) (*models.GpssLegalityCheckReply, error) {
+	logger := log.FromContext(ctx)
+
+	data, err := base64.StdEncoding.DecodeString(pokemon)
+	if err != nil {
+		// Not something we can hash, let the checker deal with it.
+		return c.next.Check(ctx, pokemon, generation)
+	}
+
+	version, err := c.engineVersion(ctx)
+	if err != nil {
+		logger.WithError(err).Warn("failed to get the engine version, skipping the legality cache")
+		return c.next.Check(ctx, pokemon, generation)
+	}
+
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+	key := hash + ":" + generation + ":" + version.String()
+
+	if reply, ok := c.memory.get(key); ok {
+		reply.Cached = true
+		return &reply, nil
+	}
+
+	cached, err := c.db.LegalityCache.Query().Where(
+		legalitycache.Hash(hash),
+		legalitycache.Generation(generation),
+		legalitycache.EngineVersion(version.String()),
+	).First(ctx)
+	if err == nil {
+		reply := models.GpssLegalityCheckReply{Legal: cached.Legal, Report: cached.Report}
+		c.memory.add(key, reply)
+
+		reply.Cached = true
+		return &reply, nil
+	} else if !ent.IsNotFound(err) {
+		logger.WithError(err).Warn("failed to read the legality cache")
+	}
+
+	reply, err := c.next.Check(ctx, pokemon, generation)
+	if err != nil {
+		return nil, err
+	}
+
+	c.memory.add(key, *reply)
+	err = c.db.LegalityCache.Create().
+		SetHash(hash).
+		SetGeneration(generation).
+		SetEngineVersion(version.String()).
+		SetLegal(reply.Legal).
+		SetReport(reply.Report).
+		SetCreatedAt(time.Now()).
+		Exec(ctx)
+	// Two requests for the same Pokémon can race each other, the other one already stored it.
+	if err != nil && !ent.IsConstraintError(err) {
+		logger.WithError(err).Warn("failed to store legality result in the cache")
+	}
+
+	return reply, nil
+}
+
+func (c *Cached) Legalize(ctx context.Context, pokemon, generation, version string) (*models.GpssAutoLegalityReply, error) {
+	return c.next.Legalize(ctx, pokemon, generation, version)
+}
+
+func (c *Cached) Version(ctx context.Context) (*models.EngineVersion, error) {
+	return c.engineVersion(ctx)
+}
+
+// engineVersion returns the current engine version, dropping any cached results from other
+// versions when it changes. The version is fetched without holding the lock, as the workers can be
+// busy for a while, and concurrent refreshes share a single fetch. A call that gives up on waiting
+// leaves the fetch running for the others.
+func (c *Cached) engineVersion(ctx context.Context) (*models.EngineVersion, error) {
+	c.mu.Lock()
+	if c.version != nil && time.Since(c.versionRead) < versionRefresh {
+		v := c.version
+		c.mu.Unlock()
+		return v, nil
+	}
+	c.mu.Unlock()
+
+	refreshed := c.refresh.DoChan("version", func() (any, error) {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), versionTimeout)
+		defer cancel()
+
+		return c.refreshVersion(ctx)
+	})
+
+	select {
+	case res := <-refreshed:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(*models.EngineVersion), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// refreshVersion fetches the engine version and stores it, see engineVersion.
+func (c *Cached) refreshVersion(ctx context.Context) (*models.EngineVersion, error) {
+	v, err := c.next.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.versionRead = time.Now()
+	if c.version != nil && *v == *c.version {
+		v = c.version
+		c.mu.Unlock()
+		return v, nil
+	}
+
+	c.version = v
+	c.memory.purge()
+	c.mu.Unlock()
+
+	version := v.String()
+	deleted, err := c.db.LegalityCache.Delete().Where(legalitycache.EngineVersionNEQ(version)).Exec(ctx)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("failed to remove stale legality cache entries")
+	} else if deleted > 0 {
+		log.FromContext(ctx).WithFields(log.Fields{
+			"engine_version": version,
+			"removed":        deleted,
+		}).Info("engine version changed, cleared the legality cache")
+	}
+
+	return v, nil
+}
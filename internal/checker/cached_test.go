@@ -0,0 +1,88 @@
+package checker
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/FlagBrew/local-gpss/internal/database"
+	"github.com/FlagBrew/local-gpss/internal/database/ent"
+	"github.com/FlagBrew/local-gpss/internal/models"
+)
+
+// slowVersion takes until it's released to tell its version, telling through entered when it's asked.
+type slowVersion struct {
+	*Fake
+	calls   atomic.Int32
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (s *slowVersion) Version(ctx context.Context) (*models.EngineVersion, error) {
+	s.calls.Add(1)
+	s.entered <- struct{}{}
+
+	select {
+	case <-s.release:
+		return s.Fake.Version(ctx)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func newTestDB(t *testing.T, ctx context.Context) *ent.Client {
+	t.Helper()
+
+	cfg := &models.DatabaseConfig{
+		DBType:           "sqlite",
+		ConnectionString: "file:" + filepath.Join(t.TempDir(), "gpss.db") + "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)",
+	}
+
+	db := database.New(ctx, cfg)
+	t.Cleanup(func() { db.Close() })
+
+	database.Migrate(ent.NewContext(ctx, db), cfg, false)
+	return db
+}
+
+// TestCachedVersionShared checks a call giving up on the version doesn't take the refresh down with it,
+// the other calls waiting on it still get the version.
+func TestCachedVersionShared(t *testing.T) {
+	ctx := quiet()
+	slow := &slowVersion{Fake: NewFake(Fixtures{}), entered: make(chan struct{}, 2), release: make(chan struct{})}
+	c := NewCached(slow, newTestDB(t, ctx), 0)
+
+	version := func(ctx context.Context) <-chan error {
+		done := make(chan error, 1)
+		go func() {
+			_, err := c.Version(ctx)
+			done <- err
+		}()
+		return done
+	}
+
+	firstCtx, cancel := context.WithCancel(ctx)
+	first := version(firstCtx)
+	waitFor(t, slow.entered)
+	second := version(ctx)
+
+	cancel()
+	if err := waitFor(t, first); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want %v", err, context.Canceled)
+	}
+
+	close(slow.release)
+	if err := waitFor(t, second); err != nil {
+		t.Errorf("the call still waiting failed: %v", err)
+	}
+
+	if _, err := c.Version(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if calls := slow.calls.Load(); calls != 1 {
+		t.Errorf("version fetched %d times, want once", calls)
+	}
+}
@@ -0,0 +1,63 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/FlagBrew/local-gpss/internal/console"
+	"github.com/FlagBrew/local-gpss/internal/database/ent"
+	"github.com/FlagBrew/local-gpss/internal/models"
+)
+
+// LegalityChecker runs legality checks and auto legalization for base64 encoded Pokémon.
+type LegalityChecker interface {
+	Check(ctx context.Context, pokemon, generation string) (*models.GpssLegalityCheckReply, error)
+	Legalize(ctx context.Context, pokemon, generation, version string) (*models.GpssAutoLegalityReply, error)
+	// Version returns the PKHeX.Core and AutoMod versions the checker is running with.
+	Version(ctx context.Context) (*models.EngineVersion, error)
+}
+
+// New returns the checker for the configured engine, pool is only used by the subprocess engine and may be nil.
+// Checks are limited by an admission queue, and results are cached in db unless the cache has been disabled.
+func New(cfg *models.Config, pool *console.Pool, db *ent.Client) (LegalityChecker, error) {
+	legalityCfg := &cfg.Legality
+	var checker LegalityChecker
+
+	switch legalityCfg.Engine {
+	case "", "subprocess":
+		checker = NewSubprocess(pool, console.BinaryPath(&cfg.GpssConsole))
+	case "remote":
+		if legalityCfg.RemoteURL == "" {
+			return nil, fmt.Errorf("remote_url is required for the remote legality engine")
+		}
+		checker = NewRemote(legalityCfg.RemoteURL)
+	case "fake":
+		fake, err := LoadFake(legalityCfg.Fixtures)
+		if err != nil {
+			return nil, err
+		}
+		checker = fake
+	default:
+		return nil, fmt.Errorf("unknown legality engine: %s", legalityCfg.Engine)
+	}
+
+	concurrency := legalityCfg.MaxConcurrent
+	if concurrency <= 0 && pool != nil {
+		// There's no point running more at once than there are workers to do it.
+		concurrency = pool.Size()
+	}
+
+	depth := legalityCfg.QueueDepth
+	if depth <= 0 {
+		depth = defaultQueueDepth
+	}
+
+	// The queue sits behind the cache, cached results don't need to wait for a turn.
+	checker = NewQueued(checker, concurrency, depth)
+
+	if legalityCfg.DisableCache || db == nil {
+		return checker, nil
+	}
+
+	return NewCached(checker, db, legalityCfg.CacheSize), nil
+}
@@ -0,0 +1,114 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/FlagBrew/local-gpss/internal/console"
+	"github.com/FlagBrew/local-gpss/internal/models"
+)
+
+var ErrNoFixture = &console.Error{Code: console.CodeInvalidData, Message: "no fixture for pokemon"}
+
+// Fixtures maps base64 encoded Pokémon to the replies the fake checker should give for them.
+type Fixtures struct {
+	Version  *models.EngineVersion                    `json:"version"`
+	Check    map[string]models.GpssLegalityCheckReply `json:"check"`
+	Legalize map[string]models.GpssAutoLegalityReply  `json:"legalize"`
+}
+
+// Fake is an in-memory checker that replies from fixtures, it never touches GpssConsole,
+// which makes it suitable for running the handlers on machines without .NET.
+type Fake struct {
+	mu       sync.RWMutex
+	version  models.EngineVersion
+	check    map[string]models.GpssLegalityCheckReply
+	legalize map[string]models.GpssAutoLegalityReply
+}
+
+func NewFake(fixtures Fixtures) *Fake {
+	f := &Fake{
+		check:    map[string]models.GpssLegalityCheckReply{},
+		legalize: map[string]models.GpssAutoLegalityReply{},
+		version:  models.EngineVersion{PKHeX: "fake", AutoMod: "fake"},
+	}
+
+	if fixtures.Version != nil {
+		f.version = *fixtures.Version
+	}
+
+	for pokemon, reply := range fixtures.Check {
+		f.check[pokemon] = reply
+	}
+
+	for pokemon, reply := range fixtures.Legalize {
+		f.legalize[pokemon] = reply
+	}
+
+	return f
+}
+
+// LoadFake creates a fake checker from a JSON fixtures file, an empty path gives a fake with no fixtures.
+func LoadFake(path string) (*Fake, error) {
+	var fixtures Fixtures
+	if path == "" {
+		return NewFake(fixtures), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(data, &fixtures); err != nil {
+		return nil, err
+	}
+
+	return NewFake(fixtures), nil
+}
+
+func (f *Fake) SetCheck(pokemon string, reply models.GpssLegalityCheckReply) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.check[pokemon] = reply
+}
+
+func (f *Fake) SetLegalize(pokemon string, reply models.GpssAutoLegalityReply) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.legalize[pokemon] = reply
+}
+
+func (f *Fake) Check(ctx context.Context, pokemon, generation string) (*models.GpssLegalityCheckReply, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	reply, ok := f.check[pokemon]
+	if !ok {
+		return nil, ErrNoFixture
+	}
+
+	return &reply, nil
+}
+
+func (f *Fake) Legalize(ctx context.Context, pokemon, generation, version string) (*models.GpssAutoLegalityReply, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	reply, ok := f.legalize[pokemon]
+	if !ok {
+		return nil, ErrNoFixture
+	}
+
+	return &reply, nil
+}
+
+func (f *Fake) Version(ctx context.Context) (*models.EngineVersion, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	version := f.version
+	return &version, nil
+}
@@ -0,0 +1,118 @@
+package checker
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/FlagBrew/local-gpss/internal/console"
+	"github.com/FlagBrew/local-gpss/internal/models"
+)
+
+func TestFake(t *testing.T) {
+	ctx := context.Background()
+	pokemon := "cG9rZW1vbg=="
+
+	fixtures := Fixtures{
+		Check:    map[string]models.GpssLegalityCheckReply{pokemon: {Legal: false, Report: []string{"Invalid: moves"}}},
+		Legalize: map[string]models.GpssAutoLegalityReply{pokemon: {Legal: true, Success: true, Ran: true, Pokemon: &pokemon}},
+	}
+	fake := NewFake(fixtures)
+
+	// The fixtures are copied, changing them afterwards doesn't change the fake.
+	delete(fixtures.Check, pokemon)
+
+	check, err := fake.Check(ctx, pokemon, "8")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if check.Legal || !reflect.DeepEqual(check.Report, []string{"Invalid: moves"}) {
+		t.Errorf("check = %+v", check)
+	}
+
+	legalize, err := fake.Legalize(ctx, pokemon, "8", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !legalize.Success || legalize.Pokemon == nil || *legalize.Pokemon != pokemon {
+		t.Errorf("legalize = %+v", legalize)
+	}
+
+	fake.SetCheck(pokemon, models.GpssLegalityCheckReply{Legal: true})
+	if check, err = fake.Check(ctx, pokemon, "8"); err != nil || !check.Legal {
+		t.Errorf("check after SetCheck = %+v, %v", check, err)
+	}
+
+	version, err := fake.Version(ctx)
+	if err != nil || *version != (models.EngineVersion{PKHeX: "fake", AutoMod: "fake"}) {
+		t.Errorf("version = %+v, %v", version, err)
+	}
+}
+
+// TestFakeNoFixture checks a Pokémon without a fixture is rejected the way GpssConsole rejects data it
+// can't read.
+func TestFakeNoFixture(t *testing.T) {
+	fake := NewFake(Fixtures{})
+
+	_, err := fake.Check(context.Background(), "dW5rbm93bg==", "8")
+	if !errors.Is(err, ErrNoFixture) {
+		t.Fatalf("check error = %v, want %v", err, ErrNoFixture)
+	}
+	if code := console.AsError(err).Code; code != console.CodeInvalidData {
+		t.Errorf("check error code = %s, want %s", code, console.CodeInvalidData)
+	}
+
+	fake.SetLegalize("b3RoZXI=", models.GpssAutoLegalityReply{Ran: true})
+	if _, err := fake.Legalize(context.Background(), "dW5rbm93bg==", "8", ""); !errors.Is(err, ErrNoFixture) {
+		t.Errorf("legalize error = %v, want %v", err, ErrNoFixture)
+	}
+}
+
+func TestLoadFake(t *testing.T) {
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "fixtures.json")
+	err := os.WriteFile(valid, []byte(`{
+		"version": {"pkhex": "24.10.1", "automod": "24.10.2"},
+		"check": {"cG9rZW1vbg==": {"legal": true}}
+	}`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	invalid := filepath.Join(dir, "invalid.json")
+	if err = os.WriteFile(invalid, []byte(`{"check": [`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	fake, err := LoadFake(valid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check, err := fake.Check(context.Background(), "cG9rZW1vbg==", "9"); err != nil || !check.Legal {
+		t.Errorf("check = %+v, %v", check, err)
+	}
+
+	version, _ := fake.Version(context.Background())
+	if version.String() != "pkhex-24.10.1+automod-24.10.2" {
+		t.Errorf("version = %s", version)
+	}
+
+	// No fixtures file gives a fake that doesn't know any Pokémon.
+	if fake, err := LoadFake(""); err != nil {
+		t.Error(err)
+	} else if _, err := fake.Check(context.Background(), "cG9rZW1vbg==", "9"); !errors.Is(err, ErrNoFixture) {
+		t.Errorf("check without fixtures = %v, want %v", err, ErrNoFixture)
+	}
+
+	if _, err := LoadFake(invalid); err == nil {
+		t.Error("loaded invalid fixtures")
+	}
+
+	if _, err := LoadFake(filepath.Join(dir, "missing.json")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("missing fixtures error = %v, want %v", err, os.ErrNotExist)
+	}
+}
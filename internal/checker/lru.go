@@ -0,0 +1,68 @@
+package checker
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/FlagBrew/local-gpss/internal/models"
+)
+
+type lruEntry struct {
+	key   string
+	reply models.GpssLegalityCheckReply
+}
+
+// lru is a small fixed size least recently used cache of legality replies.
+type lru struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+func newLRU(size int) *lru {
+	return &lru{
+		size:    size,
+		order:   list.New(),
+		entries: map[string]*list.Element{},
+	}
+}
+
+func (l *lru) get(key string) (models.GpssLegalityCheckReply, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	el, ok := l.entries[key]
+	if !ok {
+		return models.GpssLegalityCheckReply{}, false
+	}
+
+	l.order.MoveToFront(el)
+	return el.Value.(*lruEntry).reply, true
+}
+
+func (l *lru) add(key string, reply models.GpssLegalityCheckReply) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if el, ok := l.entries[key]; ok {
+		el.Value.(*lruEntry).reply = reply
+		l.order.MoveToFront(el)
+		return
+	}
+
+	l.entries[key] = l.order.PushFront(&lruEntry{key: key, reply: reply})
+	if l.order.Len() > l.size {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.entries, oldest.Value.(*lruEntry).key)
+	}
+}
+
+func (l *lru) purge() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.order.Init()
+	l.entries = map[string]*list.Element{}
+}
@@ -0,0 +1,166 @@
+package checker
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/FlagBrew/local-gpss/internal/console"
+	"github.com/FlagBrew/local-gpss/internal/models"
+	"github.com/apex/log"
+)
+
+const (
+	defaultQueueConcurrency = 4
+	defaultQueueDepth       = 32
+)
+
+// Queued wraps another checker and limits how many checks can run at once, up to depth calls can wait
+// for their turn, anything past that is turned away with console.CodeBusy so the caller can retry later.
+type Queued struct {
+	next      LegalityChecker
+	admission chan struct{}
+	running   chan struct{}
+
+	mu          sync.Mutex
+	avgDuration time.Duration
+}
+
+func NewQueued(next LegalityChecker, concurrency, depth int) *Queued {
+	if concurrency <= 0 {
+		concurrency = defaultQueueConcurrency
+	}
+
+	if depth < 0 {
+		depth = defaultQueueDepth
+	}
+
+	return &Queued{
+		next:        next,
+		admission:   make(chan struct{}, concurrency+depth),
+		running:     make(chan struct{}, concurrency),
+		avgDuration: time.Second,
+	}
+}
+
+func (q *Queued) Check(ctx context.Context, pokemon, generation string) (*models.GpssLegalityCheckReply, error) {
+	release, err := q.acquire(ctx, "legality")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return q.next.Check(ctx, pokemon, generation)
+}
+
+func (q *Queued) Legalize(ctx context.Context, pokemon, generation, version string) (*models.GpssAutoLegalityReply, error) {
+	release, err := q.acquire(ctx, "legalize")
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return q.next.Legalize(ctx, pokemon, generation, version)
+}
+
+// Version doesn't go through the queue, it's cheap and needed to decide whether a check is required at all.
+func (q *Queued) Version(ctx context.Context) (*models.EngineVersion, error) {
+	return q.next.Version(ctx)
+}
+
+type startedKey struct{}
+
+// WithStarted has fn called once a call made with the context is let through the queue, for callers that
+// report whether their work is still waiting.
+func WithStarted(ctx context.Context, fn func()) context.Context {
+	return context.WithValue(ctx, startedKey{}, fn)
+}
+
+func (q *Queued) acquire(ctx context.Context, mode string) (func(), error) {
+	logger := log.FromContext(ctx)
+
+	select {
+	case q.admission <- struct{}{}:
+	default:
+		retryAfter := q.retryAfter()
+		logger.WithFields(log.Fields{
+			"mode":        mode,
+			"retry_after": retryAfter,
+		}).Warn("legality queue is full, turning request away")
+		return nil, &console.Error{Code: console.CodeBusy, Message: "legality queue is full, please try again later", RetryAfter: retryAfter}
+	}
+
+	queuedAt := time.Now()
+	select {
+	case q.running <- struct{}{}:
+	default:
+		// Every slot is taken, wait for one to free up.
+		select {
+		case q.running <- struct{}{}:
+		case <-ctx.Done():
+			<-q.admission
+			return nil, ctx.Err()
+		}
+
+		logger.WithFields(log.Fields{
+			"mode":       mode,
+			"queue_wait": time.Since(queuedAt).String(),
+		}).Info("legality work waited in queue")
+	}
+
+	if started, ok := ctx.Value(startedKey{}).(func()); ok {
+		started()
+	}
+
+	startedAt := time.Now()
+	return func() {
+		q.record(time.Since(startedAt))
+		<-q.running
+		<-q.admission
+	}, nil
+}
+
+// record keeps a moving average of how long a call takes, used to estimate the Retry-After.
+func (q *Queued) record(duration time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.avgDuration = (q.avgDuration*9 + duration) / 10
+}
+
+// retryAfter estimates how long it'll take for the current queue to drain.
+func (q *Queued) retryAfter() time.Duration {
+	q.mu.Lock()
+	avg := q.avgDuration
+	q.mu.Unlock()
+
+	batches := math.Ceil(float64(len(q.admission)) / float64(cap(q.running)))
+	estimate := time.Duration(batches * float64(avg)).Round(time.Second)
+	if estimate < time.Second {
+		return time.Second
+	}
+
+	return estimate
+}
+
+// WaitForTurn calls fn until it stops being turned away with console.CodeBusy, sleeping for the
+// suggested Retry-After in between. Meant for background work which is in no rush.
+func WaitForTurn[T any](ctx context.Context, fn func() (T, error)) (T, error) {
+	for {
+		result, err := fn()
+
+		var consoleErr *console.Error
+		if !errors.As(err, &consoleErr) || consoleErr.Code != console.CodeBusy {
+			return result, err
+		}
+
+		select {
+		case <-time.After(max(consoleErr.RetryAfter, time.Second)):
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package checker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/FlagBrew/local-gpss/internal/console"
+	"github.com/FlagBrew/local-gpss/internal/models"
+	"github.com/apex/log"
+	"github.com/apex/log/handlers/discard"
+)
+
+// blocking holds every check until it's released, telling through entered when a check got to it.
+type blocking struct {
+	*Fake
+	entered chan struct{}
+	release chan struct{}
+}
+
+func newBlocking() *blocking {
+	return &blocking{
+		Fake:    NewFake(Fixtures{Check: map[string]models.GpssLegalityCheckReply{"cG9rZW1vbg==": {Legal: true}}}),
+		entered: make(chan struct{}, 8),
+		release: make(chan struct{}),
+	}
+}
+
+func (b *blocking) Check(ctx context.Context, pokemon, generation string) (*models.GpssLegalityCheckReply, error) {
+	b.entered <- struct{}{}
+
+	select {
+	case <-b.release:
+		return b.Fake.Check(ctx, pokemon, generation)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// quiet keeps the queue from logging every call it holds up or turns away.
+func quiet() context.Context {
+	return log.NewContext(context.Background(), &log.Logger{Handler: discard.New(), Level: log.ErrorLevel})
+}
+
+// check runs a check in the background, the result ends up in the returned channel.
+func check(ctx context.Context, q *Queued) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		_, err := q.Check(ctx, "cG9rZW1vbg==", "8")
+		done <- err
+	}()
+	return done
+}
+
+func waitFor[T any](t *testing.T, c <-chan T) T {
+	t.Helper()
+
+	select {
+	case v := <-c:
+		return v
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out")
+		panic("unreachable")
+	}
+}
+
+// waitQueued waits until n calls were let into the queue.
+func waitQueued(t *testing.T, q *Queued, n int) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(q.admission) < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d calls queued, want %d", len(q.admission), n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestQueuedBusy(t *testing.T) {
+	ctx := quiet()
+	b := newBlocking()
+	q := NewQueued(b, 1, 1)
+
+	running := check(ctx, q)
+	waitFor(t, b.entered)
+	waiting := check(ctx, q)
+	waitQueued(t, q, 2)
+
+	_, err := q.Check(ctx, "cG9rZW1vbg==", "8")
+	var consoleErr *console.Error
+	if !errors.As(err, &consoleErr) || consoleErr.Code != console.CodeBusy {
+		t.Fatalf("got %v, want %s", err, console.CodeBusy)
+	}
+
+	// Two calls are ahead and a call takes a second until the queue knows better.
+	if consoleErr.RetryAfter != 2*time.Second {
+		t.Errorf("retry after = %s, want 2s", consoleErr.RetryAfter)
+	}
+
+	close(b.release)
+	for _, done := range []<-chan error{running, waiting} {
+		if err := waitFor(t, done); err != nil {
+			t.Errorf("queued call failed: %v", err)
+		}
+	}
+
+	if len(q.admission) != 0 || len(q.running) != 0 {
+		t.Errorf("%d calls still queued and %d running, want none", len(q.admission), len(q.running))
+	}
+}
+
+func TestQueuedCancelWhileWaiting(t *testing.T) {
+	ctx := quiet()
+	b := newBlocking()
+	q := NewQueued(b, 1, 1)
+
+	running := check(ctx, q)
+	waitFor(t, b.entered)
+
+	var started bool
+	waitCtx, cancel := context.WithCancel(WithStarted(ctx, func() { started = true }))
+	waiting := check(waitCtx, q)
+	waitQueued(t, q, 2)
+
+	cancel()
+	if err := waitFor(t, waiting); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want %v", err, context.Canceled)
+	}
+
+	if started {
+		t.Error("a call that never got its turn was reported as started")
+	}
+
+	// The call that gave up doesn't hold on to its place in the queue.
+	if len(q.admission) != 1 {
+		t.Errorf("%d calls queued, want 1", len(q.admission))
+	}
+	next := check(ctx, q)
+	waitQueued(t, q, 2)
+
+	close(b.release)
+	for _, done := range []<-chan error{running, next} {
+		if err := waitFor(t, done); err != nil {
+			t.Errorf("queued call failed: %v", err)
+		}
+	}
+}
+
+func TestQueuedStarted(t *testing.T) {
+	ctx := quiet()
+	b := newBlocking()
+	q := NewQueued(b, 1, 1)
+
+	running := check(ctx, q)
+	waitFor(t, b.entered)
+
+	started := make(chan struct{})
+	waiting := check(WithStarted(ctx, func() { close(started) }), q)
+	waitQueued(t, q, 2)
+
+	select {
+	case <-started:
+		t.Fatal("a waiting call was reported as started")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(b.release)
+	waitFor(t, started)
+	for _, done := range []<-chan error{running, waiting} {
+		if err := waitFor(t, done); err != nil {
+			t.Errorf("queued call failed: %v", err)
+		}
+	}
+}
+
+func TestWaitForTurn(t *testing.T) {
+	busy := &console.Error{Code: console.CodeBusy, RetryAfter: time.Millisecond}
+
+	t.Run("retries while busy", func(t *testing.T) {
+		calls := 0
+		got, err := WaitForTurn(context.Background(), func() (int, error) {
+			calls++
+			if calls == 1 {
+				return 0, busy
+			}
+			return 42, nil
+		})
+
+		if got != 42 || err != nil || calls != 2 {
+			t.Errorf("got %d, %v after %d calls, want 42 after 2", got, err, calls)
+		}
+	})
+
+	t.Run("other errors", func(t *testing.T) {
+		want := &console.Error{Code: console.CodeTimeout}
+		calls := 0
+		_, err := WaitForTurn(context.Background(), func() (int, error) {
+			calls++
+			return 0, want
+		})
+
+		if !errors.Is(err, want) || calls != 1 {
+			t.Errorf("got %v after %d calls, want %v after 1", err, calls, want)
+		}
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := WaitForTurn(ctx, func() (int, error) { return 0, busy })
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("got %v, want %v", err, context.DeadlineExceeded)
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package checker
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/FlagBrew/local-gpss/internal/console"
+	"github.com/FlagBrew/local-gpss/internal/models"
+)
+
+// Remote sends the checks to the legality endpoints of another Local GPSS instance, so the
+// legality engine can run on a different host.
+type Remote struct {
+	baseURL string
+	client  *http.Client
+}
+
+func NewRemote(baseURL string) *Remote {
+	return &Remote{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client: &http.Client{
+			// Auto legality can take a while.
+			Timeout: 90 * time.Second,
+		},
+	}
+}
+
+func (r *Remote) Check(ctx context.Context, pokemon, generation string) (*models.GpssLegalityCheckReply, error) {
+	var reply models.GpssLegalityCheckReply
+	if err := r.post(ctx, "/api/v2/pksm/legality", pokemon, generation, "", &reply); err != nil {
+		return nil, err
+	}
+
+	return &reply, nil
+}
+
+func (r *Remote) Legalize(ctx context.Context, pokemon, generation, version string) (*models.GpssAutoLegalityReply, error) {
+	var reply models.GpssAutoLegalityReply
+	if err := r.post(ctx, "/api/v2/pksm/legalize", pokemon, generation, version, &reply); err != nil {
+		return nil, err
+	}
+
+	return &reply, nil
+}
+
+func (r *Remote) Version(ctx context.Context) (*models.EngineVersion, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.baseURL+"/api/v2/pksm/version", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var version models.EngineVersion
+	if err = r.do(req, &version); err != nil {
+		return nil, err
+	}
+
+	return &version, nil
+}
+
+func (r *Remote) post(ctx context.Context, path, pokemon, generation, version string, out any) error {
+	data, err := base64.StdEncoding.DecodeString(pokemon)
+	if err != nil {
+		return &console.Error{Code: console.CodeInvalidData, Message: "failed to decode pokemon", Err: err}
+	}
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("pkmn", "pkmn")
+	if err != nil {
+		return err
+	}
+
+	if _, err = part.Write(data); err != nil {
+		return err
+	}
+
+	if err = form.Close(); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.baseURL+path, &body)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	req.Header.Set("generation", generation)
+	if version != "" {
+		req.Header.Set("version", version)
+	}
+
+	return r.do(req, out)
+}
+
+func (r *Remote) do(req *http.Request, out any) error {
+	resp, err := r.client.Do(req)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return err
+		}
+
+		code := console.CodeEngineCrash
+		if os.IsTimeout(err) {
+			code = console.CodeTimeout
+		}
+		return &console.Error{Code: code, Message: "failed to reach remote legality service", Err: err}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		// Pass the classification of the remote instance through where it has one.
+		var errReply models.GpssErrorReply
+		if json.NewDecoder(resp.Body).Decode(&errReply) == nil && errReply.Error != "" && errReply.Code != "" {
+			return &console.Error{Code: console.ErrorCode(errReply.Code), Message: errReply.Error}
+		}
+
+		consoleErr := &console.Error{
+			Code:    console.CodeEngineCrash,
+			Message: fmt.Sprintf("remote legality service returned %d", resp.StatusCode),
+		}
+		if errReply.Error != "" {
+			consoleErr.Err = errors.New(errReply.Error)
+		}
+		return consoleErr
+	}
+
+	if err = json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return &console.Error{Code: console.CodeEngineCrash, Message: "remote legality service returned an invalid reply", Err: err}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package checker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/FlagBrew/local-gpss/internal/console"
+	"github.com/FlagBrew/local-gpss/internal/models"
+)
+
+func TestRemote(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/pksm/version":
+			json.NewEncoder(w).Encode(models.EngineVersion{PKHeX: "1", AutoMod: "2"})
+		case "/api/v2/pksm/legality":
+			file, _, err := r.FormFile("pkmn")
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			data, _ := io.ReadAll(file)
+
+			switch string(data) {
+			case "legal":
+				json.NewEncoder(w).Encode(models.GpssLegalityCheckReply{Legal: r.Header.Get("generation") == "8"})
+			case "busy":
+				w.WriteHeader(http.StatusServiceUnavailable)
+				json.NewEncoder(w).Encode(models.GpssErrorReply{Error: "legality checks are busy", Code: string(console.CodeBusy)})
+			default:
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte("oops"))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	remote := NewRemote(srv.URL + "/")
+
+	version, err := remote.Version(ctx)
+	if err != nil || *version != (models.EngineVersion{PKHeX: "1", AutoMod: "2"}) {
+		t.Errorf("version = %+v, %v", version, err)
+	}
+
+	check, err := remote.Check(ctx, "bGVnYWw=", "8")
+	if err != nil || !check.Legal {
+		t.Errorf("check = %+v, %v", check, err)
+	}
+
+	tests := []struct {
+		name    string
+		pokemon string
+		want    console.ErrorCode
+	}{
+		// The classification of the other instance is passed through.
+		{name: "busy", pokemon: "YnVzeQ==", want: console.CodeBusy},
+		{name: "unclassified failure", pokemon: "b29wcw==", want: console.CodeEngineCrash},
+		{name: "invalid base64", pokemon: "not base64!", want: console.CodeInvalidData},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := remote.Check(ctx, tt.pokemon, "8")
+
+			var consoleErr *console.Error
+			if !errors.As(err, &consoleErr) || consoleErr.Code != tt.want {
+				t.Errorf("error = %v, want code %s", err, tt.want)
+			}
+		})
+	}
+
+	// Legalize isn't served, which is reported like any other failure of the other instance.
+	if _, err := remote.Legalize(ctx, "bGVnYWw=", "8", ""); console.AsError(err).Code != console.CodeEngineCrash {
+		t.Errorf("legalize error = %v, want code %s", err, console.CodeEngineCrash)
+	}
+
+	srv.Close()
+	if _, err := remote.Version(ctx); console.AsError(err).Code != console.CodeEngineCrash {
+		t.Errorf("error with the other instance down = %v, want code %s", err, console.CodeEngineCrash)
+	}
+}
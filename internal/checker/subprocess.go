@@ -0,0 +1,127 @@
+package checker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/FlagBrew/local-gpss/internal/console"
+	"github.com/FlagBrew/local-gpss/internal/models"
+	"github.com/apex/log"
+)
+
+// Subprocess runs the checks with GpssConsole, using the worker pool when one is available.
+type Subprocess struct {
+	pool *console.Pool
+	path string
+}
+
+func NewSubprocess(pool *console.Pool, path string) *Subprocess {
+	return &Subprocess{pool: pool, path: path}
+}
+
+func (s *Subprocess) Check(ctx context.Context, pokemon, generation string) (*models.GpssLegalityCheckReply, error) {
+	return execGpssConsole[models.GpssLegalityCheckReply](ctx, s, models.GpssConsoleArgs{
+		Mode:       "legality",
+		Pokemon:    pokemon,
+		Generation: generation,
+	})
+}
+
+func (s *Subprocess) Legalize(ctx context.Context, pokemon, generation, version string) (*models.GpssAutoLegalityReply, error) {
+	return execGpssConsole[models.GpssAutoLegalityReply](ctx, s, models.GpssConsoleArgs{
+		Mode:       "legalize",
+		Pokemon:    pokemon,
+		Generation: generation,
+		Version:    version,
+	})
+}
+
+func (s *Subprocess) Version(ctx context.Context) (*models.EngineVersion, error) {
+	return execGpssConsole[models.EngineVersion](ctx, s, models.GpssConsoleArgs{
+		Mode: "version",
+	})
+}
+
+func execGpssConsole[T any](ctx context.Context, s *Subprocess, args models.GpssConsoleArgs) (*T, error) {
+	logger := log.FromContext(ctx)
+
+	var output []byte
+	var err error
+	// Prefer the long-running workers, only fall back to spawning a process if there's no pool.
+	if s.pool != nil {
+		output, err = s.pool.Call(ctx, args)
+	} else {
+		output, err = execGpssConsoleOnce(ctx, s.path, args)
+	}
+
+	if err != nil {
+		// The client going away isn't a problem with GpssConsole.
+		if errors.Is(err, context.Canceled) {
+			return nil, err
+		}
+
+		consoleErr := console.AsError(err)
+		if consoleErr.StatusCode() >= http.StatusInternalServerError {
+			logger.WithError(err).Error("GPSS Console call failed")
+		}
+		return nil, consoleErr
+	}
+
+	var t T
+	if err := json.Unmarshal(output, &t); err != nil {
+		return nil, &console.Error{Code: console.CodeEngineCrash, Message: "GPSS Console returned an invalid reply", Err: err}
+	}
+	return &t, nil
+}
+
+func execGpssConsoleOnce(ctx context.Context, path string, args models.GpssConsoleArgs) ([]byte, error) {
+	logger := log.FromContext(ctx)
+
+	// Make sure it exists
+	if _, err := os.Stat(path); err != nil {
+		logger.WithField("path", path).Error("GPSS Console binary is missing from disk, please make sure you grab it from the latest release")
+		return nil, console.ErrBinaryMissing
+	}
+
+	cmdArgs := []string{"--mode", args.Mode}
+	if args.Pokemon != "" {
+		cmdArgs = append(cmdArgs, "--pokemon", args.Pokemon, "--generation", args.Generation)
+	}
+
+	if args.Version != "" {
+		cmdArgs = append(cmdArgs, "--ver", args.Version)
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, path, cmdArgs...)
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		// Failures GpssConsole knows about are written to stdout.
+		var reply struct {
+			Error string            `json:"error"`
+			Code  console.ErrorCode `json:"code"`
+		}
+		if json.Unmarshal(output, &reply) == nil && reply.Error != "" {
+			return nil, &console.Error{Code: reply.Code, Message: reply.Error}
+		}
+
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			err = errors.New(msg)
+		}
+		return nil, &console.Error{Code: console.CodeEngineCrash, Message: "GPSS Console command failed", Err: err}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,77 @@
+package console
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// ErrorCode is the machine-readable reason a call to GpssConsole failed.
+type ErrorCode string
+
+const (
+	CodeInvalidData           ErrorCode = "invalid_data"
+	CodeUnsupportedGeneration ErrorCode = "unsupported_generation"
+	CodeBadVersion            ErrorCode = "bad_version"
+	CodeTimeout               ErrorCode = "timeout"
+	CodeEngineCrash           ErrorCode = "engine_crash"
+	CodeBinaryMissing         ErrorCode = "binary_missing"
+	CodeBusy                  ErrorCode = "busy"
+)
+
+var (
+	ErrBinaryMissing = &Error{Code: CodeBinaryMissing, Message: "GPSS Console binary is missing from disk"}
+	ErrWorkerExited  = &Error{Code: CodeEngineCrash, Message: "GPSS Console worker exited unexpectedly"}
+	ErrTimeout       = &Error{Code: CodeTimeout, Message: "GPSS Console worker timed out"}
+	ErrPoolClosed    = &Error{Code: CodeEngineCrash, Message: "GPSS Console pool is closed"}
+)
+
+// Error is a classified GpssConsole failure, Message is what GpssConsole (or Local GPSS) had to say about it.
+type Error struct {
+	Code    ErrorCode
+	Message string
+	Err     error
+	// RetryAfter is how long the caller should wait before trying again, only set for busy errors.
+	RetryAfter time.Duration
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// StatusCode is the HTTP status code the error should be reported with.
+func (e *Error) StatusCode() int {
+	switch e.Code {
+	case CodeInvalidData:
+		return http.StatusUnprocessableEntity
+	case CodeUnsupportedGeneration, CodeBadVersion:
+		return http.StatusBadRequest
+	case CodeTimeout:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusServiceUnavailable
+	}
+}
+
+// AsError classifies err, anything that isn't already an *Error is treated as the engine being unavailable.
+func AsError(err error) *Error {
+	var consoleErr *Error
+	if errors.As(err, &consoleErr) {
+		return consoleErr
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return &Error{Code: CodeTimeout, Message: "legality check timed out", Err: err}
+	}
+
+	return &Error{Code: CodeEngineCrash, Message: "legality engine failed", Err: err}
+}
@@ -0,0 +1,276 @@
+package console
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/FlagBrew/local-gpss/internal/models"
+	"github.com/apex/log"
+)
+
+const (
+	defaultWorkers = 2
+	defaultTimeout = 30 * time.Second
+	startupTimeout = 2 * time.Minute
+	healthInterval = 30 * time.Second
+	pingTimeout    = 5 * time.Second
+)
+
+// slot holds a worker, a nil worker means it still has to be (re)started.
+type slot struct {
+	id     int
+	worker *worker
+}
+
+// Pool keeps a fixed amount of GpssConsole workers alive so PKHeX only has to be initialised once
+// per process rather than once per request. Workers are handed out one call at a time, restarted
+// when they crash or time out, and pinged in the background to make sure they're still healthy.
+type Pool struct {
+	logger  log.Interface
+	path    string
+	timeout time.Duration
+	slots   []*slot
+	idle    chan *slot
+	done    chan struct{}
+	mu      sync.Mutex
+	closed  bool
+}
+
+func NewPool(ctx context.Context, cfg *models.ConsoleConfig) *Pool {
+	size := cfg.Workers
+	if size <= 0 {
+		size = defaultWorkers
+	}
+
+	timeout := defaultTimeout
+	if cfg.Timeout > 0 {
+		timeout = time.Duration(cfg.Timeout) * time.Second
+	}
+
+	p := &Pool{
+		logger:  log.FromContext(ctx),
+		path:    BinaryPath(cfg),
+		timeout: timeout,
+		idle:    make(chan *slot, size),
+		done:    make(chan struct{}),
+	}
+
+	for i := 0; i < size; i++ {
+		s := &slot{id: i + 1}
+		p.slots = append(p.slots, s)
+		p.idle <- s
+	}
+
+	go func() {
+		<-ctx.Done()
+		p.Close()
+	}()
+
+	return p
+}
+
+// Path returns the location of the GpssConsole binary used by the workers.
+func (p *Pool) Path() string {
+	return p.path
+}
+
+// Size returns the amount of workers in the pool.
+func (p *Pool) Size() int {
+	return len(p.slots)
+}
+
+// Start warms up all the workers in the background and starts the health checks.
+func (p *Pool) Start() {
+	p.logger.WithField("workers", len(p.slots)).Info("starting GPSS Console workers")
+
+	for range p.slots {
+		go func() {
+			s, ok := p.acquire(context.Background())
+			if !ok {
+				return
+			}
+			defer p.release(s)
+
+			if _, err := p.ensure(s); err != nil {
+				p.logger.WithError(err).WithField("worker", s.id).Error("failed to start GPSS Console worker")
+			}
+		}()
+	}
+
+	go p.monitor()
+}
+
+type timeoutKey struct{}
+
+// WithTimeout overrides how long a call made with the context can take before the worker is restarted,
+// for calls that are known to take longer than the rest.
+func WithTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, timeoutKey{}, timeout)
+}
+
+// Call sends the request to the next free worker and returns the raw JSON result.
+func (p *Pool) Call(ctx context.Context, args models.GpssConsoleArgs) (json.RawMessage, error) {
+	s, ok := p.acquire(ctx)
+	if !ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return nil, ErrPoolClosed
+	}
+	defer p.release(s)
+
+	w, err := p.ensure(s)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := p.timeout
+	if t, ok := ctx.Value(timeoutKey{}).(time.Duration); ok && t > 0 {
+		timeout = t
+	}
+
+	resp, err := w.call(ctx, request{
+		Mode:       args.Mode,
+		Pokemon:    args.Pokemon,
+		Generation: args.Generation,
+		Version:    args.Version,
+	}, timeout)
+	if err != nil {
+		var consoleErr *Error
+		if errors.As(err, &consoleErr) && (consoleErr.Code == CodeTimeout || consoleErr.Code == CodeEngineCrash) {
+			p.logger.WithError(err).WithField("worker", s.id).Warn("restarting GPSS Console worker")
+			p.stop(s)
+		}
+		return nil, err
+	}
+
+	if resp.Error != "" {
+		code := resp.Code
+		if code == "" {
+			code = CodeEngineCrash
+		}
+		return nil, &Error{Code: code, Message: resp.Error}
+	}
+
+	return resp.Result, nil
+}
+
+// Close stops all the workers, any calls in progress will fail.
+func (p *Pool) Close() {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.closed = true
+	close(p.done)
+	p.mu.Unlock()
+
+	for _, s := range p.slots {
+		p.stop(s)
+	}
+}
+
+func (p *Pool) acquire(ctx context.Context) (*slot, bool) {
+	select {
+	case s := <-p.idle:
+		return s, true
+	case <-ctx.Done():
+		return nil, false
+	case <-p.done:
+		return nil, false
+	}
+}
+
+func (p *Pool) release(s *slot) {
+	p.idle <- s
+}
+
+// ensure makes sure the slot has a running worker, starting a new one if required.
+func (p *Pool) ensure(s *slot) (*worker, error) {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil, ErrPoolClosed
+	}
+	w := s.worker
+	p.mu.Unlock()
+
+	if w != nil && w.alive() {
+		return w, nil
+	}
+
+	if w != nil {
+		p.logger.WithField("worker", s.id).Warn("GPSS Console worker exited, restarting it")
+	}
+
+	w, err := startWorker(p.path)
+	if err != nil {
+		if errors.Is(err, ErrBinaryMissing) {
+			p.logger.WithField("path", p.path).Error("GPSS Console binary is missing from disk, please make sure you grab it from the latest release")
+		}
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// The pool could have been closed while we were waiting for the worker to start.
+	if p.closed {
+		w.kill()
+		return nil, ErrPoolClosed
+	}
+
+	s.worker = w
+	p.logger.WithField("worker", s.id).Debug("GPSS Console worker started")
+	return w, nil
+}
+
+func (p *Pool) stop(s *slot) {
+	p.mu.Lock()
+	w := s.worker
+	s.worker = nil
+	p.mu.Unlock()
+
+	if w != nil {
+		w.kill()
+	}
+}
+
+func (p *Pool) monitor() {
+	ticker := time.NewTicker(healthInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.done:
+			return
+		case <-ticker.C:
+			p.checkHealth()
+		}
+	}
+}
+
+// checkHealth pings every idle worker, busy workers are skipped as they're clearly doing something.
+func (p *Pool) checkHealth() {
+	for range p.slots {
+		var s *slot
+		select {
+		case s = <-p.idle:
+		default:
+			return
+		}
+
+		if w, err := p.ensure(s); err == nil {
+			if _, err = w.call(context.Background(), request{Mode: "ping"}, pingTimeout); err != nil {
+				p.logger.WithError(err).WithField("worker", s.id).Warn("GPSS Console worker failed health check, restarting it")
+				p.stop(s)
+				p.ensure(s)
+			}
+		}
+
+		p.release(s)
+	}
+}
@@ -0,0 +1,90 @@
+package console
+
+import (
+	"context"
+	_ "embed"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/FlagBrew/local-gpss/internal/models"
+	"github.com/apex/log"
+)
+
+// requiredModes are the modes Local GPSS relies on, a binary missing any of them is outdated.
+var requiredModes = []string{"legality", "legalize", "version", "ping", "worker"}
+
+// samplePokemon is a decrypted Gen 8 Pikachu, it doesn't have to be legal, only readable by PKHeX.
+//
+//go:embed selftest.pk8
+var samplePokemon []byte
+
+// Diagnose runs the startup handshake and self-test against the pool, so a missing, outdated or broken
+// GpssConsole shows up straight away rather than on the first request.
+func Diagnose(ctx context.Context, pool *Pool) *models.ConsoleStatus {
+	logger := log.FromContext(ctx).WithField("path", pool.Path())
+	status := &models.ConsoleStatus{Path: pool.Path()}
+
+	fail := func(err error, msg string) *models.ConsoleStatus {
+		status.Error = msg
+		logger.WithError(err).Error(msg)
+		return status
+	}
+
+	output, err := pool.Call(ctx, models.GpssConsoleArgs{Mode: "capabilities"})
+	if err != nil {
+		switch AsError(err).Code {
+		case CodeBinaryMissing:
+			return fail(err, "GPSS Console binary is missing, please grab it from the latest release or set gpss_console.path")
+		case CodeInvalidData:
+			// Binaries from before the handshake don't know the mode at all.
+			return fail(err, "GPSS Console is outdated, please grab the latest release")
+		default:
+			return fail(err, "GPSS Console handshake failed")
+		}
+	}
+
+	capabilities := &models.ConsoleCapabilities{}
+	if err = json.Unmarshal(output, capabilities); err != nil {
+		return fail(err, "GPSS Console returned an invalid handshake")
+	}
+	status.Capabilities = capabilities
+
+	var missing []string
+	for _, mode := range requiredModes {
+		if !slices.Contains(capabilities.Modes, mode) {
+			missing = append(missing, mode)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fail(fmt.Errorf("missing modes: %s", strings.Join(missing, ", ")), "GPSS Console is outdated, please grab the latest release")
+	}
+
+	logger.WithFields(log.Fields{
+		"pkhex":   capabilities.PKHeX,
+		"automod": capabilities.AutoMod,
+		"modes":   strings.Join(capabilities.Modes, ","),
+	}).Info("GPSS Console handshake complete")
+
+	output, err = pool.Call(ctx, models.GpssConsoleArgs{
+		Mode:       "legality",
+		Pokemon:    base64.StdEncoding.EncodeToString(samplePokemon),
+		Generation: "8",
+	})
+	if err != nil {
+		return fail(err, "GPSS Console self-test failed")
+	}
+
+	var reply models.GpssLegalityCheckReply
+	if err = json.Unmarshal(output, &reply); err != nil || len(reply.Report) == 0 {
+		return fail(err, "GPSS Console self-test returned an invalid report")
+	}
+
+	status.SelfTest = true
+	logger.Info("GPSS Console self-test passed")
+
+	return status
+}
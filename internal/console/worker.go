@@ -0,0 +1,228 @@
+package console
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/FlagBrew/local-gpss/internal/models"
+)
+
+type request struct {
+	ID         uint64 `json:"id"`
+	Mode       string `json:"mode"`
+	Pokemon    string `json:"pokemon,omitempty"`
+	Generation string `json:"generation,omitempty"`
+	Version    string `json:"version,omitempty"`
+}
+
+type response struct {
+	ID     uint64          `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  string          `json:"error"`
+	Code   ErrorCode       `json:"code"`
+}
+
+// BinaryPath returns the configured location of the GpssConsole binary, falling back to the bundled
+// one for the current platform.
+func BinaryPath(cfg *models.ConsoleConfig) string {
+	if cfg.Path != "" {
+		return cfg.Path
+	}
+
+	if runtime.GOOS == "windows" {
+		return "./bin/GpssConsole.exe"
+	}
+
+	return "./bin/GpssConsole"
+}
+
+// worker is a single GpssConsole process running in worker mode, it reads one request per line
+// from stdin and replies with one response per line on stdout.
+type worker struct {
+	cmd       *exec.Cmd
+	stdin     io.WriteCloser
+	stderr    *tail
+	responses chan response
+	exited    chan struct{}
+	stopped   chan struct{}
+	stopOnce  sync.Once
+	nextID    uint64
+}
+
+func startWorker(path string) (*worker, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, ErrBinaryMissing
+	}
+
+	cmd := exec.Command(path, "--mode", "worker")
+	stderr := &tail{}
+	cmd.Stderr = stderr
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	w := &worker{
+		cmd:       cmd,
+		stdin:     stdin,
+		stderr:    stderr,
+		responses: make(chan response, 1),
+		exited:    make(chan struct{}),
+		stopped:   make(chan struct{}),
+	}
+
+	go w.read(stdout)
+
+	// The worker sends a response with an id of 0 once PKHeX has been initialised.
+	timer := time.NewTimer(startupTimeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case resp := <-w.responses:
+			if resp.ID == 0 && resp.Error == "" {
+				return w, nil
+			}
+		case <-w.exited:
+			return nil, w.exitError()
+		case <-timer.C:
+			w.kill()
+			return nil, fmt.Errorf("GPSS Console worker failed to start: %w", ErrTimeout)
+		}
+	}
+}
+
+func (w *worker) read(stdout io.Reader) {
+	defer close(w.exited)
+
+	reader := bufio.NewReader(stdout)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			var resp response
+			if json.Unmarshal(line, &resp) == nil {
+				select {
+				case w.responses <- resp:
+				case <-w.stopped:
+					// Nobody is going to read this anymore, just drain until the process is gone.
+				}
+			}
+		}
+
+		if err != nil {
+			break
+		}
+	}
+
+	// Reap the process so it doesn't linger around as a zombie.
+	w.cmd.Wait()
+}
+
+func (w *worker) call(ctx context.Context, req request, timeout time.Duration) (*response, error) {
+	w.nextID++
+	req.ID = w.nextID
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = w.stdin.Write(append(data, '\n')); err != nil {
+		return nil, ErrWorkerExited
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case resp := <-w.responses:
+			// Responses to calls which were abandoned (context cancelled) can still show up, skip them.
+			if resp.ID != req.ID {
+				continue
+			}
+			return &resp, nil
+		case <-w.exited:
+			return nil, w.exitError()
+		case <-timer.C:
+			return nil, ErrTimeout
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// exitError returns ErrWorkerExited along with whatever the worker printed to stderr before it died.
+func (w *worker) exitError() error {
+	if output := w.stderr.String(); output != "" {
+		return &Error{Code: ErrWorkerExited.Code, Message: ErrWorkerExited.Message, Err: errors.New(output)}
+	}
+
+	return ErrWorkerExited
+}
+
+func (w *worker) alive() bool {
+	select {
+	case <-w.exited:
+		return false
+	default:
+		return true
+	}
+}
+
+func (w *worker) kill() {
+	w.stopOnce.Do(func() {
+		close(w.stopped)
+		w.stdin.Close()
+		if w.cmd.Process != nil {
+			w.cmd.Process.Kill()
+		}
+	})
+}
+
+// tail keeps the last few KB written to it.
+type tail struct {
+	mu  sync.Mutex
+	buf []byte
+}
+
+const tailSize = 2048
+
+func (t *tail) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.buf = append(t.buf, p...)
+	if len(t.buf) > tailSize {
+		t.buf = t.buf[len(t.buf)-tailSize:]
+	}
+
+	return len(p), nil
+}
+
+func (t *tail) String() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return string(bytes.TrimSpace(t.buf))
+}
@@ -3,6 +3,9 @@ package database
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
 
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
@@ -10,7 +13,6 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	_ "modernc.org/sqlite"
 
-	"entgo.io/ent/dialect/sql/schema"
 	"github.com/FlagBrew/local-gpss/internal/database/ent"
 	"github.com/FlagBrew/local-gpss/internal/models"
 	"github.com/apex/log"
@@ -18,50 +20,55 @@ import (
 )
 
 func New(ctx context.Context, cfg *models.DatabaseConfig) *ent.Client {
-	logger := log.FromContext(ctx)
-	var drv *entsql.Driver
+	drv, _, err := open(ctx, cfg)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Fatal("failed to connect to the database")
+		return nil
+	}
+
+	return ent.NewClient(ent.Driver(drv))
+}
 
+// open connects to the database. The returned func closes everything the driver holds on to, which the
+// driver itself doesn't do for the postgres pool.
+func open(ctx context.Context, cfg *models.DatabaseConfig) (*entsql.Driver, func(), error) {
 	switch cfg.DBType {
 	case "postgres":
 		poolCfg, err := pgxpool.ParseConfig(cfg.ConnectionString)
 		if err != nil {
-			logger.WithError(err).Fatal("failed to parse connection string")
-			return nil
+			return nil, nil, fmt.Errorf("failed to parse connection string: %w", err)
 		}
 		pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
 		if err != nil {
-			logger.WithError(err).Fatal("failed to connect to postgres")
-			return nil
+			return nil, nil, fmt.Errorf("failed to connect to postgres: %w", err)
 		}
-		db := stdlib.OpenDBFromPool(pool)
-		drv = entsql.OpenDB(dialect.Postgres, db)
 
-		break
+		drv := entsql.OpenDB(dialect.Postgres, stdlib.OpenDBFromPool(pool))
+		return drv, func() { drv.Close(); pool.Close() }, nil
 	case "mysql":
 		db, err := sql.Open(dialect.MySQL, cfg.ConnectionString)
 		if err != nil {
-			logger.WithError(err).Fatal("failed to connect to mysql")
-			return nil
+			return nil, nil, fmt.Errorf("failed to connect to mysql: %w", err)
 		}
 
-		drv = entsql.OpenDB(dialect.MySQL, db)
-		break
+		drv := entsql.OpenDB(dialect.MySQL, db)
+		return drv, func() { drv.Close() }, nil
 	case "sqlite":
-		db, err := sql.Open(cfg.DBType, cfg.ConnectionString)
+		db, err := sql.Open(cfg.DBType, sqliteDSN(cfg.ConnectionString))
 		if err != nil {
-			logger.WithError(err).Fatal("failed to connect to sqlite")
-			return nil
+			return nil, nil, fmt.Errorf("failed to connect to sqlite: %w", err)
 		}
-		drv = entsql.OpenDB(dialect.SQLite, db)
-		break
+
+		drv := entsql.OpenDB(dialect.SQLite, db)
+		return drv, func() { drv.Close() }, nil
 	default:
-		return nil
+		return nil, nil, fmt.Errorf("unsupported database type %q", cfg.DBType)
 	}
-
-	return ent.NewClient(ent.Driver(drv))
 }
 
-func Migrate(ctx context.Context) {
+// Migrate applies the pending migrations and then updates the stored data to match. Migrations that can
+// lose data are refused unless approveDestructive is set.
+func Migrate(ctx context.Context, cfg *models.DatabaseConfig, approveDestructive bool) {
 	logger := log.FromContext(ctx)
 	logger.Info("initiating database schema migration")
 	db := ent.FromContext(ctx)
@@ -70,12 +77,37 @@ func Migrate(ctx context.Context) {
 		return
 	}
 
-	if err := db.Schema.Create(
-		ctx,
-		schema.WithDropIndex(true),
-		schema.WithDropColumn(true),
-	); err != nil {
-		logger.WithError(err).Fatal("failed to create schema")
+	m, err := newMigrator(ctx, cfg)
+	if err != nil {
+		logger.WithError(err).Fatal("failed to prepare the migrations")
+	}
+
+	err = m.up(ctx, approveDestructive)
+	m.close()
+
+	var destructive *DestructiveError
+	if errors.As(err, &destructive) {
+		logger.WithField("changes", strings.Join(destructive.Changes, "; ")).Fatal("refusing to apply migrations that can lose data, back up the database and approve them with --approve-destructive")
+	} else if err != nil {
+		logger.WithError(err).Fatal("failed to migrate schema")
+	}
+
+	if cfg.DBType == "sqlite" {
+		if err := normalizeSQLiteTimes(ctx, db); err != nil {
+			logger.WithError(err).Fatal("failed to rewrite stored times")
+		}
+	}
+
+	if err := normalizePokemonGenerations(ctx, db); err != nil {
+		logger.WithError(err).Fatal("failed to normalize pokemon generations")
+	}
+
+	if err := convertBase64Pokemon(ctx, db); err != nil {
+		logger.WithError(err).Fatal("failed to convert stored pokemon")
+	}
+
+	if err := backfillContentHashes(ctx, db); err != nil {
+		logger.WithError(err).Fatal("failed to hash stored pokemon")
 	}
 	logger.Info("database schema migration complete")
 }
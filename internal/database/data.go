@@ -0,0 +1,89 @@
+package database
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/FlagBrew/local-gpss/internal/database/ent"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/generation"
+	"github.com/FlagBrew/local-gpss/internal/pkm"
+	"github.com/apex/log"
+)
+
+// StoredData returns what a pokemon is stored as, its canonical form. A pokemon we can't read ourselves
+// is stored as it was sent, PKHeX may still know what to do with it.
+func StoredData(ctx context.Context, raw []byte, gen generation.Generation) []byte {
+	data, err := pkm.Canonical(raw, gen)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("failed to get the canonical form of the pokemon, storing it as is")
+		return raw
+	}
+
+	return data
+}
+
+// convertBase64Pokemon moves the pokemon stored before the data column into it, in their canonical form.
+// The same pokemon stored once in party and once in box form is merged into the first one.
+func convertBase64Pokemon(ctx context.Context, db *ent.Client) error {
+	logger := log.FromContext(ctx)
+
+	total, err := db.Pokemon.Query().Where(pokemon.DataIsNil()).Count(ctx)
+	if err != nil || total == 0 {
+		return err
+	}
+
+	logger.WithField("total", total).Info("converting stored pokemon to binary")
+
+	var converted, duplicates, failed int
+	lastID := 0
+	for {
+		mons, err := db.Pokemon.Query().
+			Where(pokemon.DataIsNil(), pokemon.IDGT(lastID)).
+			Order(pokemon.ByID()).
+			Limit(hashBatchSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(mons) == 0 {
+			break
+		}
+
+		for _, mon := range mons {
+			lastID = mon.ID
+			monLogger := logger.WithField("code", mon.DownloadCode)
+
+			raw, err := base64.StdEncoding.DecodeString(mon.Base64)
+			if err != nil {
+				failed++
+				monLogger.WithError(err).Warn("failed to decode stored pokemon")
+				continue
+			}
+
+			data := raw
+			if gen, err := generation.Parse(mon.Generation); err == nil {
+				data = StoredData(log.NewContext(ctx, monLogger), raw, gen)
+			}
+
+			hash := ContentHash(data)
+			err = mon.Update().SetData(data).ClearBase64().SetContentHash(hash).Exec(ctx)
+			if ent.IsConstraintError(err) {
+				duplicates++
+				err = mergeDuplicate(log.NewContext(ctx, monLogger), db, mon, hash, func(u *ent.PokemonUpdateOne) {
+					u.SetData(data).ClearBase64().ClearContentHash()
+				})
+			}
+
+			if err != nil {
+				return err
+			}
+
+			converted++
+		}
+	}
+
+	logger.WithFields(log.Fields{"converted": converted, "duplicates": duplicates, "failed": failed}).Info("finished converting stored pokemon")
+	return nil
+}
@@ -17,6 +17,12 @@ type Bundle struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// Visibility holds the value of the "visibility" field.
+	Visibility bundle.Visibility `json:"visibility,omitempty"`
+	// VisibilityChangedAt holds the value of the "visibility_changed_at" field.
+	VisibilityChangedAt *time.Time `json:"visibility_changed_at,omitempty"`
+	// VisibilityReason holds the value of the "visibility_reason" field.
+	VisibilityReason *string `json:"visibility_reason,omitempty"`
 	// UploadDatetime holds the value of the "upload_datetime" field.
 	UploadDatetime time.Time `json:"upload_datetime,omitempty"`
 	// DownloadCode holds the value of the "download_code" field.
@@ -26,9 +32,15 @@ type Bundle struct {
 	// Legal holds the value of the "legal" field.
 	Legal bool `json:"legal,omitempty"`
 	// MinGen holds the value of the "min_gen" field.
-	MinGen string `json:"min_gen,omitempty"`
+	MinGen float64 `json:"min_gen,omitempty"`
 	// MaxGen holds the value of the "max_gen" field.
-	MaxGen string `json:"max_gen,omitempty"`
+	MaxGen float64 `json:"max_gen,omitempty"`
+	// Title holds the value of the "title" field.
+	Title *string `json:"title,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes *string `json:"notes,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the BundleQuery when eager-loading is set.
 	Edges        BundleEdges `json:"edges"`
@@ -37,20 +49,20 @@ type Bundle struct {
 
 // BundleEdges holds the relations/edges for other nodes in the graph.
 type BundleEdges struct {
-	// Pokemons holds the value of the pokemons edge.
-	Pokemons []*Pokemon `json:"pokemons,omitempty"`
+	// Slots holds the value of the slots edge.
+	Slots []*BundleSlot `json:"slots,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
 	loadedTypes [1]bool
 }
 
-// PokemonsOrErr returns the Pokemons value or an error if the edge
+// SlotsOrErr returns the Slots value or an error if the edge
 // was not loaded in eager-loading.
-func (e BundleEdges) PokemonsOrErr() ([]*Pokemon, error) {
+func (e BundleEdges) SlotsOrErr() ([]*BundleSlot, error) {
 	if e.loadedTypes[0] {
-		return e.Pokemons, nil
+		return e.Slots, nil
 	}
-	return nil, &NotLoadedError{edge: "pokemons"}
+	return nil, &NotLoadedError{edge: "slots"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -60,11 +72,13 @@ func (*Bundle) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case bundle.FieldLegal:
 			values[i] = new(sql.NullBool)
+		case bundle.FieldMinGen, bundle.FieldMaxGen:
+			values[i] = new(sql.NullFloat64)
 		case bundle.FieldID, bundle.FieldDownloadCount:
 			values[i] = new(sql.NullInt64)
-		case bundle.FieldDownloadCode, bundle.FieldMinGen, bundle.FieldMaxGen:
+		case bundle.FieldVisibility, bundle.FieldVisibilityReason, bundle.FieldDownloadCode, bundle.FieldTitle, bundle.FieldDescription, bundle.FieldNotes:
 			values[i] = new(sql.NullString)
-		case bundle.FieldUploadDatetime:
+		case bundle.FieldVisibilityChangedAt, bundle.FieldUploadDatetime:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -87,6 +101,26 @@ func (_m *Bundle) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			_m.ID = int(value.Int64)
+		case bundle.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				_m.Visibility = bundle.Visibility(value.String)
+			}
+		case bundle.FieldVisibilityChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility_changed_at", values[i])
+			} else if value.Valid {
+				_m.VisibilityChangedAt = new(time.Time)
+				*_m.VisibilityChangedAt = value.Time
+			}
+		case bundle.FieldVisibilityReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility_reason", values[i])
+			} else if value.Valid {
+				_m.VisibilityReason = new(string)
+				*_m.VisibilityReason = value.String
+			}
 		case bundle.FieldUploadDatetime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field upload_datetime", values[i])
@@ -112,16 +146,37 @@ func (_m *Bundle) assignValues(columns []string, values []any) error {
 				_m.Legal = value.Bool
 			}
 		case bundle.FieldMinGen:
-			if value, ok := values[i].(*sql.NullString); !ok {
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field min_gen", values[i])
 			} else if value.Valid {
-				_m.MinGen = value.String
+				_m.MinGen = value.Float64
 			}
 		case bundle.FieldMaxGen:
-			if value, ok := values[i].(*sql.NullString); !ok {
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field max_gen", values[i])
 			} else if value.Valid {
-				_m.MaxGen = value.String
+				_m.MaxGen = value.Float64
+			}
+		case bundle.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = new(string)
+				*_m.Title = value.String
+			}
+		case bundle.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = new(string)
+				*_m.Description = value.String
+			}
+		case bundle.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				_m.Notes = new(string)
+				*_m.Notes = value.String
 			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
@@ -136,9 +191,9 @@ func (_m *Bundle) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
-// QueryPokemons queries the "pokemons" edge of the Bundle entity.
-func (_m *Bundle) QueryPokemons() *PokemonQuery {
-	return NewBundleClient(_m.config).QueryPokemons(_m)
+// QuerySlots queries the "slots" edge of the Bundle entity.
+func (_m *Bundle) QuerySlots() *BundleSlotQuery {
+	return NewBundleClient(_m.config).QuerySlots(_m)
 }
 
 // Update returns a builder for updating this Bundle.
@@ -164,6 +219,19 @@ func (_m *Bundle) String() string {
 	var builder strings.Builder
 	builder.WriteString("Bundle(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Visibility))
+	builder.WriteString(", ")
+	if v := _m.VisibilityChangedAt; v != nil {
+		builder.WriteString("visibility_changed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.VisibilityReason; v != nil {
+		builder.WriteString("visibility_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("upload_datetime=")
 	builder.WriteString(_m.UploadDatetime.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -177,10 +245,25 @@ func (_m *Bundle) String() string {
 	builder.WriteString(fmt.Sprintf("%v", _m.Legal))
 	builder.WriteString(", ")
 	builder.WriteString("min_gen=")
-	builder.WriteString(_m.MinGen)
+	builder.WriteString(fmt.Sprintf("%v", _m.MinGen))
 	builder.WriteString(", ")
 	builder.WriteString("max_gen=")
-	builder.WriteString(_m.MaxGen)
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxGen))
+	builder.WriteString(", ")
+	if v := _m.Title; v != nil {
+		builder.WriteString("title=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Notes; v != nil {
+		builder.WriteString("notes=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package bundle
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -12,6 +14,12 @@ const (
 	Label = "bundle"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldVisibility holds the string denoting the visibility field in the database.
+	FieldVisibility = "visibility"
+	// FieldVisibilityChangedAt holds the string denoting the visibility_changed_at field in the database.
+	FieldVisibilityChangedAt = "visibility_changed_at"
+	// FieldVisibilityReason holds the string denoting the visibility_reason field in the database.
+	FieldVisibilityReason = "visibility_reason"
 	// FieldUploadDatetime holds the string denoting the upload_datetime field in the database.
 	FieldUploadDatetime = "upload_datetime"
 	// FieldDownloadCode holds the string denoting the download_code field in the database.
@@ -24,34 +32,42 @@ const (
 	FieldMinGen = "min_gen"
 	// FieldMaxGen holds the string denoting the max_gen field in the database.
 	FieldMaxGen = "max_gen"
-	// EdgePokemons holds the string denoting the pokemons edge name in mutations.
-	EdgePokemons = "pokemons"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// EdgeSlots holds the string denoting the slots edge name in mutations.
+	EdgeSlots = "slots"
 	// Table holds the table name of the bundle in the database.
 	Table = "bundles"
-	// PokemonsTable is the table that holds the pokemons relation/edge. The primary key declared below.
-	PokemonsTable = "bundle_pokemons"
-	// PokemonsInverseTable is the table name for the Pokemon entity.
-	// It exists in this package in order to avoid circular dependency with the "pokemon" package.
-	PokemonsInverseTable = "pokemons"
+	// SlotsTable is the table that holds the slots relation/edge.
+	SlotsTable = "bundle_slots"
+	// SlotsInverseTable is the table name for the BundleSlot entity.
+	// It exists in this package in order to avoid circular dependency with the "bundleslot" package.
+	SlotsInverseTable = "bundle_slots"
+	// SlotsColumn is the table column denoting the slots relation/edge.
+	SlotsColumn = "bundle_id"
 )
 
 // Columns holds all SQL columns for bundle fields.
 var Columns = []string{
 	FieldID,
+	FieldVisibility,
+	FieldVisibilityChangedAt,
+	FieldVisibilityReason,
 	FieldUploadDatetime,
 	FieldDownloadCode,
 	FieldDownloadCount,
 	FieldLegal,
 	FieldMinGen,
 	FieldMaxGen,
+	FieldTitle,
+	FieldDescription,
+	FieldNotes,
 }
 
-var (
-	// PokemonsPrimaryKey and PokemonsColumn2 are the table columns denoting the
-	// primary key for the pokemons relation (M2M).
-	PokemonsPrimaryKey = []string{"bundle_id", "pokemon_id"}
-)
-
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -67,6 +83,33 @@ var (
 	DefaultDownloadCount int
 )
 
+// Visibility defines the type for the "visibility" enum field.
+type Visibility string
+
+// VisibilityVisible is the default value of the Visibility enum.
+const DefaultVisibility = VisibilityVisible
+
+// Visibility values.
+const (
+	VisibilityVisible Visibility = "visible"
+	VisibilityHidden  Visibility = "hidden"
+	VisibilityDeleted Visibility = "deleted"
+)
+
+func (v Visibility) String() string {
+	return string(v)
+}
+
+// VisibilityValidator is a validator for the "visibility" field enum values. It is called by the builders before save.
+func VisibilityValidator(v Visibility) error {
+	switch v {
+	case VisibilityVisible, VisibilityHidden, VisibilityDeleted:
+		return nil
+	default:
+		return fmt.Errorf("bundle: invalid enum value for visibility field: %q", v)
+	}
+}
+
 // OrderOption defines the ordering options for the Bundle queries.
 type OrderOption func(*sql.Selector)
 
@@ -75,6 +118,21 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByVisibility orders the results by the visibility field.
+func ByVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisibility, opts...).ToFunc()
+}
+
+// ByVisibilityChangedAt orders the results by the visibility_changed_at field.
+func ByVisibilityChangedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisibilityChangedAt, opts...).ToFunc()
+}
+
+// ByVisibilityReason orders the results by the visibility_reason field.
+func ByVisibilityReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisibilityReason, opts...).ToFunc()
+}
+
 // ByUploadDatetime orders the results by the upload_datetime field.
 func ByUploadDatetime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUploadDatetime, opts...).ToFunc()
@@ -105,23 +163,38 @@ func ByMaxGen(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMaxGen, opts...).ToFunc()
 }
 
-// ByPokemonsCount orders the results by pokemons count.
-func ByPokemonsCount(opts ...sql.OrderTermOption) OrderOption {
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByNotes orders the results by the notes field.
+func ByNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotes, opts...).ToFunc()
+}
+
+// BySlotsCount orders the results by slots count.
+func BySlotsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newPokemonsStep(), opts...)
+		sqlgraph.OrderByNeighborsCount(s, newSlotsStep(), opts...)
 	}
 }
 
-// ByPokemons orders the results by pokemons terms.
-func ByPokemons(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+// BySlots orders the results by slots terms.
+func BySlots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newPokemonsStep(), append([]sql.OrderTerm{term}, terms...)...)
+		sqlgraph.OrderByNeighborTerms(s, newSlotsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
-func newPokemonsStep() *sqlgraph.Step {
+func newSlotsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(PokemonsInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.M2M, false, PokemonsTable, PokemonsPrimaryKey...),
+		sqlgraph.To(SlotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SlotsTable, SlotsColumn),
 	)
 }
@@ -55,6 +55,16 @@ func IDLTE(id int) predicate.Bundle {
 	return predicate.Bundle(sql.FieldLTE(FieldID, id))
 }
 
+// VisibilityChangedAt applies equality check predicate on the "visibility_changed_at" field. It's identical to VisibilityChangedAtEQ.
+func VisibilityChangedAt(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldVisibilityChangedAt, v))
+}
+
+// VisibilityReason applies equality check predicate on the "visibility_reason" field. It's identical to VisibilityReasonEQ.
+func VisibilityReason(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldVisibilityReason, v))
+}
+
 // UploadDatetime applies equality check predicate on the "upload_datetime" field. It's identical to UploadDatetimeEQ.
 func UploadDatetime(v time.Time) predicate.Bundle {
 	return predicate.Bundle(sql.FieldEQ(FieldUploadDatetime, v))
@@ -76,15 +86,175 @@ func Legal(v bool) predicate.Bundle {
 }
 
 // MinGen applies equality check predicate on the "min_gen" field. It's identical to MinGenEQ.
-func MinGen(v string) predicate.Bundle {
+func MinGen(v float64) predicate.Bundle {
 	return predicate.Bundle(sql.FieldEQ(FieldMinGen, v))
 }
 
 // MaxGen applies equality check predicate on the "max_gen" field. It's identical to MaxGenEQ.
-func MaxGen(v string) predicate.Bundle {
+func MaxGen(v float64) predicate.Bundle {
 	return predicate.Bundle(sql.FieldEQ(FieldMaxGen, v))
 }
 
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldDescription, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldNotes, v))
+}
+
+// VisibilityEQ applies the EQ predicate on the "visibility" field.
+func VisibilityEQ(v Visibility) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldVisibility, v))
+}
+
+// VisibilityNEQ applies the NEQ predicate on the "visibility" field.
+func VisibilityNEQ(v Visibility) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNEQ(FieldVisibility, v))
+}
+
+// VisibilityIn applies the In predicate on the "visibility" field.
+func VisibilityIn(vs ...Visibility) predicate.Bundle {
+	return predicate.Bundle(sql.FieldIn(FieldVisibility, vs...))
+}
+
+// VisibilityNotIn applies the NotIn predicate on the "visibility" field.
+func VisibilityNotIn(vs ...Visibility) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotIn(FieldVisibility, vs...))
+}
+
+// VisibilityChangedAtEQ applies the EQ predicate on the "visibility_changed_at" field.
+func VisibilityChangedAtEQ(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldVisibilityChangedAt, v))
+}
+
+// VisibilityChangedAtNEQ applies the NEQ predicate on the "visibility_changed_at" field.
+func VisibilityChangedAtNEQ(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNEQ(FieldVisibilityChangedAt, v))
+}
+
+// VisibilityChangedAtIn applies the In predicate on the "visibility_changed_at" field.
+func VisibilityChangedAtIn(vs ...time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldIn(FieldVisibilityChangedAt, vs...))
+}
+
+// VisibilityChangedAtNotIn applies the NotIn predicate on the "visibility_changed_at" field.
+func VisibilityChangedAtNotIn(vs ...time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotIn(FieldVisibilityChangedAt, vs...))
+}
+
+// VisibilityChangedAtGT applies the GT predicate on the "visibility_changed_at" field.
+func VisibilityChangedAtGT(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGT(FieldVisibilityChangedAt, v))
+}
+
+// VisibilityChangedAtGTE applies the GTE predicate on the "visibility_changed_at" field.
+func VisibilityChangedAtGTE(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGTE(FieldVisibilityChangedAt, v))
+}
+
+// VisibilityChangedAtLT applies the LT predicate on the "visibility_changed_at" field.
+func VisibilityChangedAtLT(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLT(FieldVisibilityChangedAt, v))
+}
+
+// VisibilityChangedAtLTE applies the LTE predicate on the "visibility_changed_at" field.
+func VisibilityChangedAtLTE(v time.Time) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLTE(FieldVisibilityChangedAt, v))
+}
+
+// VisibilityChangedAtIsNil applies the IsNil predicate on the "visibility_changed_at" field.
+func VisibilityChangedAtIsNil() predicate.Bundle {
+	return predicate.Bundle(sql.FieldIsNull(FieldVisibilityChangedAt))
+}
+
+// VisibilityChangedAtNotNil applies the NotNil predicate on the "visibility_changed_at" field.
+func VisibilityChangedAtNotNil() predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotNull(FieldVisibilityChangedAt))
+}
+
+// VisibilityReasonEQ applies the EQ predicate on the "visibility_reason" field.
+func VisibilityReasonEQ(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldVisibilityReason, v))
+}
+
+// VisibilityReasonNEQ applies the NEQ predicate on the "visibility_reason" field.
+func VisibilityReasonNEQ(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNEQ(FieldVisibilityReason, v))
+}
+
+// VisibilityReasonIn applies the In predicate on the "visibility_reason" field.
+func VisibilityReasonIn(vs ...string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldIn(FieldVisibilityReason, vs...))
+}
+
+// VisibilityReasonNotIn applies the NotIn predicate on the "visibility_reason" field.
+func VisibilityReasonNotIn(vs ...string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotIn(FieldVisibilityReason, vs...))
+}
+
+// VisibilityReasonGT applies the GT predicate on the "visibility_reason" field.
+func VisibilityReasonGT(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGT(FieldVisibilityReason, v))
+}
+
+// VisibilityReasonGTE applies the GTE predicate on the "visibility_reason" field.
+func VisibilityReasonGTE(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGTE(FieldVisibilityReason, v))
+}
+
+// VisibilityReasonLT applies the LT predicate on the "visibility_reason" field.
+func VisibilityReasonLT(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLT(FieldVisibilityReason, v))
+}
+
+// VisibilityReasonLTE applies the LTE predicate on the "visibility_reason" field.
+func VisibilityReasonLTE(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLTE(FieldVisibilityReason, v))
+}
+
+// VisibilityReasonContains applies the Contains predicate on the "visibility_reason" field.
+func VisibilityReasonContains(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldContains(FieldVisibilityReason, v))
+}
+
+// VisibilityReasonHasPrefix applies the HasPrefix predicate on the "visibility_reason" field.
+func VisibilityReasonHasPrefix(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldHasPrefix(FieldVisibilityReason, v))
+}
+
+// VisibilityReasonHasSuffix applies the HasSuffix predicate on the "visibility_reason" field.
+func VisibilityReasonHasSuffix(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldHasSuffix(FieldVisibilityReason, v))
+}
+
+// VisibilityReasonIsNil applies the IsNil predicate on the "visibility_reason" field.
+func VisibilityReasonIsNil() predicate.Bundle {
+	return predicate.Bundle(sql.FieldIsNull(FieldVisibilityReason))
+}
+
+// VisibilityReasonNotNil applies the NotNil predicate on the "visibility_reason" field.
+func VisibilityReasonNotNil() predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotNull(FieldVisibilityReason))
+}
+
+// VisibilityReasonEqualFold applies the EqualFold predicate on the "visibility_reason" field.
+func VisibilityReasonEqualFold(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEqualFold(FieldVisibilityReason, v))
+}
+
+// VisibilityReasonContainsFold applies the ContainsFold predicate on the "visibility_reason" field.
+func VisibilityReasonContainsFold(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldContainsFold(FieldVisibilityReason, v))
+}
+
 // UploadDatetimeEQ applies the EQ predicate on the "upload_datetime" field.
 func UploadDatetimeEQ(v time.Time) predicate.Bundle {
 	return predicate.Bundle(sql.FieldEQ(FieldUploadDatetime, v))
@@ -241,150 +411,325 @@ func LegalNEQ(v bool) predicate.Bundle {
 }
 
 // MinGenEQ applies the EQ predicate on the "min_gen" field.
-func MinGenEQ(v string) predicate.Bundle {
+func MinGenEQ(v float64) predicate.Bundle {
 	return predicate.Bundle(sql.FieldEQ(FieldMinGen, v))
 }
 
 // MinGenNEQ applies the NEQ predicate on the "min_gen" field.
-func MinGenNEQ(v string) predicate.Bundle {
+func MinGenNEQ(v float64) predicate.Bundle {
 	return predicate.Bundle(sql.FieldNEQ(FieldMinGen, v))
 }
 
 // MinGenIn applies the In predicate on the "min_gen" field.
-func MinGenIn(vs ...string) predicate.Bundle {
+func MinGenIn(vs ...float64) predicate.Bundle {
 	return predicate.Bundle(sql.FieldIn(FieldMinGen, vs...))
 }
 
 // MinGenNotIn applies the NotIn predicate on the "min_gen" field.
-func MinGenNotIn(vs ...string) predicate.Bundle {
+func MinGenNotIn(vs ...float64) predicate.Bundle {
 	return predicate.Bundle(sql.FieldNotIn(FieldMinGen, vs...))
 }
 
 // MinGenGT applies the GT predicate on the "min_gen" field.
-func MinGenGT(v string) predicate.Bundle {
+func MinGenGT(v float64) predicate.Bundle {
 	return predicate.Bundle(sql.FieldGT(FieldMinGen, v))
 }
 
 // MinGenGTE applies the GTE predicate on the "min_gen" field.
-func MinGenGTE(v string) predicate.Bundle {
+func MinGenGTE(v float64) predicate.Bundle {
 	return predicate.Bundle(sql.FieldGTE(FieldMinGen, v))
 }
 
 // MinGenLT applies the LT predicate on the "min_gen" field.
-func MinGenLT(v string) predicate.Bundle {
+func MinGenLT(v float64) predicate.Bundle {
 	return predicate.Bundle(sql.FieldLT(FieldMinGen, v))
 }
 
 // MinGenLTE applies the LTE predicate on the "min_gen" field.
-func MinGenLTE(v string) predicate.Bundle {
+func MinGenLTE(v float64) predicate.Bundle {
 	return predicate.Bundle(sql.FieldLTE(FieldMinGen, v))
 }
 
-// MinGenContains applies the Contains predicate on the "min_gen" field.
-func MinGenContains(v string) predicate.Bundle {
-	return predicate.Bundle(sql.FieldContains(FieldMinGen, v))
-}
-
-// MinGenHasPrefix applies the HasPrefix predicate on the "min_gen" field.
-func MinGenHasPrefix(v string) predicate.Bundle {
-	return predicate.Bundle(sql.FieldHasPrefix(FieldMinGen, v))
-}
-
-// MinGenHasSuffix applies the HasSuffix predicate on the "min_gen" field.
-func MinGenHasSuffix(v string) predicate.Bundle {
-	return predicate.Bundle(sql.FieldHasSuffix(FieldMinGen, v))
-}
-
-// MinGenEqualFold applies the EqualFold predicate on the "min_gen" field.
-func MinGenEqualFold(v string) predicate.Bundle {
-	return predicate.Bundle(sql.FieldEqualFold(FieldMinGen, v))
-}
-
-// MinGenContainsFold applies the ContainsFold predicate on the "min_gen" field.
-func MinGenContainsFold(v string) predicate.Bundle {
-	return predicate.Bundle(sql.FieldContainsFold(FieldMinGen, v))
-}
-
 // MaxGenEQ applies the EQ predicate on the "max_gen" field.
-func MaxGenEQ(v string) predicate.Bundle {
+func MaxGenEQ(v float64) predicate.Bundle {
 	return predicate.Bundle(sql.FieldEQ(FieldMaxGen, v))
 }
 
 // MaxGenNEQ applies the NEQ predicate on the "max_gen" field.
-func MaxGenNEQ(v string) predicate.Bundle {
+func MaxGenNEQ(v float64) predicate.Bundle {
 	return predicate.Bundle(sql.FieldNEQ(FieldMaxGen, v))
 }
 
 // MaxGenIn applies the In predicate on the "max_gen" field.
-func MaxGenIn(vs ...string) predicate.Bundle {
+func MaxGenIn(vs ...float64) predicate.Bundle {
 	return predicate.Bundle(sql.FieldIn(FieldMaxGen, vs...))
 }
 
 // MaxGenNotIn applies the NotIn predicate on the "max_gen" field.
-func MaxGenNotIn(vs ...string) predicate.Bundle {
+func MaxGenNotIn(vs ...float64) predicate.Bundle {
 	return predicate.Bundle(sql.FieldNotIn(FieldMaxGen, vs...))
 }
 
 // MaxGenGT applies the GT predicate on the "max_gen" field.
-func MaxGenGT(v string) predicate.Bundle {
+func MaxGenGT(v float64) predicate.Bundle {
 	return predicate.Bundle(sql.FieldGT(FieldMaxGen, v))
 }
 
 // MaxGenGTE applies the GTE predicate on the "max_gen" field.
-func MaxGenGTE(v string) predicate.Bundle {
+func MaxGenGTE(v float64) predicate.Bundle {
 	return predicate.Bundle(sql.FieldGTE(FieldMaxGen, v))
 }
 
 // MaxGenLT applies the LT predicate on the "max_gen" field.
-func MaxGenLT(v string) predicate.Bundle {
+func MaxGenLT(v float64) predicate.Bundle {
 	return predicate.Bundle(sql.FieldLT(FieldMaxGen, v))
 }
 
 // MaxGenLTE applies the LTE predicate on the "max_gen" field.
-func MaxGenLTE(v string) predicate.Bundle {
+func MaxGenLTE(v float64) predicate.Bundle {
 	return predicate.Bundle(sql.FieldLTE(FieldMaxGen, v))
 }
 
-// MaxGenContains applies the Contains predicate on the "max_gen" field.
-func MaxGenContains(v string) predicate.Bundle {
-	return predicate.Bundle(sql.FieldContains(FieldMaxGen, v))
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.Bundle {
+	return predicate.Bundle(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.Bundle {
+	return predicate.Bundle(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldHasSuffix(FieldNotes, v))
 }
 
-// MaxGenHasPrefix applies the HasPrefix predicate on the "max_gen" field.
-func MaxGenHasPrefix(v string) predicate.Bundle {
-	return predicate.Bundle(sql.FieldHasPrefix(FieldMaxGen, v))
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.Bundle {
+	return predicate.Bundle(sql.FieldIsNull(FieldNotes))
 }
 
-// MaxGenHasSuffix applies the HasSuffix predicate on the "max_gen" field.
-func MaxGenHasSuffix(v string) predicate.Bundle {
-	return predicate.Bundle(sql.FieldHasSuffix(FieldMaxGen, v))
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.Bundle {
+	return predicate.Bundle(sql.FieldNotNull(FieldNotes))
 }
 
-// MaxGenEqualFold applies the EqualFold predicate on the "max_gen" field.
-func MaxGenEqualFold(v string) predicate.Bundle {
-	return predicate.Bundle(sql.FieldEqualFold(FieldMaxGen, v))
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldEqualFold(FieldNotes, v))
 }
 
-// MaxGenContainsFold applies the ContainsFold predicate on the "max_gen" field.
-func MaxGenContainsFold(v string) predicate.Bundle {
-	return predicate.Bundle(sql.FieldContainsFold(FieldMaxGen, v))
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.Bundle {
+	return predicate.Bundle(sql.FieldContainsFold(FieldNotes, v))
 }
 
-// HasPokemons applies the HasEdge predicate on the "pokemons" edge.
-func HasPokemons() predicate.Bundle {
+// HasSlots applies the HasEdge predicate on the "slots" edge.
+func HasSlots() predicate.Bundle {
 	return predicate.Bundle(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, PokemonsTable, PokemonsPrimaryKey...),
+			sqlgraph.Edge(sqlgraph.O2M, false, SlotsTable, SlotsColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasPokemonsWith applies the HasEdge predicate on the "pokemons" edge with a given conditions (other predicates).
-func HasPokemonsWith(preds ...predicate.Pokemon) predicate.Bundle {
+// HasSlotsWith applies the HasEdge predicate on the "slots" edge with a given conditions (other predicates).
+func HasSlotsWith(preds ...predicate.BundleSlot) predicate.Bundle {
 	return predicate.Bundle(func(s *sql.Selector) {
-		step := newPokemonsStep()
+		step := newSlotsStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
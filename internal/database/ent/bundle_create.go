@@ -11,7 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
-	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
 )
 
 // BundleCreate is the builder for creating a Bundle entity.
@@ -21,6 +21,48 @@ type BundleCreate struct {
 	hooks    []Hook
 }
 
+// SetVisibility sets the "visibility" field.
+func (_c *BundleCreate) SetVisibility(v bundle.Visibility) *BundleCreate {
+	_c.mutation.SetVisibility(v)
+	return _c
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_c *BundleCreate) SetNillableVisibility(v *bundle.Visibility) *BundleCreate {
+	if v != nil {
+		_c.SetVisibility(*v)
+	}
+	return _c
+}
+
+// SetVisibilityChangedAt sets the "visibility_changed_at" field.
+func (_c *BundleCreate) SetVisibilityChangedAt(v time.Time) *BundleCreate {
+	_c.mutation.SetVisibilityChangedAt(v)
+	return _c
+}
+
+// SetNillableVisibilityChangedAt sets the "visibility_changed_at" field if the given value is not nil.
+func (_c *BundleCreate) SetNillableVisibilityChangedAt(v *time.Time) *BundleCreate {
+	if v != nil {
+		_c.SetVisibilityChangedAt(*v)
+	}
+	return _c
+}
+
+// SetVisibilityReason sets the "visibility_reason" field.
+func (_c *BundleCreate) SetVisibilityReason(v string) *BundleCreate {
+	_c.mutation.SetVisibilityReason(v)
+	return _c
+}
+
+// SetNillableVisibilityReason sets the "visibility_reason" field if the given value is not nil.
+func (_c *BundleCreate) SetNillableVisibilityReason(v *string) *BundleCreate {
+	if v != nil {
+		_c.SetVisibilityReason(*v)
+	}
+	return _c
+}
+
 // SetUploadDatetime sets the "upload_datetime" field.
 func (_c *BundleCreate) SetUploadDatetime(v time.Time) *BundleCreate {
 	_c.mutation.SetUploadDatetime(v)
@@ -54,30 +96,72 @@ func (_c *BundleCreate) SetLegal(v bool) *BundleCreate {
 }
 
 // SetMinGen sets the "min_gen" field.
-func (_c *BundleCreate) SetMinGen(v string) *BundleCreate {
+func (_c *BundleCreate) SetMinGen(v float64) *BundleCreate {
 	_c.mutation.SetMinGen(v)
 	return _c
 }
 
 // SetMaxGen sets the "max_gen" field.
-func (_c *BundleCreate) SetMaxGen(v string) *BundleCreate {
+func (_c *BundleCreate) SetMaxGen(v float64) *BundleCreate {
 	_c.mutation.SetMaxGen(v)
 	return _c
 }
 
-// AddPokemonIDs adds the "pokemons" edge to the Pokemon entity by IDs.
-func (_c *BundleCreate) AddPokemonIDs(ids ...int) *BundleCreate {
-	_c.mutation.AddPokemonIDs(ids...)
+// SetTitle sets the "title" field.
+func (_c *BundleCreate) SetTitle(v string) *BundleCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_c *BundleCreate) SetNillableTitle(v *string) *BundleCreate {
+	if v != nil {
+		_c.SetTitle(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *BundleCreate) SetDescription(v string) *BundleCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *BundleCreate) SetNillableDescription(v *string) *BundleCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
 	return _c
 }
 
-// AddPokemons adds the "pokemons" edges to the Pokemon entity.
-func (_c *BundleCreate) AddPokemons(v ...*Pokemon) *BundleCreate {
+// SetNotes sets the "notes" field.
+func (_c *BundleCreate) SetNotes(v string) *BundleCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *BundleCreate) SetNillableNotes(v *string) *BundleCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// AddSlotIDs adds the "slots" edge to the BundleSlot entity by IDs.
+func (_c *BundleCreate) AddSlotIDs(ids ...int) *BundleCreate {
+	_c.mutation.AddSlotIDs(ids...)
+	return _c
+}
+
+// AddSlots adds the "slots" edges to the BundleSlot entity.
+func (_c *BundleCreate) AddSlots(v ...*BundleSlot) *BundleCreate {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _c.AddPokemonIDs(ids...)
+	return _c.AddSlotIDs(ids...)
 }
 
 // Mutation returns the BundleMutation object of the builder.
@@ -115,6 +199,10 @@ func (_c *BundleCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *BundleCreate) defaults() {
+	if _, ok := _c.mutation.Visibility(); !ok {
+		v := bundle.DefaultVisibility
+		_c.mutation.SetVisibility(v)
+	}
 	if _, ok := _c.mutation.DownloadCount(); !ok {
 		v := bundle.DefaultDownloadCount
 		_c.mutation.SetDownloadCount(v)
@@ -123,6 +211,14 @@ func (_c *BundleCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *BundleCreate) check() error {
+	if _, ok := _c.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "Bundle.visibility"`)}
+	}
+	if v, ok := _c.mutation.Visibility(); ok {
+		if err := bundle.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Bundle.visibility": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.UploadDatetime(); !ok {
 		return &ValidationError{Name: "upload_datetime", err: errors.New(`ent: missing required field "Bundle.upload_datetime"`)}
 	}
@@ -167,6 +263,18 @@ func (_c *BundleCreate) createSpec() (*Bundle, *sqlgraph.CreateSpec) {
 		_node = &Bundle{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(bundle.Table, sqlgraph.NewFieldSpec(bundle.FieldID, field.TypeInt))
 	)
+	if value, ok := _c.mutation.Visibility(); ok {
+		_spec.SetField(bundle.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
+	if value, ok := _c.mutation.VisibilityChangedAt(); ok {
+		_spec.SetField(bundle.FieldVisibilityChangedAt, field.TypeTime, value)
+		_node.VisibilityChangedAt = &value
+	}
+	if value, ok := _c.mutation.VisibilityReason(); ok {
+		_spec.SetField(bundle.FieldVisibilityReason, field.TypeString, value)
+		_node.VisibilityReason = &value
+	}
 	if value, ok := _c.mutation.UploadDatetime(); ok {
 		_spec.SetField(bundle.FieldUploadDatetime, field.TypeTime, value)
 		_node.UploadDatetime = value
@@ -184,22 +292,34 @@ func (_c *BundleCreate) createSpec() (*Bundle, *sqlgraph.CreateSpec) {
 		_node.Legal = value
 	}
 	if value, ok := _c.mutation.MinGen(); ok {
-		_spec.SetField(bundle.FieldMinGen, field.TypeString, value)
+		_spec.SetField(bundle.FieldMinGen, field.TypeFloat64, value)
 		_node.MinGen = value
 	}
 	if value, ok := _c.mutation.MaxGen(); ok {
-		_spec.SetField(bundle.FieldMaxGen, field.TypeString, value)
+		_spec.SetField(bundle.FieldMaxGen, field.TypeFloat64, value)
 		_node.MaxGen = value
 	}
-	if nodes := _c.mutation.PokemonsIDs(); len(nodes) > 0 {
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(bundle.FieldTitle, field.TypeString, value)
+		_node.Title = &value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(bundle.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(bundle.FieldNotes, field.TypeString, value)
+		_node.Notes = &value
+	}
+	if nodes := _c.mutation.SlotsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   bundle.PokemonsTable,
-			Columns: bundle.PokemonsPrimaryKey,
+			Table:   bundle.SlotsTable,
+			Columns: []string{bundle.SlotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bundleslot.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
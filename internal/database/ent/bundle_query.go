@@ -13,18 +13,18 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
-	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/predicate"
 )
 
 // BundleQuery is the builder for querying Bundle entities.
 type BundleQuery struct {
 	config
-	ctx          *QueryContext
-	order        []bundle.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Bundle
-	withPokemons *PokemonQuery
+	ctx        *QueryContext
+	order      []bundle.OrderOption
+	inters     []Interceptor
+	predicates []predicate.Bundle
+	withSlots  *BundleSlotQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -61,9 +61,9 @@ func (_q *BundleQuery) Order(o ...bundle.OrderOption) *BundleQuery {
 	return _q
 }
 
-// QueryPokemons chains the current query on the "pokemons" edge.
-func (_q *BundleQuery) QueryPokemons() *PokemonQuery {
-	query := (&PokemonClient{config: _q.config}).Query()
+// QuerySlots chains the current query on the "slots" edge.
+func (_q *BundleQuery) QuerySlots() *BundleSlotQuery {
+	query := (&BundleSlotClient{config: _q.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := _q.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -74,8 +74,8 @@ func (_q *BundleQuery) QueryPokemons() *PokemonQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(bundle.Table, bundle.FieldID, selector),
-			sqlgraph.To(pokemon.Table, pokemon.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, bundle.PokemonsTable, bundle.PokemonsPrimaryKey...),
+			sqlgraph.To(bundleslot.Table, bundleslot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, bundle.SlotsTable, bundle.SlotsColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
@@ -270,26 +270,26 @@ func (_q *BundleQuery) Clone() *BundleQuery {
 		return nil
 	}
 	return &BundleQuery{
-		config:       _q.config,
-		ctx:          _q.ctx.Clone(),
-		order:        append([]bundle.OrderOption{}, _q.order...),
-		inters:       append([]Interceptor{}, _q.inters...),
-		predicates:   append([]predicate.Bundle{}, _q.predicates...),
-		withPokemons: _q.withPokemons.Clone(),
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]bundle.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.Bundle{}, _q.predicates...),
+		withSlots:  _q.withSlots.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
 	}
 }
 
-// WithPokemons tells the query-builder to eager-load the nodes that are connected to
-// the "pokemons" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *BundleQuery) WithPokemons(opts ...func(*PokemonQuery)) *BundleQuery {
-	query := (&PokemonClient{config: _q.config}).Query()
+// WithSlots tells the query-builder to eager-load the nodes that are connected to
+// the "slots" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BundleQuery) WithSlots(opts ...func(*BundleSlotQuery)) *BundleQuery {
+	query := (&BundleSlotClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withPokemons = query
+	_q.withSlots = query
 	return _q
 }
 
@@ -299,12 +299,12 @@ func (_q *BundleQuery) WithPokemons(opts ...func(*PokemonQuery)) *BundleQuery {
 // Example:
 //
 //	var v []struct {
-//		UploadDatetime time.Time `json:"upload_datetime,omitempty"`
+//		Visibility bundle.Visibility `json:"visibility,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Bundle.Query().
-//		GroupBy(bundle.FieldUploadDatetime).
+//		GroupBy(bundle.FieldVisibility).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *BundleQuery) GroupBy(field string, fields ...string) *BundleGroupBy {
@@ -322,11 +322,11 @@ func (_q *BundleQuery) GroupBy(field string, fields ...string) *BundleGroupBy {
 // Example:
 //
 //	var v []struct {
-//		UploadDatetime time.Time `json:"upload_datetime,omitempty"`
+//		Visibility bundle.Visibility `json:"visibility,omitempty"`
 //	}
 //
 //	client.Bundle.Query().
-//		Select(bundle.FieldUploadDatetime).
+//		Select(bundle.FieldVisibility).
 //		Scan(ctx, &v)
 func (_q *BundleQuery) Select(fields ...string) *BundleSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -372,7 +372,7 @@ func (_q *BundleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Bundl
 		nodes       = []*Bundle{}
 		_spec       = _q.querySpec()
 		loadedTypes = [1]bool{
-			_q.withPokemons != nil,
+			_q.withSlots != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -393,74 +393,43 @@ func (_q *BundleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Bundl
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
-	if query := _q.withPokemons; query != nil {
-		if err := _q.loadPokemons(ctx, query, nodes,
-			func(n *Bundle) { n.Edges.Pokemons = []*Pokemon{} },
-			func(n *Bundle, e *Pokemon) { n.Edges.Pokemons = append(n.Edges.Pokemons, e) }); err != nil {
+	if query := _q.withSlots; query != nil {
+		if err := _q.loadSlots(ctx, query, nodes,
+			func(n *Bundle) { n.Edges.Slots = []*BundleSlot{} },
+			func(n *Bundle, e *BundleSlot) { n.Edges.Slots = append(n.Edges.Slots, e) }); err != nil {
 			return nil, err
 		}
 	}
 	return nodes, nil
 }
 
-func (_q *BundleQuery) loadPokemons(ctx context.Context, query *PokemonQuery, nodes []*Bundle, init func(*Bundle), assign func(*Bundle, *Pokemon)) error {
-	edgeIDs := make([]driver.Value, len(nodes))
-	byID := make(map[int]*Bundle)
-	nids := make(map[int]map[*Bundle]struct{})
-	for i, node := range nodes {
-		edgeIDs[i] = node.ID
-		byID[node.ID] = node
+func (_q *BundleQuery) loadSlots(ctx context.Context, query *BundleSlotQuery, nodes []*Bundle, init func(*Bundle), assign func(*Bundle, *BundleSlot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Bundle)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
 		if init != nil {
-			init(node)
+			init(nodes[i])
 		}
 	}
-	query.Where(func(s *sql.Selector) {
-		joinT := sql.Table(bundle.PokemonsTable)
-		s.Join(joinT).On(s.C(pokemon.FieldID), joinT.C(bundle.PokemonsPrimaryKey[1]))
-		s.Where(sql.InValues(joinT.C(bundle.PokemonsPrimaryKey[0]), edgeIDs...))
-		columns := s.SelectedColumns()
-		s.Select(joinT.C(bundle.PokemonsPrimaryKey[0]))
-		s.AppendSelect(columns...)
-		s.SetDistinct(false)
-	})
-	if err := query.prepareQuery(ctx); err != nil {
-		return err
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(bundleslot.FieldBundleID)
 	}
-	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
-		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
-			assign := spec.Assign
-			values := spec.ScanValues
-			spec.ScanValues = func(columns []string) ([]any, error) {
-				values, err := values(columns[1:])
-				if err != nil {
-					return nil, err
-				}
-				return append([]any{new(sql.NullInt64)}, values...), nil
-			}
-			spec.Assign = func(columns []string, values []any) error {
-				outValue := int(values[0].(*sql.NullInt64).Int64)
-				inValue := int(values[1].(*sql.NullInt64).Int64)
-				if nids[inValue] == nil {
-					nids[inValue] = map[*Bundle]struct{}{byID[outValue]: {}}
-					return assign(columns[1:], values[1:])
-				}
-				nids[inValue][byID[outValue]] = struct{}{}
-				return nil
-			}
-		})
-	})
-	neighbors, err := withInterceptors[[]*Pokemon](ctx, query, qr, query.inters)
+	query.Where(predicate.BundleSlot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(bundle.SlotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		nodes, ok := nids[n.ID]
+		fk := n.BundleID
+		node, ok := nodeids[fk]
 		if !ok {
-			return fmt.Errorf(`unexpected "pokemons" node returned %v`, n.ID)
-		}
-		for kn := range nodes {
-			assign(kn, n)
+			return fmt.Errorf(`unexpected referenced foreign-key "bundle_id" returned %v for node %v`, fk, n.ID)
 		}
+		assign(node, n)
 	}
 	return nil
 }
@@ -12,7 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
-	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/predicate"
 )
 
@@ -29,6 +29,60 @@ func (_u *BundleUpdate) Where(ps ...predicate.Bundle) *BundleUpdate {
 	return _u
 }
 
+// SetVisibility sets the "visibility" field.
+func (_u *BundleUpdate) SetVisibility(v bundle.Visibility) *BundleUpdate {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *BundleUpdate) SetNillableVisibility(v *bundle.Visibility) *BundleUpdate {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetVisibilityChangedAt sets the "visibility_changed_at" field.
+func (_u *BundleUpdate) SetVisibilityChangedAt(v time.Time) *BundleUpdate {
+	_u.mutation.SetVisibilityChangedAt(v)
+	return _u
+}
+
+// SetNillableVisibilityChangedAt sets the "visibility_changed_at" field if the given value is not nil.
+func (_u *BundleUpdate) SetNillableVisibilityChangedAt(v *time.Time) *BundleUpdate {
+	if v != nil {
+		_u.SetVisibilityChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearVisibilityChangedAt clears the value of the "visibility_changed_at" field.
+func (_u *BundleUpdate) ClearVisibilityChangedAt() *BundleUpdate {
+	_u.mutation.ClearVisibilityChangedAt()
+	return _u
+}
+
+// SetVisibilityReason sets the "visibility_reason" field.
+func (_u *BundleUpdate) SetVisibilityReason(v string) *BundleUpdate {
+	_u.mutation.SetVisibilityReason(v)
+	return _u
+}
+
+// SetNillableVisibilityReason sets the "visibility_reason" field if the given value is not nil.
+func (_u *BundleUpdate) SetNillableVisibilityReason(v *string) *BundleUpdate {
+	if v != nil {
+		_u.SetVisibilityReason(*v)
+	}
+	return _u
+}
+
+// ClearVisibilityReason clears the value of the "visibility_reason" field.
+func (_u *BundleUpdate) ClearVisibilityReason() *BundleUpdate {
+	_u.mutation.ClearVisibilityReason()
+	return _u
+}
+
 // SetUploadDatetime sets the "upload_datetime" field.
 func (_u *BundleUpdate) SetUploadDatetime(v time.Time) *BundleUpdate {
 	_u.mutation.SetUploadDatetime(v)
@@ -93,46 +147,120 @@ func (_u *BundleUpdate) SetNillableLegal(v *bool) *BundleUpdate {
 }
 
 // SetMinGen sets the "min_gen" field.
-func (_u *BundleUpdate) SetMinGen(v string) *BundleUpdate {
+func (_u *BundleUpdate) SetMinGen(v float64) *BundleUpdate {
+	_u.mutation.ResetMinGen()
 	_u.mutation.SetMinGen(v)
 	return _u
 }
 
 // SetNillableMinGen sets the "min_gen" field if the given value is not nil.
-func (_u *BundleUpdate) SetNillableMinGen(v *string) *BundleUpdate {
+func (_u *BundleUpdate) SetNillableMinGen(v *float64) *BundleUpdate {
 	if v != nil {
 		_u.SetMinGen(*v)
 	}
 	return _u
 }
 
+// AddMinGen adds value to the "min_gen" field.
+func (_u *BundleUpdate) AddMinGen(v float64) *BundleUpdate {
+	_u.mutation.AddMinGen(v)
+	return _u
+}
+
 // SetMaxGen sets the "max_gen" field.
-func (_u *BundleUpdate) SetMaxGen(v string) *BundleUpdate {
+func (_u *BundleUpdate) SetMaxGen(v float64) *BundleUpdate {
+	_u.mutation.ResetMaxGen()
 	_u.mutation.SetMaxGen(v)
 	return _u
 }
 
 // SetNillableMaxGen sets the "max_gen" field if the given value is not nil.
-func (_u *BundleUpdate) SetNillableMaxGen(v *string) *BundleUpdate {
+func (_u *BundleUpdate) SetNillableMaxGen(v *float64) *BundleUpdate {
 	if v != nil {
 		_u.SetMaxGen(*v)
 	}
 	return _u
 }
 
-// AddPokemonIDs adds the "pokemons" edge to the Pokemon entity by IDs.
-func (_u *BundleUpdate) AddPokemonIDs(ids ...int) *BundleUpdate {
-	_u.mutation.AddPokemonIDs(ids...)
+// AddMaxGen adds value to the "max_gen" field.
+func (_u *BundleUpdate) AddMaxGen(v float64) *BundleUpdate {
+	_u.mutation.AddMaxGen(v)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *BundleUpdate) SetTitle(v string) *BundleUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *BundleUpdate) SetNillableTitle(v *string) *BundleUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *BundleUpdate) ClearTitle() *BundleUpdate {
+	_u.mutation.ClearTitle()
 	return _u
 }
 
-// AddPokemons adds the "pokemons" edges to the Pokemon entity.
-func (_u *BundleUpdate) AddPokemons(v ...*Pokemon) *BundleUpdate {
+// SetDescription sets the "description" field.
+func (_u *BundleUpdate) SetDescription(v string) *BundleUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BundleUpdate) SetNillableDescription(v *string) *BundleUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BundleUpdate) ClearDescription() *BundleUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *BundleUpdate) SetNotes(v string) *BundleUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *BundleUpdate) SetNillableNotes(v *string) *BundleUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *BundleUpdate) ClearNotes() *BundleUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// AddSlotIDs adds the "slots" edge to the BundleSlot entity by IDs.
+func (_u *BundleUpdate) AddSlotIDs(ids ...int) *BundleUpdate {
+	_u.mutation.AddSlotIDs(ids...)
+	return _u
+}
+
+// AddSlots adds the "slots" edges to the BundleSlot entity.
+func (_u *BundleUpdate) AddSlots(v ...*BundleSlot) *BundleUpdate {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddPokemonIDs(ids...)
+	return _u.AddSlotIDs(ids...)
 }
 
 // Mutation returns the BundleMutation object of the builder.
@@ -140,25 +268,25 @@ func (_u *BundleUpdate) Mutation() *BundleMutation {
 	return _u.mutation
 }
 
-// ClearPokemons clears all "pokemons" edges to the Pokemon entity.
-func (_u *BundleUpdate) ClearPokemons() *BundleUpdate {
-	_u.mutation.ClearPokemons()
+// ClearSlots clears all "slots" edges to the BundleSlot entity.
+func (_u *BundleUpdate) ClearSlots() *BundleUpdate {
+	_u.mutation.ClearSlots()
 	return _u
 }
 
-// RemovePokemonIDs removes the "pokemons" edge to Pokemon entities by IDs.
-func (_u *BundleUpdate) RemovePokemonIDs(ids ...int) *BundleUpdate {
-	_u.mutation.RemovePokemonIDs(ids...)
+// RemoveSlotIDs removes the "slots" edge to BundleSlot entities by IDs.
+func (_u *BundleUpdate) RemoveSlotIDs(ids ...int) *BundleUpdate {
+	_u.mutation.RemoveSlotIDs(ids...)
 	return _u
 }
 
-// RemovePokemons removes "pokemons" edges to Pokemon entities.
-func (_u *BundleUpdate) RemovePokemons(v ...*Pokemon) *BundleUpdate {
+// RemoveSlots removes "slots" edges to BundleSlot entities.
+func (_u *BundleUpdate) RemoveSlots(v ...*BundleSlot) *BundleUpdate {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemovePokemonIDs(ids...)
+	return _u.RemoveSlotIDs(ids...)
 }
 
 // Save executes the query and returns the number of nodes affected by the update operation.
@@ -188,7 +316,20 @@ func (_u *BundleUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *BundleUpdate) check() error {
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := bundle.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Bundle.visibility": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *BundleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(bundle.Table, bundle.Columns, sqlgraph.NewFieldSpec(bundle.FieldID, field.TypeInt))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -197,6 +338,21 @@ func (_u *BundleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(bundle.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.VisibilityChangedAt(); ok {
+		_spec.SetField(bundle.FieldVisibilityChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VisibilityChangedAtCleared() {
+		_spec.ClearField(bundle.FieldVisibilityChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VisibilityReason(); ok {
+		_spec.SetField(bundle.FieldVisibilityReason, field.TypeString, value)
+	}
+	if _u.mutation.VisibilityReasonCleared() {
+		_spec.ClearField(bundle.FieldVisibilityReason, field.TypeString)
+	}
 	if value, ok := _u.mutation.UploadDatetime(); ok {
 		_spec.SetField(bundle.FieldUploadDatetime, field.TypeTime, value)
 	}
@@ -213,33 +369,57 @@ func (_u *BundleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		_spec.SetField(bundle.FieldLegal, field.TypeBool, value)
 	}
 	if value, ok := _u.mutation.MinGen(); ok {
-		_spec.SetField(bundle.FieldMinGen, field.TypeString, value)
+		_spec.SetField(bundle.FieldMinGen, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMinGen(); ok {
+		_spec.AddField(bundle.FieldMinGen, field.TypeFloat64, value)
 	}
 	if value, ok := _u.mutation.MaxGen(); ok {
-		_spec.SetField(bundle.FieldMaxGen, field.TypeString, value)
+		_spec.SetField(bundle.FieldMaxGen, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxGen(); ok {
+		_spec.AddField(bundle.FieldMaxGen, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(bundle.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(bundle.FieldTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bundle.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bundle.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(bundle.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(bundle.FieldNotes, field.TypeString)
 	}
-	if _u.mutation.PokemonsCleared() {
+	if _u.mutation.SlotsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   bundle.PokemonsTable,
-			Columns: bundle.PokemonsPrimaryKey,
+			Table:   bundle.SlotsTable,
+			Columns: []string{bundle.SlotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bundleslot.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedPokemonsIDs(); len(nodes) > 0 && !_u.mutation.PokemonsCleared() {
+	if nodes := _u.mutation.RemovedSlotsIDs(); len(nodes) > 0 && !_u.mutation.SlotsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   bundle.PokemonsTable,
-			Columns: bundle.PokemonsPrimaryKey,
+			Table:   bundle.SlotsTable,
+			Columns: []string{bundle.SlotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bundleslot.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -247,15 +427,15 @@ func (_u *BundleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PokemonsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SlotsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   bundle.PokemonsTable,
-			Columns: bundle.PokemonsPrimaryKey,
+			Table:   bundle.SlotsTable,
+			Columns: []string{bundle.SlotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bundleslot.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -283,6 +463,60 @@ type BundleUpdateOne struct {
 	mutation *BundleMutation
 }
 
+// SetVisibility sets the "visibility" field.
+func (_u *BundleUpdateOne) SetVisibility(v bundle.Visibility) *BundleUpdateOne {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *BundleUpdateOne) SetNillableVisibility(v *bundle.Visibility) *BundleUpdateOne {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetVisibilityChangedAt sets the "visibility_changed_at" field.
+func (_u *BundleUpdateOne) SetVisibilityChangedAt(v time.Time) *BundleUpdateOne {
+	_u.mutation.SetVisibilityChangedAt(v)
+	return _u
+}
+
+// SetNillableVisibilityChangedAt sets the "visibility_changed_at" field if the given value is not nil.
+func (_u *BundleUpdateOne) SetNillableVisibilityChangedAt(v *time.Time) *BundleUpdateOne {
+	if v != nil {
+		_u.SetVisibilityChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearVisibilityChangedAt clears the value of the "visibility_changed_at" field.
+func (_u *BundleUpdateOne) ClearVisibilityChangedAt() *BundleUpdateOne {
+	_u.mutation.ClearVisibilityChangedAt()
+	return _u
+}
+
+// SetVisibilityReason sets the "visibility_reason" field.
+func (_u *BundleUpdateOne) SetVisibilityReason(v string) *BundleUpdateOne {
+	_u.mutation.SetVisibilityReason(v)
+	return _u
+}
+
+// SetNillableVisibilityReason sets the "visibility_reason" field if the given value is not nil.
+func (_u *BundleUpdateOne) SetNillableVisibilityReason(v *string) *BundleUpdateOne {
+	if v != nil {
+		_u.SetVisibilityReason(*v)
+	}
+	return _u
+}
+
+// ClearVisibilityReason clears the value of the "visibility_reason" field.
+func (_u *BundleUpdateOne) ClearVisibilityReason() *BundleUpdateOne {
+	_u.mutation.ClearVisibilityReason()
+	return _u
+}
+
 // SetUploadDatetime sets the "upload_datetime" field.
 func (_u *BundleUpdateOne) SetUploadDatetime(v time.Time) *BundleUpdateOne {
 	_u.mutation.SetUploadDatetime(v)
@@ -347,46 +581,120 @@ func (_u *BundleUpdateOne) SetNillableLegal(v *bool) *BundleUpdateOne {
 }
 
 // SetMinGen sets the "min_gen" field.
-func (_u *BundleUpdateOne) SetMinGen(v string) *BundleUpdateOne {
+func (_u *BundleUpdateOne) SetMinGen(v float64) *BundleUpdateOne {
+	_u.mutation.ResetMinGen()
 	_u.mutation.SetMinGen(v)
 	return _u
 }
 
 // SetNillableMinGen sets the "min_gen" field if the given value is not nil.
-func (_u *BundleUpdateOne) SetNillableMinGen(v *string) *BundleUpdateOne {
+func (_u *BundleUpdateOne) SetNillableMinGen(v *float64) *BundleUpdateOne {
 	if v != nil {
 		_u.SetMinGen(*v)
 	}
 	return _u
 }
 
+// AddMinGen adds value to the "min_gen" field.
+func (_u *BundleUpdateOne) AddMinGen(v float64) *BundleUpdateOne {
+	_u.mutation.AddMinGen(v)
+	return _u
+}
+
 // SetMaxGen sets the "max_gen" field.
-func (_u *BundleUpdateOne) SetMaxGen(v string) *BundleUpdateOne {
+func (_u *BundleUpdateOne) SetMaxGen(v float64) *BundleUpdateOne {
+	_u.mutation.ResetMaxGen()
 	_u.mutation.SetMaxGen(v)
 	return _u
 }
 
 // SetNillableMaxGen sets the "max_gen" field if the given value is not nil.
-func (_u *BundleUpdateOne) SetNillableMaxGen(v *string) *BundleUpdateOne {
+func (_u *BundleUpdateOne) SetNillableMaxGen(v *float64) *BundleUpdateOne {
 	if v != nil {
 		_u.SetMaxGen(*v)
 	}
 	return _u
 }
 
-// AddPokemonIDs adds the "pokemons" edge to the Pokemon entity by IDs.
-func (_u *BundleUpdateOne) AddPokemonIDs(ids ...int) *BundleUpdateOne {
-	_u.mutation.AddPokemonIDs(ids...)
+// AddMaxGen adds value to the "max_gen" field.
+func (_u *BundleUpdateOne) AddMaxGen(v float64) *BundleUpdateOne {
+	_u.mutation.AddMaxGen(v)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *BundleUpdateOne) SetTitle(v string) *BundleUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *BundleUpdateOne) SetNillableTitle(v *string) *BundleUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *BundleUpdateOne) ClearTitle() *BundleUpdateOne {
+	_u.mutation.ClearTitle()
 	return _u
 }
 
-// AddPokemons adds the "pokemons" edges to the Pokemon entity.
-func (_u *BundleUpdateOne) AddPokemons(v ...*Pokemon) *BundleUpdateOne {
+// SetDescription sets the "description" field.
+func (_u *BundleUpdateOne) SetDescription(v string) *BundleUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *BundleUpdateOne) SetNillableDescription(v *string) *BundleUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *BundleUpdateOne) ClearDescription() *BundleUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *BundleUpdateOne) SetNotes(v string) *BundleUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *BundleUpdateOne) SetNillableNotes(v *string) *BundleUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *BundleUpdateOne) ClearNotes() *BundleUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// AddSlotIDs adds the "slots" edge to the BundleSlot entity by IDs.
+func (_u *BundleUpdateOne) AddSlotIDs(ids ...int) *BundleUpdateOne {
+	_u.mutation.AddSlotIDs(ids...)
+	return _u
+}
+
+// AddSlots adds the "slots" edges to the BundleSlot entity.
+func (_u *BundleUpdateOne) AddSlots(v ...*BundleSlot) *BundleUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddPokemonIDs(ids...)
+	return _u.AddSlotIDs(ids...)
 }
 
 // Mutation returns the BundleMutation object of the builder.
@@ -394,25 +702,25 @@ func (_u *BundleUpdateOne) Mutation() *BundleMutation {
 	return _u.mutation
 }
 
-// ClearPokemons clears all "pokemons" edges to the Pokemon entity.
-func (_u *BundleUpdateOne) ClearPokemons() *BundleUpdateOne {
-	_u.mutation.ClearPokemons()
+// ClearSlots clears all "slots" edges to the BundleSlot entity.
+func (_u *BundleUpdateOne) ClearSlots() *BundleUpdateOne {
+	_u.mutation.ClearSlots()
 	return _u
 }
 
-// RemovePokemonIDs removes the "pokemons" edge to Pokemon entities by IDs.
-func (_u *BundleUpdateOne) RemovePokemonIDs(ids ...int) *BundleUpdateOne {
-	_u.mutation.RemovePokemonIDs(ids...)
+// RemoveSlotIDs removes the "slots" edge to BundleSlot entities by IDs.
+func (_u *BundleUpdateOne) RemoveSlotIDs(ids ...int) *BundleUpdateOne {
+	_u.mutation.RemoveSlotIDs(ids...)
 	return _u
 }
 
-// RemovePokemons removes "pokemons" edges to Pokemon entities.
-func (_u *BundleUpdateOne) RemovePokemons(v ...*Pokemon) *BundleUpdateOne {
+// RemoveSlots removes "slots" edges to BundleSlot entities.
+func (_u *BundleUpdateOne) RemoveSlots(v ...*BundleSlot) *BundleUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemovePokemonIDs(ids...)
+	return _u.RemoveSlotIDs(ids...)
 }
 
 // Where appends a list predicates to the BundleUpdate builder.
@@ -455,7 +763,20 @@ func (_u *BundleUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *BundleUpdateOne) check() error {
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := bundle.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Bundle.visibility": %w`, err)}
+		}
+	}
+	return nil
+}
+
 func (_u *BundleUpdateOne) sqlSave(ctx context.Context) (_node *Bundle, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(bundle.Table, bundle.Columns, sqlgraph.NewFieldSpec(bundle.FieldID, field.TypeInt))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -481,6 +802,21 @@ func (_u *BundleUpdateOne) sqlSave(ctx context.Context) (_node *Bundle, err erro
 			}
 		}
 	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(bundle.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.VisibilityChangedAt(); ok {
+		_spec.SetField(bundle.FieldVisibilityChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VisibilityChangedAtCleared() {
+		_spec.ClearField(bundle.FieldVisibilityChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VisibilityReason(); ok {
+		_spec.SetField(bundle.FieldVisibilityReason, field.TypeString, value)
+	}
+	if _u.mutation.VisibilityReasonCleared() {
+		_spec.ClearField(bundle.FieldVisibilityReason, field.TypeString)
+	}
 	if value, ok := _u.mutation.UploadDatetime(); ok {
 		_spec.SetField(bundle.FieldUploadDatetime, field.TypeTime, value)
 	}
@@ -497,33 +833,57 @@ func (_u *BundleUpdateOne) sqlSave(ctx context.Context) (_node *Bundle, err erro
 		_spec.SetField(bundle.FieldLegal, field.TypeBool, value)
 	}
 	if value, ok := _u.mutation.MinGen(); ok {
-		_spec.SetField(bundle.FieldMinGen, field.TypeString, value)
+		_spec.SetField(bundle.FieldMinGen, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMinGen(); ok {
+		_spec.AddField(bundle.FieldMinGen, field.TypeFloat64, value)
 	}
 	if value, ok := _u.mutation.MaxGen(); ok {
-		_spec.SetField(bundle.FieldMaxGen, field.TypeString, value)
+		_spec.SetField(bundle.FieldMaxGen, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxGen(); ok {
+		_spec.AddField(bundle.FieldMaxGen, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(bundle.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(bundle.FieldTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(bundle.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(bundle.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(bundle.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(bundle.FieldNotes, field.TypeString)
 	}
-	if _u.mutation.PokemonsCleared() {
+	if _u.mutation.SlotsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   bundle.PokemonsTable,
-			Columns: bundle.PokemonsPrimaryKey,
+			Table:   bundle.SlotsTable,
+			Columns: []string{bundle.SlotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bundleslot.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedPokemonsIDs(); len(nodes) > 0 && !_u.mutation.PokemonsCleared() {
+	if nodes := _u.mutation.RemovedSlotsIDs(); len(nodes) > 0 && !_u.mutation.SlotsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   bundle.PokemonsTable,
-			Columns: bundle.PokemonsPrimaryKey,
+			Table:   bundle.SlotsTable,
+			Columns: []string{bundle.SlotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bundleslot.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -531,15 +891,15 @@ func (_u *BundleUpdateOne) sqlSave(ctx context.Context) (_node *Bundle, err erro
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.PokemonsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SlotsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   bundle.PokemonsTable,
-			Columns: bundle.PokemonsPrimaryKey,
+			Table:   bundle.SlotsTable,
+			Columns: []string{bundle.SlotsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(bundleslot.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
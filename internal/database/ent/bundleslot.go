@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+)
+
+// BundleSlot is the model entity for the BundleSlot schema.
+type BundleSlot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// BundleID holds the value of the "bundle_id" field.
+	BundleID int `json:"bundle_id,omitempty"`
+	// PokemonID holds the value of the "pokemon_id" field.
+	PokemonID int `json:"pokemon_id,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// Generation holds the value of the "generation" field.
+	Generation string `json:"generation,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BundleSlotQuery when eager-loading is set.
+	Edges        BundleSlotEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BundleSlotEdges holds the relations/edges for other nodes in the graph.
+type BundleSlotEdges struct {
+	// Bundle holds the value of the bundle edge.
+	Bundle *Bundle `json:"bundle,omitempty"`
+	// Pokemon holds the value of the pokemon edge.
+	Pokemon *Pokemon `json:"pokemon,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// BundleOrErr returns the Bundle value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BundleSlotEdges) BundleOrErr() (*Bundle, error) {
+	if e.Bundle != nil {
+		return e.Bundle, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: bundle.Label}
+	}
+	return nil, &NotLoadedError{edge: "bundle"}
+}
+
+// PokemonOrErr returns the Pokemon value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BundleSlotEdges) PokemonOrErr() (*Pokemon, error) {
+	if e.Pokemon != nil {
+		return e.Pokemon, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: pokemon.Label}
+	}
+	return nil, &NotLoadedError{edge: "pokemon"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BundleSlot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bundleslot.FieldID, bundleslot.FieldBundleID, bundleslot.FieldPokemonID, bundleslot.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case bundleslot.FieldGeneration:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BundleSlot fields.
+func (_m *BundleSlot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bundleslot.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case bundleslot.FieldBundleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bundle_id", values[i])
+			} else if value.Valid {
+				_m.BundleID = int(value.Int64)
+			}
+		case bundleslot.FieldPokemonID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pokemon_id", values[i])
+			} else if value.Valid {
+				_m.PokemonID = int(value.Int64)
+			}
+		case bundleslot.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		case bundleslot.FieldGeneration:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field generation", values[i])
+			} else if value.Valid {
+				_m.Generation = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BundleSlot.
+// This includes values selected through modifiers, order, etc.
+func (_m *BundleSlot) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBundle queries the "bundle" edge of the BundleSlot entity.
+func (_m *BundleSlot) QueryBundle() *BundleQuery {
+	return NewBundleSlotClient(_m.config).QueryBundle(_m)
+}
+
+// QueryPokemon queries the "pokemon" edge of the BundleSlot entity.
+func (_m *BundleSlot) QueryPokemon() *PokemonQuery {
+	return NewBundleSlotClient(_m.config).QueryPokemon(_m)
+}
+
+// Update returns a builder for updating this BundleSlot.
+// Note that you need to call BundleSlot.Unwrap() before calling this method if this BundleSlot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BundleSlot) Update() *BundleSlotUpdateOne {
+	return NewBundleSlotClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BundleSlot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BundleSlot) Unwrap() *BundleSlot {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BundleSlot is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BundleSlot) String() string {
+	var builder strings.Builder
+	builder.WriteString("BundleSlot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("bundle_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BundleID))
+	builder.WriteString(", ")
+	builder.WriteString("pokemon_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PokemonID))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
+	builder.WriteString("generation=")
+	builder.WriteString(_m.Generation)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BundleSlots is a parsable slice of BundleSlot.
+type BundleSlots []*BundleSlot
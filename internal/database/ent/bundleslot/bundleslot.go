@@ -0,0 +1,123 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bundleslot
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the bundleslot type in the database.
+	Label = "bundle_slot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBundleID holds the string denoting the bundle_id field in the database.
+	FieldBundleID = "bundle_id"
+	// FieldPokemonID holds the string denoting the pokemon_id field in the database.
+	FieldPokemonID = "pokemon_id"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// FieldGeneration holds the string denoting the generation field in the database.
+	FieldGeneration = "generation"
+	// EdgeBundle holds the string denoting the bundle edge name in mutations.
+	EdgeBundle = "bundle"
+	// EdgePokemon holds the string denoting the pokemon edge name in mutations.
+	EdgePokemon = "pokemon"
+	// Table holds the table name of the bundleslot in the database.
+	Table = "bundle_slots"
+	// BundleTable is the table that holds the bundle relation/edge.
+	BundleTable = "bundle_slots"
+	// BundleInverseTable is the table name for the Bundle entity.
+	// It exists in this package in order to avoid circular dependency with the "bundle" package.
+	BundleInverseTable = "bundles"
+	// BundleColumn is the table column denoting the bundle relation/edge.
+	BundleColumn = "bundle_id"
+	// PokemonTable is the table that holds the pokemon relation/edge.
+	PokemonTable = "bundle_slots"
+	// PokemonInverseTable is the table name for the Pokemon entity.
+	// It exists in this package in order to avoid circular dependency with the "pokemon" package.
+	PokemonInverseTable = "pokemons"
+	// PokemonColumn is the table column denoting the pokemon relation/edge.
+	PokemonColumn = "pokemon_id"
+)
+
+// Columns holds all SQL columns for bundleslot fields.
+var Columns = []string{
+	FieldID,
+	FieldBundleID,
+	FieldPokemonID,
+	FieldPosition,
+	FieldGeneration,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	PositionValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the BundleSlot queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBundleID orders the results by the bundle_id field.
+func ByBundleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBundleID, opts...).ToFunc()
+}
+
+// ByPokemonID orders the results by the pokemon_id field.
+func ByPokemonID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPokemonID, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByGeneration orders the results by the generation field.
+func ByGeneration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGeneration, opts...).ToFunc()
+}
+
+// ByBundleField orders the results by bundle field.
+func ByBundleField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBundleStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPokemonField orders the results by pokemon field.
+func ByPokemonField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPokemonStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBundleStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BundleInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BundleTable, BundleColumn),
+	)
+}
+func newPokemonStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PokemonInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PokemonTable, PokemonColumn),
+	)
+}
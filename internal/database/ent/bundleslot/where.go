@@ -0,0 +1,280 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bundleslot
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldLTE(FieldID, id))
+}
+
+// BundleID applies equality check predicate on the "bundle_id" field. It's identical to BundleIDEQ.
+func BundleID(v int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldEQ(FieldBundleID, v))
+}
+
+// PokemonID applies equality check predicate on the "pokemon_id" field. It's identical to PokemonIDEQ.
+func PokemonID(v int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldEQ(FieldPokemonID, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldEQ(FieldPosition, v))
+}
+
+// Generation applies equality check predicate on the "generation" field. It's identical to GenerationEQ.
+func Generation(v string) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldEQ(FieldGeneration, v))
+}
+
+// BundleIDEQ applies the EQ predicate on the "bundle_id" field.
+func BundleIDEQ(v int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldEQ(FieldBundleID, v))
+}
+
+// BundleIDNEQ applies the NEQ predicate on the "bundle_id" field.
+func BundleIDNEQ(v int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldNEQ(FieldBundleID, v))
+}
+
+// BundleIDIn applies the In predicate on the "bundle_id" field.
+func BundleIDIn(vs ...int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldIn(FieldBundleID, vs...))
+}
+
+// BundleIDNotIn applies the NotIn predicate on the "bundle_id" field.
+func BundleIDNotIn(vs ...int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldNotIn(FieldBundleID, vs...))
+}
+
+// PokemonIDEQ applies the EQ predicate on the "pokemon_id" field.
+func PokemonIDEQ(v int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldEQ(FieldPokemonID, v))
+}
+
+// PokemonIDNEQ applies the NEQ predicate on the "pokemon_id" field.
+func PokemonIDNEQ(v int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldNEQ(FieldPokemonID, v))
+}
+
+// PokemonIDIn applies the In predicate on the "pokemon_id" field.
+func PokemonIDIn(vs ...int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldIn(FieldPokemonID, vs...))
+}
+
+// PokemonIDNotIn applies the NotIn predicate on the "pokemon_id" field.
+func PokemonIDNotIn(vs ...int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldNotIn(FieldPokemonID, vs...))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldLTE(FieldPosition, v))
+}
+
+// GenerationEQ applies the EQ predicate on the "generation" field.
+func GenerationEQ(v string) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldEQ(FieldGeneration, v))
+}
+
+// GenerationNEQ applies the NEQ predicate on the "generation" field.
+func GenerationNEQ(v string) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldNEQ(FieldGeneration, v))
+}
+
+// GenerationIn applies the In predicate on the "generation" field.
+func GenerationIn(vs ...string) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldIn(FieldGeneration, vs...))
+}
+
+// GenerationNotIn applies the NotIn predicate on the "generation" field.
+func GenerationNotIn(vs ...string) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldNotIn(FieldGeneration, vs...))
+}
+
+// GenerationGT applies the GT predicate on the "generation" field.
+func GenerationGT(v string) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldGT(FieldGeneration, v))
+}
+
+// GenerationGTE applies the GTE predicate on the "generation" field.
+func GenerationGTE(v string) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldGTE(FieldGeneration, v))
+}
+
+// GenerationLT applies the LT predicate on the "generation" field.
+func GenerationLT(v string) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldLT(FieldGeneration, v))
+}
+
+// GenerationLTE applies the LTE predicate on the "generation" field.
+func GenerationLTE(v string) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldLTE(FieldGeneration, v))
+}
+
+// GenerationContains applies the Contains predicate on the "generation" field.
+func GenerationContains(v string) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldContains(FieldGeneration, v))
+}
+
+// GenerationHasPrefix applies the HasPrefix predicate on the "generation" field.
+func GenerationHasPrefix(v string) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldHasPrefix(FieldGeneration, v))
+}
+
+// GenerationHasSuffix applies the HasSuffix predicate on the "generation" field.
+func GenerationHasSuffix(v string) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldHasSuffix(FieldGeneration, v))
+}
+
+// GenerationEqualFold applies the EqualFold predicate on the "generation" field.
+func GenerationEqualFold(v string) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldEqualFold(FieldGeneration, v))
+}
+
+// GenerationContainsFold applies the ContainsFold predicate on the "generation" field.
+func GenerationContainsFold(v string) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.FieldContainsFold(FieldGeneration, v))
+}
+
+// HasBundle applies the HasEdge predicate on the "bundle" edge.
+func HasBundle() predicate.BundleSlot {
+	return predicate.BundleSlot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BundleTable, BundleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBundleWith applies the HasEdge predicate on the "bundle" edge with a given conditions (other predicates).
+func HasBundleWith(preds ...predicate.Bundle) predicate.BundleSlot {
+	return predicate.BundleSlot(func(s *sql.Selector) {
+		step := newBundleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPokemon applies the HasEdge predicate on the "pokemon" edge.
+func HasPokemon() predicate.BundleSlot {
+	return predicate.BundleSlot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PokemonTable, PokemonColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPokemonWith applies the HasEdge predicate on the "pokemon" edge with a given conditions (other predicates).
+func HasPokemonWith(preds ...predicate.Pokemon) predicate.BundleSlot {
+	return predicate.BundleSlot(func(s *sql.Selector) {
+		step := newPokemonStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BundleSlot) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BundleSlot) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BundleSlot) predicate.BundleSlot {
+	return predicate.BundleSlot(sql.NotPredicates(p))
+}
@@ -0,0 +1,271 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+)
+
+// BundleSlotCreate is the builder for creating a BundleSlot entity.
+type BundleSlotCreate struct {
+	config
+	mutation *BundleSlotMutation
+	hooks    []Hook
+}
+
+// SetBundleID sets the "bundle_id" field.
+func (_c *BundleSlotCreate) SetBundleID(v int) *BundleSlotCreate {
+	_c.mutation.SetBundleID(v)
+	return _c
+}
+
+// SetPokemonID sets the "pokemon_id" field.
+func (_c *BundleSlotCreate) SetPokemonID(v int) *BundleSlotCreate {
+	_c.mutation.SetPokemonID(v)
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *BundleSlotCreate) SetPosition(v int) *BundleSlotCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetGeneration sets the "generation" field.
+func (_c *BundleSlotCreate) SetGeneration(v string) *BundleSlotCreate {
+	_c.mutation.SetGeneration(v)
+	return _c
+}
+
+// SetBundle sets the "bundle" edge to the Bundle entity.
+func (_c *BundleSlotCreate) SetBundle(v *Bundle) *BundleSlotCreate {
+	return _c.SetBundleID(v.ID)
+}
+
+// SetPokemon sets the "pokemon" edge to the Pokemon entity.
+func (_c *BundleSlotCreate) SetPokemon(v *Pokemon) *BundleSlotCreate {
+	return _c.SetPokemonID(v.ID)
+}
+
+// Mutation returns the BundleSlotMutation object of the builder.
+func (_c *BundleSlotCreate) Mutation() *BundleSlotMutation {
+	return _c.mutation
+}
+
+// Save creates the BundleSlot in the database.
+func (_c *BundleSlotCreate) Save(ctx context.Context) (*BundleSlot, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BundleSlotCreate) SaveX(ctx context.Context) *BundleSlot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BundleSlotCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BundleSlotCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BundleSlotCreate) check() error {
+	if _, ok := _c.mutation.BundleID(); !ok {
+		return &ValidationError{Name: "bundle_id", err: errors.New(`ent: missing required field "BundleSlot.bundle_id"`)}
+	}
+	if _, ok := _c.mutation.PokemonID(); !ok {
+		return &ValidationError{Name: "pokemon_id", err: errors.New(`ent: missing required field "BundleSlot.pokemon_id"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "BundleSlot.position"`)}
+	}
+	if v, ok := _c.mutation.Position(); ok {
+		if err := bundleslot.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "BundleSlot.position": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Generation(); !ok {
+		return &ValidationError{Name: "generation", err: errors.New(`ent: missing required field "BundleSlot.generation"`)}
+	}
+	if len(_c.mutation.BundleIDs()) == 0 {
+		return &ValidationError{Name: "bundle", err: errors.New(`ent: missing required edge "BundleSlot.bundle"`)}
+	}
+	if len(_c.mutation.PokemonIDs()) == 0 {
+		return &ValidationError{Name: "pokemon", err: errors.New(`ent: missing required edge "BundleSlot.pokemon"`)}
+	}
+	return nil
+}
+
+func (_c *BundleSlotCreate) sqlSave(ctx context.Context) (*BundleSlot, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BundleSlotCreate) createSpec() (*BundleSlot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BundleSlot{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bundleslot.Table, sqlgraph.NewFieldSpec(bundleslot.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(bundleslot.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.Generation(); ok {
+		_spec.SetField(bundleslot.FieldGeneration, field.TypeString, value)
+		_node.Generation = value
+	}
+	if nodes := _c.mutation.BundleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bundleslot.BundleTable,
+			Columns: []string{bundleslot.BundleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bundle.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BundleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PokemonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bundleslot.PokemonTable,
+			Columns: []string{bundleslot.PokemonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PokemonID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BundleSlotCreateBulk is the builder for creating many BundleSlot entities in bulk.
+type BundleSlotCreateBulk struct {
+	config
+	err      error
+	builders []*BundleSlotCreate
+}
+
+// Save creates the BundleSlot entities in the database.
+func (_c *BundleSlotCreateBulk) Save(ctx context.Context) ([]*BundleSlot, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BundleSlot, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BundleSlotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BundleSlotCreateBulk) SaveX(ctx context.Context) []*BundleSlot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BundleSlotCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BundleSlotCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/predicate"
+)
+
+// BundleSlotDelete is the builder for deleting a BundleSlot entity.
+type BundleSlotDelete struct {
+	config
+	hooks    []Hook
+	mutation *BundleSlotMutation
+}
+
+// Where appends a list predicates to the BundleSlotDelete builder.
+func (_d *BundleSlotDelete) Where(ps ...predicate.BundleSlot) *BundleSlotDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *BundleSlotDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BundleSlotDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *BundleSlotDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(bundleslot.Table, sqlgraph.NewFieldSpec(bundleslot.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// BundleSlotDeleteOne is the builder for deleting a single BundleSlot entity.
+type BundleSlotDeleteOne struct {
+	_d *BundleSlotDelete
+}
+
+// Where appends a list predicates to the BundleSlotDelete builder.
+func (_d *BundleSlotDeleteOne) Where(ps ...predicate.BundleSlot) *BundleSlotDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *BundleSlotDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{bundleslot.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *BundleSlotDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
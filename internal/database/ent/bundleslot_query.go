@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/predicate"
+)
+
+// BundleSlotQuery is the builder for querying BundleSlot entities.
+type BundleSlotQuery struct {
+	config
+	ctx         *QueryContext
+	order       []bundleslot.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.BundleSlot
+	withBundle  *BundleQuery
+	withPokemon *PokemonQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BundleSlotQuery builder.
+func (_q *BundleSlotQuery) Where(ps ...predicate.BundleSlot) *BundleSlotQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *BundleSlotQuery) Limit(limit int) *BundleSlotQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *BundleSlotQuery) Offset(offset int) *BundleSlotQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *BundleSlotQuery) Unique(unique bool) *BundleSlotQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *BundleSlotQuery) Order(o ...bundleslot.OrderOption) *BundleSlotQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryBundle chains the current query on the "bundle" edge.
+func (_q *BundleSlotQuery) QueryBundle() *BundleQuery {
+	query := (&BundleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bundleslot.Table, bundleslot.FieldID, selector),
+			sqlgraph.To(bundle.Table, bundle.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, bundleslot.BundleTable, bundleslot.BundleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPokemon chains the current query on the "pokemon" edge.
+func (_q *BundleSlotQuery) QueryPokemon() *PokemonQuery {
+	query := (&PokemonClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bundleslot.Table, bundleslot.FieldID, selector),
+			sqlgraph.To(pokemon.Table, pokemon.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, bundleslot.PokemonTable, bundleslot.PokemonColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first BundleSlot entity from the query.
+// Returns a *NotFoundError when no BundleSlot was found.
+func (_q *BundleSlotQuery) First(ctx context.Context) (*BundleSlot, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{bundleslot.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *BundleSlotQuery) FirstX(ctx context.Context) *BundleSlot {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BundleSlot ID from the query.
+// Returns a *NotFoundError when no BundleSlot ID was found.
+func (_q *BundleSlotQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{bundleslot.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *BundleSlotQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BundleSlot entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BundleSlot entity is found.
+// Returns a *NotFoundError when no BundleSlot entities are found.
+func (_q *BundleSlotQuery) Only(ctx context.Context) (*BundleSlot, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{bundleslot.Label}
+	default:
+		return nil, &NotSingularError{bundleslot.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *BundleSlotQuery) OnlyX(ctx context.Context) *BundleSlot {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BundleSlot ID in the query.
+// Returns a *NotSingularError when more than one BundleSlot ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *BundleSlotQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{bundleslot.Label}
+	default:
+		err = &NotSingularError{bundleslot.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *BundleSlotQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BundleSlots.
+func (_q *BundleSlotQuery) All(ctx context.Context) ([]*BundleSlot, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BundleSlot, *BundleSlotQuery]()
+	return withInterceptors[[]*BundleSlot](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *BundleSlotQuery) AllX(ctx context.Context) []*BundleSlot {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BundleSlot IDs.
+func (_q *BundleSlotQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(bundleslot.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *BundleSlotQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *BundleSlotQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*BundleSlotQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *BundleSlotQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *BundleSlotQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *BundleSlotQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BundleSlotQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *BundleSlotQuery) Clone() *BundleSlotQuery {
+	if _q == nil {
+		return nil
+	}
+	return &BundleSlotQuery{
+		config:      _q.config,
+		ctx:         _q.ctx.Clone(),
+		order:       append([]bundleslot.OrderOption{}, _q.order...),
+		inters:      append([]Interceptor{}, _q.inters...),
+		predicates:  append([]predicate.BundleSlot{}, _q.predicates...),
+		withBundle:  _q.withBundle.Clone(),
+		withPokemon: _q.withPokemon.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithBundle tells the query-builder to eager-load the nodes that are connected to
+// the "bundle" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BundleSlotQuery) WithBundle(opts ...func(*BundleQuery)) *BundleSlotQuery {
+	query := (&BundleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBundle = query
+	return _q
+}
+
+// WithPokemon tells the query-builder to eager-load the nodes that are connected to
+// the "pokemon" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BundleSlotQuery) WithPokemon(opts ...func(*PokemonQuery)) *BundleSlotQuery {
+	query := (&PokemonClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPokemon = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		BundleID int `json:"bundle_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BundleSlot.Query().
+//		GroupBy(bundleslot.FieldBundleID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *BundleSlotQuery) GroupBy(field string, fields ...string) *BundleSlotGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BundleSlotGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = bundleslot.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		BundleID int `json:"bundle_id,omitempty"`
+//	}
+//
+//	client.BundleSlot.Query().
+//		Select(bundleslot.FieldBundleID).
+//		Scan(ctx, &v)
+func (_q *BundleSlotQuery) Select(fields ...string) *BundleSlotSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &BundleSlotSelect{BundleSlotQuery: _q}
+	sbuild.label = bundleslot.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BundleSlotSelect configured with the given aggregations.
+func (_q *BundleSlotQuery) Aggregate(fns ...AggregateFunc) *BundleSlotSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *BundleSlotQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !bundleslot.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *BundleSlotQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BundleSlot, error) {
+	var (
+		nodes       = []*BundleSlot{}
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withBundle != nil,
+			_q.withPokemon != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BundleSlot).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BundleSlot{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withBundle; query != nil {
+		if err := _q.loadBundle(ctx, query, nodes, nil,
+			func(n *BundleSlot, e *Bundle) { n.Edges.Bundle = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPokemon; query != nil {
+		if err := _q.loadPokemon(ctx, query, nodes, nil,
+			func(n *BundleSlot, e *Pokemon) { n.Edges.Pokemon = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *BundleSlotQuery) loadBundle(ctx context.Context, query *BundleQuery, nodes []*BundleSlot, init func(*BundleSlot), assign func(*BundleSlot, *Bundle)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*BundleSlot)
+	for i := range nodes {
+		fk := nodes[i].BundleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(bundle.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "bundle_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BundleSlotQuery) loadPokemon(ctx context.Context, query *PokemonQuery, nodes []*BundleSlot, init func(*BundleSlot), assign func(*BundleSlot, *Pokemon)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*BundleSlot)
+	for i := range nodes {
+		fk := nodes[i].PokemonID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(pokemon.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "pokemon_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *BundleSlotQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *BundleSlotQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(bundleslot.Table, bundleslot.Columns, sqlgraph.NewFieldSpec(bundleslot.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bundleslot.FieldID)
+		for i := range fields {
+			if fields[i] != bundleslot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withBundle != nil {
+			_spec.Node.AddColumnOnce(bundleslot.FieldBundleID)
+		}
+		if _q.withPokemon != nil {
+			_spec.Node.AddColumnOnce(bundleslot.FieldPokemonID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *BundleSlotQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(bundleslot.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = bundleslot.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BundleSlotGroupBy is the group-by builder for BundleSlot entities.
+type BundleSlotGroupBy struct {
+	selector
+	build *BundleSlotQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *BundleSlotGroupBy) Aggregate(fns ...AggregateFunc) *BundleSlotGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *BundleSlotGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BundleSlotQuery, *BundleSlotGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *BundleSlotGroupBy) sqlScan(ctx context.Context, root *BundleSlotQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BundleSlotSelect is the builder for selecting fields of BundleSlot entities.
+type BundleSlotSelect struct {
+	*BundleSlotQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *BundleSlotSelect) Aggregate(fns ...AggregateFunc) *BundleSlotSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *BundleSlotSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BundleSlotQuery, *BundleSlotSelect](ctx, _s.BundleSlotQuery, _s, _s.inters, v)
+}
+
+func (_s *BundleSlotSelect) sqlScan(ctx context.Context, root *BundleSlotQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,519 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/predicate"
+)
+
+// BundleSlotUpdate is the builder for updating BundleSlot entities.
+type BundleSlotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BundleSlotMutation
+}
+
+// Where appends a list predicates to the BundleSlotUpdate builder.
+func (_u *BundleSlotUpdate) Where(ps ...predicate.BundleSlot) *BundleSlotUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetBundleID sets the "bundle_id" field.
+func (_u *BundleSlotUpdate) SetBundleID(v int) *BundleSlotUpdate {
+	_u.mutation.SetBundleID(v)
+	return _u
+}
+
+// SetNillableBundleID sets the "bundle_id" field if the given value is not nil.
+func (_u *BundleSlotUpdate) SetNillableBundleID(v *int) *BundleSlotUpdate {
+	if v != nil {
+		_u.SetBundleID(*v)
+	}
+	return _u
+}
+
+// SetPokemonID sets the "pokemon_id" field.
+func (_u *BundleSlotUpdate) SetPokemonID(v int) *BundleSlotUpdate {
+	_u.mutation.SetPokemonID(v)
+	return _u
+}
+
+// SetNillablePokemonID sets the "pokemon_id" field if the given value is not nil.
+func (_u *BundleSlotUpdate) SetNillablePokemonID(v *int) *BundleSlotUpdate {
+	if v != nil {
+		_u.SetPokemonID(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *BundleSlotUpdate) SetPosition(v int) *BundleSlotUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *BundleSlotUpdate) SetNillablePosition(v *int) *BundleSlotUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *BundleSlotUpdate) AddPosition(v int) *BundleSlotUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetGeneration sets the "generation" field.
+func (_u *BundleSlotUpdate) SetGeneration(v string) *BundleSlotUpdate {
+	_u.mutation.SetGeneration(v)
+	return _u
+}
+
+// SetNillableGeneration sets the "generation" field if the given value is not nil.
+func (_u *BundleSlotUpdate) SetNillableGeneration(v *string) *BundleSlotUpdate {
+	if v != nil {
+		_u.SetGeneration(*v)
+	}
+	return _u
+}
+
+// SetBundle sets the "bundle" edge to the Bundle entity.
+func (_u *BundleSlotUpdate) SetBundle(v *Bundle) *BundleSlotUpdate {
+	return _u.SetBundleID(v.ID)
+}
+
+// SetPokemon sets the "pokemon" edge to the Pokemon entity.
+func (_u *BundleSlotUpdate) SetPokemon(v *Pokemon) *BundleSlotUpdate {
+	return _u.SetPokemonID(v.ID)
+}
+
+// Mutation returns the BundleSlotMutation object of the builder.
+func (_u *BundleSlotUpdate) Mutation() *BundleSlotMutation {
+	return _u.mutation
+}
+
+// ClearBundle clears the "bundle" edge to the Bundle entity.
+func (_u *BundleSlotUpdate) ClearBundle() *BundleSlotUpdate {
+	_u.mutation.ClearBundle()
+	return _u
+}
+
+// ClearPokemon clears the "pokemon" edge to the Pokemon entity.
+func (_u *BundleSlotUpdate) ClearPokemon() *BundleSlotUpdate {
+	_u.mutation.ClearPokemon()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BundleSlotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BundleSlotUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BundleSlotUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BundleSlotUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BundleSlotUpdate) check() error {
+	if v, ok := _u.mutation.Position(); ok {
+		if err := bundleslot.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "BundleSlot.position": %w`, err)}
+		}
+	}
+	if _u.mutation.BundleCleared() && len(_u.mutation.BundleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BundleSlot.bundle"`)
+	}
+	if _u.mutation.PokemonCleared() && len(_u.mutation.PokemonIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BundleSlot.pokemon"`)
+	}
+	return nil
+}
+
+func (_u *BundleSlotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bundleslot.Table, bundleslot.Columns, sqlgraph.NewFieldSpec(bundleslot.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(bundleslot.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(bundleslot.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Generation(); ok {
+		_spec.SetField(bundleslot.FieldGeneration, field.TypeString, value)
+	}
+	if _u.mutation.BundleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bundleslot.BundleTable,
+			Columns: []string{bundleslot.BundleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bundle.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BundleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bundleslot.BundleTable,
+			Columns: []string{bundleslot.BundleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bundle.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PokemonCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bundleslot.PokemonTable,
+			Columns: []string{bundleslot.PokemonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PokemonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bundleslot.PokemonTable,
+			Columns: []string{bundleslot.PokemonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bundleslot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BundleSlotUpdateOne is the builder for updating a single BundleSlot entity.
+type BundleSlotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BundleSlotMutation
+}
+
+// SetBundleID sets the "bundle_id" field.
+func (_u *BundleSlotUpdateOne) SetBundleID(v int) *BundleSlotUpdateOne {
+	_u.mutation.SetBundleID(v)
+	return _u
+}
+
+// SetNillableBundleID sets the "bundle_id" field if the given value is not nil.
+func (_u *BundleSlotUpdateOne) SetNillableBundleID(v *int) *BundleSlotUpdateOne {
+	if v != nil {
+		_u.SetBundleID(*v)
+	}
+	return _u
+}
+
+// SetPokemonID sets the "pokemon_id" field.
+func (_u *BundleSlotUpdateOne) SetPokemonID(v int) *BundleSlotUpdateOne {
+	_u.mutation.SetPokemonID(v)
+	return _u
+}
+
+// SetNillablePokemonID sets the "pokemon_id" field if the given value is not nil.
+func (_u *BundleSlotUpdateOne) SetNillablePokemonID(v *int) *BundleSlotUpdateOne {
+	if v != nil {
+		_u.SetPokemonID(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *BundleSlotUpdateOne) SetPosition(v int) *BundleSlotUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *BundleSlotUpdateOne) SetNillablePosition(v *int) *BundleSlotUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *BundleSlotUpdateOne) AddPosition(v int) *BundleSlotUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetGeneration sets the "generation" field.
+func (_u *BundleSlotUpdateOne) SetGeneration(v string) *BundleSlotUpdateOne {
+	_u.mutation.SetGeneration(v)
+	return _u
+}
+
+// SetNillableGeneration sets the "generation" field if the given value is not nil.
+func (_u *BundleSlotUpdateOne) SetNillableGeneration(v *string) *BundleSlotUpdateOne {
+	if v != nil {
+		_u.SetGeneration(*v)
+	}
+	return _u
+}
+
+// SetBundle sets the "bundle" edge to the Bundle entity.
+func (_u *BundleSlotUpdateOne) SetBundle(v *Bundle) *BundleSlotUpdateOne {
+	return _u.SetBundleID(v.ID)
+}
+
+// SetPokemon sets the "pokemon" edge to the Pokemon entity.
+func (_u *BundleSlotUpdateOne) SetPokemon(v *Pokemon) *BundleSlotUpdateOne {
+	return _u.SetPokemonID(v.ID)
+}
+
+// Mutation returns the BundleSlotMutation object of the builder.
+func (_u *BundleSlotUpdateOne) Mutation() *BundleSlotMutation {
+	return _u.mutation
+}
+
+// ClearBundle clears the "bundle" edge to the Bundle entity.
+func (_u *BundleSlotUpdateOne) ClearBundle() *BundleSlotUpdateOne {
+	_u.mutation.ClearBundle()
+	return _u
+}
+
+// ClearPokemon clears the "pokemon" edge to the Pokemon entity.
+func (_u *BundleSlotUpdateOne) ClearPokemon() *BundleSlotUpdateOne {
+	_u.mutation.ClearPokemon()
+	return _u
+}
+
+// Where appends a list predicates to the BundleSlotUpdate builder.
+func (_u *BundleSlotUpdateOne) Where(ps ...predicate.BundleSlot) *BundleSlotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BundleSlotUpdateOne) Select(field string, fields ...string) *BundleSlotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BundleSlot entity.
+func (_u *BundleSlotUpdateOne) Save(ctx context.Context) (*BundleSlot, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BundleSlotUpdateOne) SaveX(ctx context.Context) *BundleSlot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BundleSlotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BundleSlotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BundleSlotUpdateOne) check() error {
+	if v, ok := _u.mutation.Position(); ok {
+		if err := bundleslot.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "BundleSlot.position": %w`, err)}
+		}
+	}
+	if _u.mutation.BundleCleared() && len(_u.mutation.BundleIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BundleSlot.bundle"`)
+	}
+	if _u.mutation.PokemonCleared() && len(_u.mutation.PokemonIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BundleSlot.pokemon"`)
+	}
+	return nil
+}
+
+func (_u *BundleSlotUpdateOne) sqlSave(ctx context.Context) (_node *BundleSlot, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bundleslot.Table, bundleslot.Columns, sqlgraph.NewFieldSpec(bundleslot.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BundleSlot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bundleslot.FieldID)
+		for _, f := range fields {
+			if !bundleslot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bundleslot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(bundleslot.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(bundleslot.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Generation(); ok {
+		_spec.SetField(bundleslot.FieldGeneration, field.TypeString, value)
+	}
+	if _u.mutation.BundleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bundleslot.BundleTable,
+			Columns: []string{bundleslot.BundleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bundle.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BundleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bundleslot.BundleTable,
+			Columns: []string{bundleslot.BundleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bundle.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PokemonCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bundleslot.PokemonTable,
+			Columns: []string{bundleslot.PokemonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PokemonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bundleslot.PokemonTable,
+			Columns: []string{bundleslot.PokemonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BundleSlot{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bundleslot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
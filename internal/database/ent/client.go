@@ -16,7 +16,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/legalitycache"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/recheckjob"
 )
 
 // Client is the client that holds all ent builders.
@@ -26,8 +29,14 @@ type Client struct {
 	Schema *migrate.Schema
 	// Bundle is the client for interacting with the Bundle builders.
 	Bundle *BundleClient
+	// BundleSlot is the client for interacting with the BundleSlot builders.
+	BundleSlot *BundleSlotClient
+	// LegalityCache is the client for interacting with the LegalityCache builders.
+	LegalityCache *LegalityCacheClient
 	// Pokemon is the client for interacting with the Pokemon builders.
 	Pokemon *PokemonClient
+	// RecheckJob is the client for interacting with the RecheckJob builders.
+	RecheckJob *RecheckJobClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -40,7 +49,10 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Bundle = NewBundleClient(c.config)
+	c.BundleSlot = NewBundleSlotClient(c.config)
+	c.LegalityCache = NewLegalityCacheClient(c.config)
 	c.Pokemon = NewPokemonClient(c.config)
+	c.RecheckJob = NewRecheckJobClient(c.config)
 }
 
 type (
@@ -131,10 +143,13 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Bundle:  NewBundleClient(cfg),
-		Pokemon: NewPokemonClient(cfg),
+		ctx:           ctx,
+		config:        cfg,
+		Bundle:        NewBundleClient(cfg),
+		BundleSlot:    NewBundleSlotClient(cfg),
+		LegalityCache: NewLegalityCacheClient(cfg),
+		Pokemon:       NewPokemonClient(cfg),
+		RecheckJob:    NewRecheckJobClient(cfg),
 	}, nil
 }
 
@@ -152,10 +167,13 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Bundle:  NewBundleClient(cfg),
-		Pokemon: NewPokemonClient(cfg),
+		ctx:           ctx,
+		config:        cfg,
+		Bundle:        NewBundleClient(cfg),
+		BundleSlot:    NewBundleSlotClient(cfg),
+		LegalityCache: NewLegalityCacheClient(cfg),
+		Pokemon:       NewPokemonClient(cfg),
+		RecheckJob:    NewRecheckJobClient(cfg),
 	}, nil
 }
 
@@ -185,14 +203,20 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	c.Bundle.Use(hooks...)
+	c.BundleSlot.Use(hooks...)
+	c.LegalityCache.Use(hooks...)
 	c.Pokemon.Use(hooks...)
+	c.RecheckJob.Use(hooks...)
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	c.Bundle.Intercept(interceptors...)
+	c.BundleSlot.Intercept(interceptors...)
+	c.LegalityCache.Intercept(interceptors...)
 	c.Pokemon.Intercept(interceptors...)
+	c.RecheckJob.Intercept(interceptors...)
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -200,8 +224,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *BundleMutation:
 		return c.Bundle.mutate(ctx, m)
+	case *BundleSlotMutation:
+		return c.BundleSlot.mutate(ctx, m)
+	case *LegalityCacheMutation:
+		return c.LegalityCache.mutate(ctx, m)
 	case *PokemonMutation:
 		return c.Pokemon.mutate(ctx, m)
+	case *RecheckJobMutation:
+		return c.RecheckJob.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -315,15 +345,15 @@ func (c *BundleClient) GetX(ctx context.Context, id int) *Bundle {
 	return obj
 }
 
-// QueryPokemons queries the pokemons edge of a Bundle.
-func (c *BundleClient) QueryPokemons(_m *Bundle) *PokemonQuery {
-	query := (&PokemonClient{config: c.config}).Query()
+// QuerySlots queries the slots edge of a Bundle.
+func (c *BundleClient) QuerySlots(_m *Bundle) *BundleSlotQuery {
+	query := (&BundleSlotClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(bundle.Table, bundle.FieldID, id),
-			sqlgraph.To(pokemon.Table, pokemon.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, false, bundle.PokemonsTable, bundle.PokemonsPrimaryKey...),
+			sqlgraph.To(bundleslot.Table, bundleslot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, bundle.SlotsTable, bundle.SlotsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -356,6 +386,304 @@ func (c *BundleClient) mutate(ctx context.Context, m *BundleMutation) (Value, er
 	}
 }
 
+// BundleSlotClient is a client for the BundleSlot schema.
+type BundleSlotClient struct {
+	config
+}
+
+// NewBundleSlotClient returns a client for the BundleSlot from the given config.
+func NewBundleSlotClient(c config) *BundleSlotClient {
+	return &BundleSlotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bundleslot.Hooks(f(g(h())))`.
+func (c *BundleSlotClient) Use(hooks ...Hook) {
+	c.hooks.BundleSlot = append(c.hooks.BundleSlot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bundleslot.Intercept(f(g(h())))`.
+func (c *BundleSlotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BundleSlot = append(c.inters.BundleSlot, interceptors...)
+}
+
+// Create returns a builder for creating a BundleSlot entity.
+func (c *BundleSlotClient) Create() *BundleSlotCreate {
+	mutation := newBundleSlotMutation(c.config, OpCreate)
+	return &BundleSlotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BundleSlot entities.
+func (c *BundleSlotClient) CreateBulk(builders ...*BundleSlotCreate) *BundleSlotCreateBulk {
+	return &BundleSlotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BundleSlotClient) MapCreateBulk(slice any, setFunc func(*BundleSlotCreate, int)) *BundleSlotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BundleSlotCreateBulk{err: fmt.Errorf("calling to BundleSlotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BundleSlotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BundleSlotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BundleSlot.
+func (c *BundleSlotClient) Update() *BundleSlotUpdate {
+	mutation := newBundleSlotMutation(c.config, OpUpdate)
+	return &BundleSlotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BundleSlotClient) UpdateOne(_m *BundleSlot) *BundleSlotUpdateOne {
+	mutation := newBundleSlotMutation(c.config, OpUpdateOne, withBundleSlot(_m))
+	return &BundleSlotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BundleSlotClient) UpdateOneID(id int) *BundleSlotUpdateOne {
+	mutation := newBundleSlotMutation(c.config, OpUpdateOne, withBundleSlotID(id))
+	return &BundleSlotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BundleSlot.
+func (c *BundleSlotClient) Delete() *BundleSlotDelete {
+	mutation := newBundleSlotMutation(c.config, OpDelete)
+	return &BundleSlotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BundleSlotClient) DeleteOne(_m *BundleSlot) *BundleSlotDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BundleSlotClient) DeleteOneID(id int) *BundleSlotDeleteOne {
+	builder := c.Delete().Where(bundleslot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BundleSlotDeleteOne{builder}
+}
+
+// Query returns a query builder for BundleSlot.
+func (c *BundleSlotClient) Query() *BundleSlotQuery {
+	return &BundleSlotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBundleSlot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BundleSlot entity by its id.
+func (c *BundleSlotClient) Get(ctx context.Context, id int) (*BundleSlot, error) {
+	return c.Query().Where(bundleslot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BundleSlotClient) GetX(ctx context.Context, id int) *BundleSlot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBundle queries the bundle edge of a BundleSlot.
+func (c *BundleSlotClient) QueryBundle(_m *BundleSlot) *BundleQuery {
+	query := (&BundleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bundleslot.Table, bundleslot.FieldID, id),
+			sqlgraph.To(bundle.Table, bundle.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, bundleslot.BundleTable, bundleslot.BundleColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPokemon queries the pokemon edge of a BundleSlot.
+func (c *BundleSlotClient) QueryPokemon(_m *BundleSlot) *PokemonQuery {
+	query := (&PokemonClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bundleslot.Table, bundleslot.FieldID, id),
+			sqlgraph.To(pokemon.Table, pokemon.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, bundleslot.PokemonTable, bundleslot.PokemonColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BundleSlotClient) Hooks() []Hook {
+	return c.hooks.BundleSlot
+}
+
+// Interceptors returns the client interceptors.
+func (c *BundleSlotClient) Interceptors() []Interceptor {
+	return c.inters.BundleSlot
+}
+
+func (c *BundleSlotClient) mutate(ctx context.Context, m *BundleSlotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BundleSlotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BundleSlotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BundleSlotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BundleSlotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BundleSlot mutation op: %q", m.Op())
+	}
+}
+
+// LegalityCacheClient is a client for the LegalityCache schema.
+type LegalityCacheClient struct {
+	config
+}
+
+// NewLegalityCacheClient returns a client for the LegalityCache from the given config.
+func NewLegalityCacheClient(c config) *LegalityCacheClient {
+	return &LegalityCacheClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `legalitycache.Hooks(f(g(h())))`.
+func (c *LegalityCacheClient) Use(hooks ...Hook) {
+	c.hooks.LegalityCache = append(c.hooks.LegalityCache, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `legalitycache.Intercept(f(g(h())))`.
+func (c *LegalityCacheClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LegalityCache = append(c.inters.LegalityCache, interceptors...)
+}
+
+// Create returns a builder for creating a LegalityCache entity.
+func (c *LegalityCacheClient) Create() *LegalityCacheCreate {
+	mutation := newLegalityCacheMutation(c.config, OpCreate)
+	return &LegalityCacheCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LegalityCache entities.
+func (c *LegalityCacheClient) CreateBulk(builders ...*LegalityCacheCreate) *LegalityCacheCreateBulk {
+	return &LegalityCacheCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LegalityCacheClient) MapCreateBulk(slice any, setFunc func(*LegalityCacheCreate, int)) *LegalityCacheCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LegalityCacheCreateBulk{err: fmt.Errorf("calling to LegalityCacheClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LegalityCacheCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LegalityCacheCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LegalityCache.
+func (c *LegalityCacheClient) Update() *LegalityCacheUpdate {
+	mutation := newLegalityCacheMutation(c.config, OpUpdate)
+	return &LegalityCacheUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LegalityCacheClient) UpdateOne(_m *LegalityCache) *LegalityCacheUpdateOne {
+	mutation := newLegalityCacheMutation(c.config, OpUpdateOne, withLegalityCache(_m))
+	return &LegalityCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LegalityCacheClient) UpdateOneID(id int) *LegalityCacheUpdateOne {
+	mutation := newLegalityCacheMutation(c.config, OpUpdateOne, withLegalityCacheID(id))
+	return &LegalityCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LegalityCache.
+func (c *LegalityCacheClient) Delete() *LegalityCacheDelete {
+	mutation := newLegalityCacheMutation(c.config, OpDelete)
+	return &LegalityCacheDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LegalityCacheClient) DeleteOne(_m *LegalityCache) *LegalityCacheDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LegalityCacheClient) DeleteOneID(id int) *LegalityCacheDeleteOne {
+	builder := c.Delete().Where(legalitycache.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LegalityCacheDeleteOne{builder}
+}
+
+// Query returns a query builder for LegalityCache.
+func (c *LegalityCacheClient) Query() *LegalityCacheQuery {
+	return &LegalityCacheQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLegalityCache},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LegalityCache entity by its id.
+func (c *LegalityCacheClient) Get(ctx context.Context, id int) (*LegalityCache, error) {
+	return c.Query().Where(legalitycache.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LegalityCacheClient) GetX(ctx context.Context, id int) *LegalityCache {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LegalityCacheClient) Hooks() []Hook {
+	return c.hooks.LegalityCache
+}
+
+// Interceptors returns the client interceptors.
+func (c *LegalityCacheClient) Interceptors() []Interceptor {
+	return c.inters.LegalityCache
+}
+
+func (c *LegalityCacheClient) mutate(ctx context.Context, m *LegalityCacheMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LegalityCacheCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LegalityCacheUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LegalityCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LegalityCacheDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LegalityCache mutation op: %q", m.Op())
+	}
+}
+
 // PokemonClient is a client for the Pokemon schema.
 type PokemonClient struct {
 	config
@@ -464,15 +792,47 @@ func (c *PokemonClient) GetX(ctx context.Context, id int) *Pokemon {
 	return obj
 }
 
-// QueryBundles queries the bundles edge of a Pokemon.
-func (c *PokemonClient) QueryBundles(_m *Pokemon) *BundleQuery {
-	query := (&BundleClient{config: c.config}).Query()
+// QueryBundleSlots queries the bundle_slots edge of a Pokemon.
+func (c *PokemonClient) QueryBundleSlots(_m *Pokemon) *BundleSlotQuery {
+	query := (&BundleSlotClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(pokemon.Table, pokemon.FieldID, id),
-			sqlgraph.To(bundle.Table, bundle.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, pokemon.BundlesTable, pokemon.BundlesPrimaryKey...),
+			sqlgraph.To(bundleslot.Table, bundleslot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, pokemon.BundleSlotsTable, pokemon.BundleSlotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMergedInto queries the merged_into edge of a Pokemon.
+func (c *PokemonClient) QueryMergedInto(_m *Pokemon) *PokemonQuery {
+	query := (&PokemonClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pokemon.Table, pokemon.FieldID, id),
+			sqlgraph.To(pokemon.Table, pokemon.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pokemon.MergedIntoTable, pokemon.MergedIntoColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDuplicates queries the duplicates edge of a Pokemon.
+func (c *PokemonClient) QueryDuplicates(_m *Pokemon) *PokemonQuery {
+	query := (&PokemonClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pokemon.Table, pokemon.FieldID, id),
+			sqlgraph.To(pokemon.Table, pokemon.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, pokemon.DuplicatesTable, pokemon.DuplicatesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -505,12 +865,145 @@ func (c *PokemonClient) mutate(ctx context.Context, m *PokemonMutation) (Value,
 	}
 }
 
+// RecheckJobClient is a client for the RecheckJob schema.
+type RecheckJobClient struct {
+	config
+}
+
+// NewRecheckJobClient returns a client for the RecheckJob from the given config.
+func NewRecheckJobClient(c config) *RecheckJobClient {
+	return &RecheckJobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `recheckjob.Hooks(f(g(h())))`.
+func (c *RecheckJobClient) Use(hooks ...Hook) {
+	c.hooks.RecheckJob = append(c.hooks.RecheckJob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `recheckjob.Intercept(f(g(h())))`.
+func (c *RecheckJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RecheckJob = append(c.inters.RecheckJob, interceptors...)
+}
+
+// Create returns a builder for creating a RecheckJob entity.
+func (c *RecheckJobClient) Create() *RecheckJobCreate {
+	mutation := newRecheckJobMutation(c.config, OpCreate)
+	return &RecheckJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RecheckJob entities.
+func (c *RecheckJobClient) CreateBulk(builders ...*RecheckJobCreate) *RecheckJobCreateBulk {
+	return &RecheckJobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RecheckJobClient) MapCreateBulk(slice any, setFunc func(*RecheckJobCreate, int)) *RecheckJobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RecheckJobCreateBulk{err: fmt.Errorf("calling to RecheckJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RecheckJobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RecheckJobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RecheckJob.
+func (c *RecheckJobClient) Update() *RecheckJobUpdate {
+	mutation := newRecheckJobMutation(c.config, OpUpdate)
+	return &RecheckJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RecheckJobClient) UpdateOne(_m *RecheckJob) *RecheckJobUpdateOne {
+	mutation := newRecheckJobMutation(c.config, OpUpdateOne, withRecheckJob(_m))
+	return &RecheckJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RecheckJobClient) UpdateOneID(id int) *RecheckJobUpdateOne {
+	mutation := newRecheckJobMutation(c.config, OpUpdateOne, withRecheckJobID(id))
+	return &RecheckJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RecheckJob.
+func (c *RecheckJobClient) Delete() *RecheckJobDelete {
+	mutation := newRecheckJobMutation(c.config, OpDelete)
+	return &RecheckJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RecheckJobClient) DeleteOne(_m *RecheckJob) *RecheckJobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RecheckJobClient) DeleteOneID(id int) *RecheckJobDeleteOne {
+	builder := c.Delete().Where(recheckjob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RecheckJobDeleteOne{builder}
+}
+
+// Query returns a query builder for RecheckJob.
+func (c *RecheckJobClient) Query() *RecheckJobQuery {
+	return &RecheckJobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRecheckJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RecheckJob entity by its id.
+func (c *RecheckJobClient) Get(ctx context.Context, id int) (*RecheckJob, error) {
+	return c.Query().Where(recheckjob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RecheckJobClient) GetX(ctx context.Context, id int) *RecheckJob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RecheckJobClient) Hooks() []Hook {
+	return c.hooks.RecheckJob
+}
+
+// Interceptors returns the client interceptors.
+func (c *RecheckJobClient) Interceptors() []Interceptor {
+	return c.inters.RecheckJob
+}
+
+func (c *RecheckJobClient) mutate(ctx context.Context, m *RecheckJobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RecheckJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RecheckJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RecheckJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RecheckJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RecheckJob mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Bundle, Pokemon []ent.Hook
+		Bundle, BundleSlot, LegalityCache, Pokemon, RecheckJob []ent.Hook
 	}
 	inters struct {
-		Bundle, Pokemon []ent.Interceptor
+		Bundle, BundleSlot, LegalityCache, Pokemon, RecheckJob []ent.Interceptor
 	}
 )
@@ -13,7 +13,10 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/legalitycache"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/recheckjob"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -74,8 +77,11 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			bundle.Table:  bundle.ValidColumn,
-			pokemon.Table: pokemon.ValidColumn,
+			bundle.Table:        bundle.ValidColumn,
+			bundleslot.Table:    bundleslot.ValidColumn,
+			legalitycache.Table: legalitycache.ValidColumn,
+			pokemon.Table:       pokemon.ValidColumn,
+			recheckjob.Table:    recheckjob.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
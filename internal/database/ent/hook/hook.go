@@ -21,6 +21,30 @@ func (f BundleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BundleMutation", m)
 }
 
+// The BundleSlotFunc type is an adapter to allow the use of ordinary
+// function as BundleSlot mutator.
+type BundleSlotFunc func(context.Context, *ent.BundleSlotMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BundleSlotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BundleSlotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BundleSlotMutation", m)
+}
+
+// The LegalityCacheFunc type is an adapter to allow the use of ordinary
+// function as LegalityCache mutator.
+type LegalityCacheFunc func(context.Context, *ent.LegalityCacheMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LegalityCacheFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LegalityCacheMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LegalityCacheMutation", m)
+}
+
 // The PokemonFunc type is an adapter to allow the use of ordinary
 // function as Pokemon mutator.
 type PokemonFunc func(context.Context, *ent.PokemonMutation) (ent.Value, error)
@@ -33,6 +57,18 @@ func (f PokemonFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PokemonMutation", m)
 }
 
+// The RecheckJobFunc type is an adapter to allow the use of ordinary
+// function as RecheckJob mutator.
+type RecheckJobFunc func(context.Context, *ent.RecheckJobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RecheckJobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RecheckJobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RecheckJobMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
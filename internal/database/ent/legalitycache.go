@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/legalitycache"
+)
+
+// LegalityCache is the model entity for the LegalityCache schema.
+type LegalityCache struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash string `json:"hash,omitempty"`
+	// Generation holds the value of the "generation" field.
+	Generation string `json:"generation,omitempty"`
+	// EngineVersion holds the value of the "engine_version" field.
+	EngineVersion string `json:"engine_version,omitempty"`
+	// Legal holds the value of the "legal" field.
+	Legal bool `json:"legal,omitempty"`
+	// Report holds the value of the "report" field.
+	Report []string `json:"report,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LegalityCache) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case legalitycache.FieldReport:
+			values[i] = new([]byte)
+		case legalitycache.FieldLegal:
+			values[i] = new(sql.NullBool)
+		case legalitycache.FieldID:
+			values[i] = new(sql.NullInt64)
+		case legalitycache.FieldHash, legalitycache.FieldGeneration, legalitycache.FieldEngineVersion:
+			values[i] = new(sql.NullString)
+		case legalitycache.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LegalityCache fields.
+func (_m *LegalityCache) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case legalitycache.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case legalitycache.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
+		case legalitycache.FieldGeneration:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field generation", values[i])
+			} else if value.Valid {
+				_m.Generation = value.String
+			}
+		case legalitycache.FieldEngineVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field engine_version", values[i])
+			} else if value.Valid {
+				_m.EngineVersion = value.String
+			}
+		case legalitycache.FieldLegal:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field legal", values[i])
+			} else if value.Valid {
+				_m.Legal = value.Bool
+			}
+		case legalitycache.FieldReport:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field report", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Report); err != nil {
+					return fmt.Errorf("unmarshal field report: %w", err)
+				}
+			}
+		case legalitycache.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LegalityCache.
+// This includes values selected through modifiers, order, etc.
+func (_m *LegalityCache) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LegalityCache.
+// Note that you need to call LegalityCache.Unwrap() before calling this method if this LegalityCache
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LegalityCache) Update() *LegalityCacheUpdateOne {
+	return NewLegalityCacheClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LegalityCache entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LegalityCache) Unwrap() *LegalityCache {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LegalityCache is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LegalityCache) String() string {
+	var builder strings.Builder
+	builder.WriteString("LegalityCache(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("generation=")
+	builder.WriteString(_m.Generation)
+	builder.WriteString(", ")
+	builder.WriteString("engine_version=")
+	builder.WriteString(_m.EngineVersion)
+	builder.WriteString(", ")
+	builder.WriteString("legal=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Legal))
+	builder.WriteString(", ")
+	builder.WriteString("report=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Report))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LegalityCaches is a parsable slice of LegalityCache.
+type LegalityCaches []*LegalityCache
@@ -0,0 +1,82 @@
+// Code generated by ent, DO NOT EDIT.
+
+package legalitycache
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the legalitycache type in the database.
+	Label = "legality_cache"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldHash holds the string denoting the hash field in the database.
+	FieldHash = "hash"
+	// FieldGeneration holds the string denoting the generation field in the database.
+	FieldGeneration = "generation"
+	// FieldEngineVersion holds the string denoting the engine_version field in the database.
+	FieldEngineVersion = "engine_version"
+	// FieldLegal holds the string denoting the legal field in the database.
+	FieldLegal = "legal"
+	// FieldReport holds the string denoting the report field in the database.
+	FieldReport = "report"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the legalitycache in the database.
+	Table = "legality_caches"
+)
+
+// Columns holds all SQL columns for legalitycache fields.
+var Columns = []string{
+	FieldID,
+	FieldHash,
+	FieldGeneration,
+	FieldEngineVersion,
+	FieldLegal,
+	FieldReport,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the LegalityCache queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByHash orders the results by the hash field.
+func ByHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHash, opts...).ToFunc()
+}
+
+// ByGeneration orders the results by the generation field.
+func ByGeneration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGeneration, opts...).ToFunc()
+}
+
+// ByEngineVersion orders the results by the engine_version field.
+func ByEngineVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEngineVersion, opts...).ToFunc()
+}
+
+// ByLegal orders the results by the legal field.
+func ByLegal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLegal, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
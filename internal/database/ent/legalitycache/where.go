@@ -0,0 +1,340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package legalitycache
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldLTE(FieldID, id))
+}
+
+// Hash applies equality check predicate on the "hash" field. It's identical to HashEQ.
+func Hash(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldEQ(FieldHash, v))
+}
+
+// Generation applies equality check predicate on the "generation" field. It's identical to GenerationEQ.
+func Generation(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldEQ(FieldGeneration, v))
+}
+
+// EngineVersion applies equality check predicate on the "engine_version" field. It's identical to EngineVersionEQ.
+func EngineVersion(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldEQ(FieldEngineVersion, v))
+}
+
+// Legal applies equality check predicate on the "legal" field. It's identical to LegalEQ.
+func Legal(v bool) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldEQ(FieldLegal, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// HashEQ applies the EQ predicate on the "hash" field.
+func HashEQ(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldEQ(FieldHash, v))
+}
+
+// HashNEQ applies the NEQ predicate on the "hash" field.
+func HashNEQ(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldNEQ(FieldHash, v))
+}
+
+// HashIn applies the In predicate on the "hash" field.
+func HashIn(vs ...string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldIn(FieldHash, vs...))
+}
+
+// HashNotIn applies the NotIn predicate on the "hash" field.
+func HashNotIn(vs ...string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldNotIn(FieldHash, vs...))
+}
+
+// HashGT applies the GT predicate on the "hash" field.
+func HashGT(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldGT(FieldHash, v))
+}
+
+// HashGTE applies the GTE predicate on the "hash" field.
+func HashGTE(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldGTE(FieldHash, v))
+}
+
+// HashLT applies the LT predicate on the "hash" field.
+func HashLT(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldLT(FieldHash, v))
+}
+
+// HashLTE applies the LTE predicate on the "hash" field.
+func HashLTE(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldLTE(FieldHash, v))
+}
+
+// HashContains applies the Contains predicate on the "hash" field.
+func HashContains(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldContains(FieldHash, v))
+}
+
+// HashHasPrefix applies the HasPrefix predicate on the "hash" field.
+func HashHasPrefix(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldHasPrefix(FieldHash, v))
+}
+
+// HashHasSuffix applies the HasSuffix predicate on the "hash" field.
+func HashHasSuffix(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldHasSuffix(FieldHash, v))
+}
+
+// HashEqualFold applies the EqualFold predicate on the "hash" field.
+func HashEqualFold(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldEqualFold(FieldHash, v))
+}
+
+// HashContainsFold applies the ContainsFold predicate on the "hash" field.
+func HashContainsFold(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldContainsFold(FieldHash, v))
+}
+
+// GenerationEQ applies the EQ predicate on the "generation" field.
+func GenerationEQ(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldEQ(FieldGeneration, v))
+}
+
+// GenerationNEQ applies the NEQ predicate on the "generation" field.
+func GenerationNEQ(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldNEQ(FieldGeneration, v))
+}
+
+// GenerationIn applies the In predicate on the "generation" field.
+func GenerationIn(vs ...string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldIn(FieldGeneration, vs...))
+}
+
+// GenerationNotIn applies the NotIn predicate on the "generation" field.
+func GenerationNotIn(vs ...string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldNotIn(FieldGeneration, vs...))
+}
+
+// GenerationGT applies the GT predicate on the "generation" field.
+func GenerationGT(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldGT(FieldGeneration, v))
+}
+
+// GenerationGTE applies the GTE predicate on the "generation" field.
+func GenerationGTE(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldGTE(FieldGeneration, v))
+}
+
+// GenerationLT applies the LT predicate on the "generation" field.
+func GenerationLT(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldLT(FieldGeneration, v))
+}
+
+// GenerationLTE applies the LTE predicate on the "generation" field.
+func GenerationLTE(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldLTE(FieldGeneration, v))
+}
+
+// GenerationContains applies the Contains predicate on the "generation" field.
+func GenerationContains(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldContains(FieldGeneration, v))
+}
+
+// GenerationHasPrefix applies the HasPrefix predicate on the "generation" field.
+func GenerationHasPrefix(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldHasPrefix(FieldGeneration, v))
+}
+
+// GenerationHasSuffix applies the HasSuffix predicate on the "generation" field.
+func GenerationHasSuffix(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldHasSuffix(FieldGeneration, v))
+}
+
+// GenerationEqualFold applies the EqualFold predicate on the "generation" field.
+func GenerationEqualFold(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldEqualFold(FieldGeneration, v))
+}
+
+// GenerationContainsFold applies the ContainsFold predicate on the "generation" field.
+func GenerationContainsFold(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldContainsFold(FieldGeneration, v))
+}
+
+// EngineVersionEQ applies the EQ predicate on the "engine_version" field.
+func EngineVersionEQ(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldEQ(FieldEngineVersion, v))
+}
+
+// EngineVersionNEQ applies the NEQ predicate on the "engine_version" field.
+func EngineVersionNEQ(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldNEQ(FieldEngineVersion, v))
+}
+
+// EngineVersionIn applies the In predicate on the "engine_version" field.
+func EngineVersionIn(vs ...string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldIn(FieldEngineVersion, vs...))
+}
+
+// EngineVersionNotIn applies the NotIn predicate on the "engine_version" field.
+func EngineVersionNotIn(vs ...string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldNotIn(FieldEngineVersion, vs...))
+}
+
+// EngineVersionGT applies the GT predicate on the "engine_version" field.
+func EngineVersionGT(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldGT(FieldEngineVersion, v))
+}
+
+// EngineVersionGTE applies the GTE predicate on the "engine_version" field.
+func EngineVersionGTE(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldGTE(FieldEngineVersion, v))
+}
+
+// EngineVersionLT applies the LT predicate on the "engine_version" field.
+func EngineVersionLT(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldLT(FieldEngineVersion, v))
+}
+
+// EngineVersionLTE applies the LTE predicate on the "engine_version" field.
+func EngineVersionLTE(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldLTE(FieldEngineVersion, v))
+}
+
+// EngineVersionContains applies the Contains predicate on the "engine_version" field.
+func EngineVersionContains(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldContains(FieldEngineVersion, v))
+}
+
+// EngineVersionHasPrefix applies the HasPrefix predicate on the "engine_version" field.
+func EngineVersionHasPrefix(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldHasPrefix(FieldEngineVersion, v))
+}
+
+// EngineVersionHasSuffix applies the HasSuffix predicate on the "engine_version" field.
+func EngineVersionHasSuffix(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldHasSuffix(FieldEngineVersion, v))
+}
+
+// EngineVersionEqualFold applies the EqualFold predicate on the "engine_version" field.
+func EngineVersionEqualFold(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldEqualFold(FieldEngineVersion, v))
+}
+
+// EngineVersionContainsFold applies the ContainsFold predicate on the "engine_version" field.
+func EngineVersionContainsFold(v string) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldContainsFold(FieldEngineVersion, v))
+}
+
+// LegalEQ applies the EQ predicate on the "legal" field.
+func LegalEQ(v bool) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldEQ(FieldLegal, v))
+}
+
+// LegalNEQ applies the NEQ predicate on the "legal" field.
+func LegalNEQ(v bool) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldNEQ(FieldLegal, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LegalityCache) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LegalityCache) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LegalityCache) predicate.LegalityCache {
+	return predicate.LegalityCache(sql.NotPredicates(p))
+}
@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/legalitycache"
+)
+
+// LegalityCacheCreate is the builder for creating a LegalityCache entity.
+type LegalityCacheCreate struct {
+	config
+	mutation *LegalityCacheMutation
+	hooks    []Hook
+}
+
+// SetHash sets the "hash" field.
+func (_c *LegalityCacheCreate) SetHash(v string) *LegalityCacheCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetGeneration sets the "generation" field.
+func (_c *LegalityCacheCreate) SetGeneration(v string) *LegalityCacheCreate {
+	_c.mutation.SetGeneration(v)
+	return _c
+}
+
+// SetEngineVersion sets the "engine_version" field.
+func (_c *LegalityCacheCreate) SetEngineVersion(v string) *LegalityCacheCreate {
+	_c.mutation.SetEngineVersion(v)
+	return _c
+}
+
+// SetLegal sets the "legal" field.
+func (_c *LegalityCacheCreate) SetLegal(v bool) *LegalityCacheCreate {
+	_c.mutation.SetLegal(v)
+	return _c
+}
+
+// SetReport sets the "report" field.
+func (_c *LegalityCacheCreate) SetReport(v []string) *LegalityCacheCreate {
+	_c.mutation.SetReport(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LegalityCacheCreate) SetCreatedAt(v time.Time) *LegalityCacheCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// Mutation returns the LegalityCacheMutation object of the builder.
+func (_c *LegalityCacheCreate) Mutation() *LegalityCacheMutation {
+	return _c.mutation
+}
+
+// Save creates the LegalityCache in the database.
+func (_c *LegalityCacheCreate) Save(ctx context.Context) (*LegalityCache, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LegalityCacheCreate) SaveX(ctx context.Context) *LegalityCache {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LegalityCacheCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LegalityCacheCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LegalityCacheCreate) check() error {
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "LegalityCache.hash"`)}
+	}
+	if _, ok := _c.mutation.Generation(); !ok {
+		return &ValidationError{Name: "generation", err: errors.New(`ent: missing required field "LegalityCache.generation"`)}
+	}
+	if _, ok := _c.mutation.EngineVersion(); !ok {
+		return &ValidationError{Name: "engine_version", err: errors.New(`ent: missing required field "LegalityCache.engine_version"`)}
+	}
+	if _, ok := _c.mutation.Legal(); !ok {
+		return &ValidationError{Name: "legal", err: errors.New(`ent: missing required field "LegalityCache.legal"`)}
+	}
+	if _, ok := _c.mutation.Report(); !ok {
+		return &ValidationError{Name: "report", err: errors.New(`ent: missing required field "LegalityCache.report"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LegalityCache.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *LegalityCacheCreate) sqlSave(ctx context.Context) (*LegalityCache, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LegalityCacheCreate) createSpec() (*LegalityCache, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LegalityCache{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(legalitycache.Table, sqlgraph.NewFieldSpec(legalitycache.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(legalitycache.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.Generation(); ok {
+		_spec.SetField(legalitycache.FieldGeneration, field.TypeString, value)
+		_node.Generation = value
+	}
+	if value, ok := _c.mutation.EngineVersion(); ok {
+		_spec.SetField(legalitycache.FieldEngineVersion, field.TypeString, value)
+		_node.EngineVersion = value
+	}
+	if value, ok := _c.mutation.Legal(); ok {
+		_spec.SetField(legalitycache.FieldLegal, field.TypeBool, value)
+		_node.Legal = value
+	}
+	if value, ok := _c.mutation.Report(); ok {
+		_spec.SetField(legalitycache.FieldReport, field.TypeJSON, value)
+		_node.Report = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(legalitycache.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// LegalityCacheCreateBulk is the builder for creating many LegalityCache entities in bulk.
+type LegalityCacheCreateBulk struct {
+	config
+	err      error
+	builders []*LegalityCacheCreate
+}
+
+// Save creates the LegalityCache entities in the database.
+func (_c *LegalityCacheCreateBulk) Save(ctx context.Context) ([]*LegalityCache, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LegalityCache, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LegalityCacheMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LegalityCacheCreateBulk) SaveX(ctx context.Context) []*LegalityCache {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LegalityCacheCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LegalityCacheCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/legalitycache"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/predicate"
+)
+
+// LegalityCacheDelete is the builder for deleting a LegalityCache entity.
+type LegalityCacheDelete struct {
+	config
+	hooks    []Hook
+	mutation *LegalityCacheMutation
+}
+
+// Where appends a list predicates to the LegalityCacheDelete builder.
+func (_d *LegalityCacheDelete) Where(ps ...predicate.LegalityCache) *LegalityCacheDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *LegalityCacheDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *LegalityCacheDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *LegalityCacheDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(legalitycache.Table, sqlgraph.NewFieldSpec(legalitycache.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// LegalityCacheDeleteOne is the builder for deleting a single LegalityCache entity.
+type LegalityCacheDeleteOne struct {
+	_d *LegalityCacheDelete
+}
+
+// Where appends a list predicates to the LegalityCacheDelete builder.
+func (_d *LegalityCacheDeleteOne) Where(ps ...predicate.LegalityCache) *LegalityCacheDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *LegalityCacheDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{legalitycache.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *LegalityCacheDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
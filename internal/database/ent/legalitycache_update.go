@@ -0,0 +1,387 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/legalitycache"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/predicate"
+)
+
+// LegalityCacheUpdate is the builder for updating LegalityCache entities.
+type LegalityCacheUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LegalityCacheMutation
+}
+
+// Where appends a list predicates to the LegalityCacheUpdate builder.
+func (_u *LegalityCacheUpdate) Where(ps ...predicate.LegalityCache) *LegalityCacheUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetHash sets the "hash" field.
+func (_u *LegalityCacheUpdate) SetHash(v string) *LegalityCacheUpdate {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *LegalityCacheUpdate) SetNillableHash(v *string) *LegalityCacheUpdate {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetGeneration sets the "generation" field.
+func (_u *LegalityCacheUpdate) SetGeneration(v string) *LegalityCacheUpdate {
+	_u.mutation.SetGeneration(v)
+	return _u
+}
+
+// SetNillableGeneration sets the "generation" field if the given value is not nil.
+func (_u *LegalityCacheUpdate) SetNillableGeneration(v *string) *LegalityCacheUpdate {
+	if v != nil {
+		_u.SetGeneration(*v)
+	}
+	return _u
+}
+
+// SetEngineVersion sets the "engine_version" field.
+func (_u *LegalityCacheUpdate) SetEngineVersion(v string) *LegalityCacheUpdate {
+	_u.mutation.SetEngineVersion(v)
+	return _u
+}
+
+// SetNillableEngineVersion sets the "engine_version" field if the given value is not nil.
+func (_u *LegalityCacheUpdate) SetNillableEngineVersion(v *string) *LegalityCacheUpdate {
+	if v != nil {
+		_u.SetEngineVersion(*v)
+	}
+	return _u
+}
+
+// SetLegal sets the "legal" field.
+func (_u *LegalityCacheUpdate) SetLegal(v bool) *LegalityCacheUpdate {
+	_u.mutation.SetLegal(v)
+	return _u
+}
+
+// SetNillableLegal sets the "legal" field if the given value is not nil.
+func (_u *LegalityCacheUpdate) SetNillableLegal(v *bool) *LegalityCacheUpdate {
+	if v != nil {
+		_u.SetLegal(*v)
+	}
+	return _u
+}
+
+// SetReport sets the "report" field.
+func (_u *LegalityCacheUpdate) SetReport(v []string) *LegalityCacheUpdate {
+	_u.mutation.SetReport(v)
+	return _u
+}
+
+// AppendReport appends value to the "report" field.
+func (_u *LegalityCacheUpdate) AppendReport(v []string) *LegalityCacheUpdate {
+	_u.mutation.AppendReport(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LegalityCacheUpdate) SetCreatedAt(v time.Time) *LegalityCacheUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LegalityCacheUpdate) SetNillableCreatedAt(v *time.Time) *LegalityCacheUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LegalityCacheMutation object of the builder.
+func (_u *LegalityCacheUpdate) Mutation() *LegalityCacheMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LegalityCacheUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LegalityCacheUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LegalityCacheUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LegalityCacheUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *LegalityCacheUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(legalitycache.Table, legalitycache.Columns, sqlgraph.NewFieldSpec(legalitycache.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(legalitycache.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Generation(); ok {
+		_spec.SetField(legalitycache.FieldGeneration, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EngineVersion(); ok {
+		_spec.SetField(legalitycache.FieldEngineVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Legal(); ok {
+		_spec.SetField(legalitycache.FieldLegal, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Report(); ok {
+		_spec.SetField(legalitycache.FieldReport, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedReport(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, legalitycache.FieldReport, value)
+		})
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(legalitycache.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{legalitycache.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LegalityCacheUpdateOne is the builder for updating a single LegalityCache entity.
+type LegalityCacheUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LegalityCacheMutation
+}
+
+// SetHash sets the "hash" field.
+func (_u *LegalityCacheUpdateOne) SetHash(v string) *LegalityCacheUpdateOne {
+	_u.mutation.SetHash(v)
+	return _u
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (_u *LegalityCacheUpdateOne) SetNillableHash(v *string) *LegalityCacheUpdateOne {
+	if v != nil {
+		_u.SetHash(*v)
+	}
+	return _u
+}
+
+// SetGeneration sets the "generation" field.
+func (_u *LegalityCacheUpdateOne) SetGeneration(v string) *LegalityCacheUpdateOne {
+	_u.mutation.SetGeneration(v)
+	return _u
+}
+
+// SetNillableGeneration sets the "generation" field if the given value is not nil.
+func (_u *LegalityCacheUpdateOne) SetNillableGeneration(v *string) *LegalityCacheUpdateOne {
+	if v != nil {
+		_u.SetGeneration(*v)
+	}
+	return _u
+}
+
+// SetEngineVersion sets the "engine_version" field.
+func (_u *LegalityCacheUpdateOne) SetEngineVersion(v string) *LegalityCacheUpdateOne {
+	_u.mutation.SetEngineVersion(v)
+	return _u
+}
+
+// SetNillableEngineVersion sets the "engine_version" field if the given value is not nil.
+func (_u *LegalityCacheUpdateOne) SetNillableEngineVersion(v *string) *LegalityCacheUpdateOne {
+	if v != nil {
+		_u.SetEngineVersion(*v)
+	}
+	return _u
+}
+
+// SetLegal sets the "legal" field.
+func (_u *LegalityCacheUpdateOne) SetLegal(v bool) *LegalityCacheUpdateOne {
+	_u.mutation.SetLegal(v)
+	return _u
+}
+
+// SetNillableLegal sets the "legal" field if the given value is not nil.
+func (_u *LegalityCacheUpdateOne) SetNillableLegal(v *bool) *LegalityCacheUpdateOne {
+	if v != nil {
+		_u.SetLegal(*v)
+	}
+	return _u
+}
+
+// SetReport sets the "report" field.
+func (_u *LegalityCacheUpdateOne) SetReport(v []string) *LegalityCacheUpdateOne {
+	_u.mutation.SetReport(v)
+	return _u
+}
+
+// AppendReport appends value to the "report" field.
+func (_u *LegalityCacheUpdateOne) AppendReport(v []string) *LegalityCacheUpdateOne {
+	_u.mutation.AppendReport(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *LegalityCacheUpdateOne) SetCreatedAt(v time.Time) *LegalityCacheUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *LegalityCacheUpdateOne) SetNillableCreatedAt(v *time.Time) *LegalityCacheUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LegalityCacheMutation object of the builder.
+func (_u *LegalityCacheUpdateOne) Mutation() *LegalityCacheMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LegalityCacheUpdate builder.
+func (_u *LegalityCacheUpdateOne) Where(ps ...predicate.LegalityCache) *LegalityCacheUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LegalityCacheUpdateOne) Select(field string, fields ...string) *LegalityCacheUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LegalityCache entity.
+func (_u *LegalityCacheUpdateOne) Save(ctx context.Context) (*LegalityCache, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LegalityCacheUpdateOne) SaveX(ctx context.Context) *LegalityCache {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LegalityCacheUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LegalityCacheUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *LegalityCacheUpdateOne) sqlSave(ctx context.Context) (_node *LegalityCache, err error) {
+	_spec := sqlgraph.NewUpdateSpec(legalitycache.Table, legalitycache.Columns, sqlgraph.NewFieldSpec(legalitycache.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LegalityCache.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, legalitycache.FieldID)
+		for _, f := range fields {
+			if !legalitycache.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != legalitycache.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Hash(); ok {
+		_spec.SetField(legalitycache.FieldHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Generation(); ok {
+		_spec.SetField(legalitycache.FieldGeneration, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EngineVersion(); ok {
+		_spec.SetField(legalitycache.FieldEngineVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Legal(); ok {
+		_spec.SetField(legalitycache.FieldLegal, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Report(); ok {
+		_spec.SetField(legalitycache.FieldReport, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedReport(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, legalitycache.FieldReport, value)
+		})
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(legalitycache.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &LegalityCache{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{legalitycache.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -11,69 +11,183 @@ var (
 	// BundlesColumns holds the columns for the "bundles" table.
 	BundlesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"visible", "hidden", "deleted"}, Default: "visible"},
+		{Name: "visibility_changed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "visibility_reason", Type: field.TypeString, Nullable: true},
 		{Name: "upload_datetime", Type: field.TypeTime},
 		{Name: "download_code", Type: field.TypeString, Unique: true},
 		{Name: "download_count", Type: field.TypeInt, Default: 0},
 		{Name: "legal", Type: field.TypeBool},
-		{Name: "min_gen", Type: field.TypeString},
-		{Name: "max_gen", Type: field.TypeString},
+		{Name: "min_gen", Type: field.TypeFloat64},
+		{Name: "max_gen", Type: field.TypeFloat64},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
 	}
 	// BundlesTable holds the schema information for the "bundles" table.
 	BundlesTable = &schema.Table{
 		Name:       "bundles",
 		Columns:    BundlesColumns,
 		PrimaryKey: []*schema.Column{BundlesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bundle_visibility",
+				Unique:  false,
+				Columns: []*schema.Column{BundlesColumns[1]},
+			},
+		},
+	}
+	// BundleSlotsColumns holds the columns for the "bundle_slots" table.
+	BundleSlotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "generation", Type: field.TypeString},
+		{Name: "bundle_id", Type: field.TypeInt},
+		{Name: "pokemon_id", Type: field.TypeInt},
+	}
+	// BundleSlotsTable holds the schema information for the "bundle_slots" table.
+	BundleSlotsTable = &schema.Table{
+		Name:       "bundle_slots",
+		Columns:    BundleSlotsColumns,
+		PrimaryKey: []*schema.Column{BundleSlotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "bundle_slots_bundles_slots",
+				Columns:    []*schema.Column{BundleSlotsColumns[3]},
+				RefColumns: []*schema.Column{BundlesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "bundle_slots_pokemons_bundle_slots",
+				Columns:    []*schema.Column{BundleSlotsColumns[4]},
+				RefColumns: []*schema.Column{PokemonsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bundleslot_bundle_id_position",
+				Unique:  true,
+				Columns: []*schema.Column{BundleSlotsColumns[3], BundleSlotsColumns[1]},
+			},
+			{
+				Name:    "bundleslot_pokemon_id",
+				Unique:  false,
+				Columns: []*schema.Column{BundleSlotsColumns[4]},
+			},
+		},
+	}
+	// LegalityCachesColumns holds the columns for the "legality_caches" table.
+	LegalityCachesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "generation", Type: field.TypeString},
+		{Name: "engine_version", Type: field.TypeString},
+		{Name: "legal", Type: field.TypeBool},
+		{Name: "report", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// LegalityCachesTable holds the schema information for the "legality_caches" table.
+	LegalityCachesTable = &schema.Table{
+		Name:       "legality_caches",
+		Columns:    LegalityCachesColumns,
+		PrimaryKey: []*schema.Column{LegalityCachesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "legalitycache_hash_generation_engine_version",
+				Unique:  true,
+				Columns: []*schema.Column{LegalityCachesColumns[1], LegalityCachesColumns[2], LegalityCachesColumns[3]},
+			},
+			{
+				Name:    "legalitycache_engine_version",
+				Unique:  false,
+				Columns: []*schema.Column{LegalityCachesColumns[3]},
+			},
+		},
 	}
 	// PokemonsColumns holds the columns for the "pokemons" table.
 	PokemonsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"visible", "hidden", "deleted"}, Default: "visible"},
+		{Name: "visibility_changed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "visibility_reason", Type: field.TypeString, Nullable: true},
 		{Name: "upload_datetime", Type: field.TypeTime},
 		{Name: "download_code", Type: field.TypeString, Unique: true},
 		{Name: "download_count", Type: field.TypeInt, Default: 0},
 		{Name: "generation", Type: field.TypeString},
 		{Name: "legal", Type: field.TypeBool},
-		{Name: "base_64", Type: field.TypeString},
+		{Name: "data", Type: field.TypeBytes, Nullable: true},
+		{Name: "base_64", Type: field.TypeString, Nullable: true},
+		{Name: "content_hash", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "legality_report", Type: field.TypeJSON, Nullable: true},
+		{Name: "engine_version", Type: field.TypeString, Nullable: true},
+		{Name: "legality_checked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "species", Type: field.TypeInt, Nullable: true},
+		{Name: "form", Type: field.TypeInt, Nullable: true},
+		{Name: "level", Type: field.TypeInt, Nullable: true},
+		{Name: "shiny", Type: field.TypeBool, Nullable: true},
+		{Name: "nature", Type: field.TypeInt, Nullable: true},
+		{Name: "gender", Type: field.TypeInt, Nullable: true},
+		{Name: "ot_name", Type: field.TypeString, Nullable: true},
+		{Name: "tid", Type: field.TypeInt, Nullable: true},
+		{Name: "sid", Type: field.TypeInt, Nullable: true},
+		{Name: "ball", Type: field.TypeInt, Nullable: true},
+		{Name: "held_item", Type: field.TypeInt, Nullable: true},
+		{Name: "language", Type: field.TypeInt, Nullable: true},
+		{Name: "origin_game", Type: field.TypeInt, Nullable: true},
+		{Name: "merged_into_id", Type: field.TypeInt, Nullable: true},
 	}
 	// PokemonsTable holds the schema information for the "pokemons" table.
 	PokemonsTable = &schema.Table{
 		Name:       "pokemons",
 		Columns:    PokemonsColumns,
 		PrimaryKey: []*schema.Column{PokemonsColumns[0]},
-	}
-	// BundlePokemonsColumns holds the columns for the "bundle_pokemons" table.
-	BundlePokemonsColumns = []*schema.Column{
-		{Name: "bundle_id", Type: field.TypeInt},
-		{Name: "pokemon_id", Type: field.TypeInt},
-	}
-	// BundlePokemonsTable holds the schema information for the "bundle_pokemons" table.
-	BundlePokemonsTable = &schema.Table{
-		Name:       "bundle_pokemons",
-		Columns:    BundlePokemonsColumns,
-		PrimaryKey: []*schema.Column{BundlePokemonsColumns[0], BundlePokemonsColumns[1]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "bundle_pokemons_bundle_id",
-				Columns:    []*schema.Column{BundlePokemonsColumns[0]},
-				RefColumns: []*schema.Column{BundlesColumns[0]},
-				OnDelete:   schema.Cascade,
+				Symbol:     "pokemons_pokemons_duplicates",
+				Columns:    []*schema.Column{PokemonsColumns[28]},
+				RefColumns: []*schema.Column{PokemonsColumns[0]},
+				OnDelete:   schema.SetNull,
 			},
+		},
+		Indexes: []*schema.Index{
 			{
-				Symbol:     "bundle_pokemons_pokemon_id",
-				Columns:    []*schema.Column{BundlePokemonsColumns[1]},
-				RefColumns: []*schema.Column{PokemonsColumns[0]},
-				OnDelete:   schema.Cascade,
+				Name:    "pokemon_visibility",
+				Unique:  false,
+				Columns: []*schema.Column{PokemonsColumns[1]},
 			},
 		},
 	}
+	// RecheckJobsColumns holds the columns for the "recheck_jobs" table.
+	RecheckJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "engine_version", Type: field.TypeString, Unique: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"running", "done"}},
+		{Name: "total", Type: field.TypeInt, Default: 0},
+		{Name: "checked", Type: field.TypeInt, Default: 0},
+		{Name: "failed", Type: field.TypeInt, Default: 0},
+		{Name: "last_pokemon_id", Type: field.TypeInt, Default: 0},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+	}
+	// RecheckJobsTable holds the schema information for the "recheck_jobs" table.
+	RecheckJobsTable = &schema.Table{
+		Name:       "recheck_jobs",
+		Columns:    RecheckJobsColumns,
+		PrimaryKey: []*schema.Column{RecheckJobsColumns[0]},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		BundlesTable,
+		BundleSlotsTable,
+		LegalityCachesTable,
 		PokemonsTable,
-		BundlePokemonsTable,
+		RecheckJobsTable,
 	}
 )
 
 func init() {
-	BundlePokemonsTable.ForeignKeys[0].RefTable = BundlesTable
-	BundlePokemonsTable.ForeignKeys[1].RefTable = PokemonsTable
+	BundleSlotsTable.ForeignKeys[0].RefTable = BundlesTable
+	BundleSlotsTable.ForeignKeys[1].RefTable = PokemonsTable
+	PokemonsTable.ForeignKeys[0].RefTable = PokemonsTable
 }
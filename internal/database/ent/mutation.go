@@ -12,8 +12,11 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/legalitycache"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/predicate"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/recheckjob"
 )
 
 const (
@@ -25,30 +28,41 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeBundle  = "Bundle"
-	TypePokemon = "Pokemon"
+	TypeBundle        = "Bundle"
+	TypeBundleSlot    = "BundleSlot"
+	TypeLegalityCache = "LegalityCache"
+	TypePokemon       = "Pokemon"
+	TypeRecheckJob    = "RecheckJob"
 )
 
 // BundleMutation represents an operation that mutates the Bundle nodes in the graph.
 type BundleMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	upload_datetime   *time.Time
-	download_code     *string
-	download_count    *int
-	adddownload_count *int
-	legal             *bool
-	min_gen           *string
-	max_gen           *string
-	clearedFields     map[string]struct{}
-	pokemons          map[int]struct{}
-	removedpokemons   map[int]struct{}
-	clearedpokemons   bool
-	done              bool
-	oldValue          func(context.Context) (*Bundle, error)
-	predicates        []predicate.Bundle
+	op                    Op
+	typ                   string
+	id                    *int
+	visibility            *bundle.Visibility
+	visibility_changed_at *time.Time
+	visibility_reason     *string
+	upload_datetime       *time.Time
+	download_code         *string
+	download_count        *int
+	adddownload_count     *int
+	legal                 *bool
+	min_gen               *float64
+	addmin_gen            *float64
+	max_gen               *float64
+	addmax_gen            *float64
+	title                 *string
+	description           *string
+	notes                 *string
+	clearedFields         map[string]struct{}
+	slots                 map[int]struct{}
+	removedslots          map[int]struct{}
+	clearedslots          bool
+	done                  bool
+	oldValue              func(context.Context) (*Bundle, error)
+	predicates            []predicate.Bundle
 }
 
 var _ ent.Mutation = (*BundleMutation)(nil)
@@ -149,6 +163,140 @@ func (m *BundleMutation) IDs(ctx context.Context) ([]int, error) {
 	}
 }
 
+// SetVisibility sets the "visibility" field.
+func (m *BundleMutation) SetVisibility(b bundle.Visibility) {
+	m.visibility = &b
+}
+
+// Visibility returns the value of the "visibility" field in the mutation.
+func (m *BundleMutation) Visibility() (r bundle.Visibility, exists bool) {
+	v := m.visibility
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisibility returns the old "visibility" field's value of the Bundle entity.
+// If the Bundle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BundleMutation) OldVisibility(ctx context.Context) (v bundle.Visibility, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisibility is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisibility requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisibility: %w", err)
+	}
+	return oldValue.Visibility, nil
+}
+
+// ResetVisibility resets all changes to the "visibility" field.
+func (m *BundleMutation) ResetVisibility() {
+	m.visibility = nil
+}
+
+// SetVisibilityChangedAt sets the "visibility_changed_at" field.
+func (m *BundleMutation) SetVisibilityChangedAt(t time.Time) {
+	m.visibility_changed_at = &t
+}
+
+// VisibilityChangedAt returns the value of the "visibility_changed_at" field in the mutation.
+func (m *BundleMutation) VisibilityChangedAt() (r time.Time, exists bool) {
+	v := m.visibility_changed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisibilityChangedAt returns the old "visibility_changed_at" field's value of the Bundle entity.
+// If the Bundle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BundleMutation) OldVisibilityChangedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisibilityChangedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisibilityChangedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisibilityChangedAt: %w", err)
+	}
+	return oldValue.VisibilityChangedAt, nil
+}
+
+// ClearVisibilityChangedAt clears the value of the "visibility_changed_at" field.
+func (m *BundleMutation) ClearVisibilityChangedAt() {
+	m.visibility_changed_at = nil
+	m.clearedFields[bundle.FieldVisibilityChangedAt] = struct{}{}
+}
+
+// VisibilityChangedAtCleared returns if the "visibility_changed_at" field was cleared in this mutation.
+func (m *BundleMutation) VisibilityChangedAtCleared() bool {
+	_, ok := m.clearedFields[bundle.FieldVisibilityChangedAt]
+	return ok
+}
+
+// ResetVisibilityChangedAt resets all changes to the "visibility_changed_at" field.
+func (m *BundleMutation) ResetVisibilityChangedAt() {
+	m.visibility_changed_at = nil
+	delete(m.clearedFields, bundle.FieldVisibilityChangedAt)
+}
+
+// SetVisibilityReason sets the "visibility_reason" field.
+func (m *BundleMutation) SetVisibilityReason(s string) {
+	m.visibility_reason = &s
+}
+
+// VisibilityReason returns the value of the "visibility_reason" field in the mutation.
+func (m *BundleMutation) VisibilityReason() (r string, exists bool) {
+	v := m.visibility_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisibilityReason returns the old "visibility_reason" field's value of the Bundle entity.
+// If the Bundle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BundleMutation) OldVisibilityReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisibilityReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisibilityReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisibilityReason: %w", err)
+	}
+	return oldValue.VisibilityReason, nil
+}
+
+// ClearVisibilityReason clears the value of the "visibility_reason" field.
+func (m *BundleMutation) ClearVisibilityReason() {
+	m.visibility_reason = nil
+	m.clearedFields[bundle.FieldVisibilityReason] = struct{}{}
+}
+
+// VisibilityReasonCleared returns if the "visibility_reason" field was cleared in this mutation.
+func (m *BundleMutation) VisibilityReasonCleared() bool {
+	_, ok := m.clearedFields[bundle.FieldVisibilityReason]
+	return ok
+}
+
+// ResetVisibilityReason resets all changes to the "visibility_reason" field.
+func (m *BundleMutation) ResetVisibilityReason() {
+	m.visibility_reason = nil
+	delete(m.clearedFields, bundle.FieldVisibilityReason)
+}
+
 // SetUploadDatetime sets the "upload_datetime" field.
 func (m *BundleMutation) SetUploadDatetime(t time.Time) {
 	m.upload_datetime = &t
@@ -314,12 +462,13 @@ func (m *BundleMutation) ResetLegal() {
 }
 
 // SetMinGen sets the "min_gen" field.
-func (m *BundleMutation) SetMinGen(s string) {
-	m.min_gen = &s
+func (m *BundleMutation) SetMinGen(f float64) {
+	m.min_gen = &f
+	m.addmin_gen = nil
 }
 
 // MinGen returns the value of the "min_gen" field in the mutation.
-func (m *BundleMutation) MinGen() (r string, exists bool) {
+func (m *BundleMutation) MinGen() (r float64, exists bool) {
 	v := m.min_gen
 	if v == nil {
 		return
@@ -330,7 +479,7 @@ func (m *BundleMutation) MinGen() (r string, exists bool) {
 // OldMinGen returns the old "min_gen" field's value of the Bundle entity.
 // If the Bundle object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BundleMutation) OldMinGen(ctx context.Context) (v string, err error) {
+func (m *BundleMutation) OldMinGen(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldMinGen is only allowed on UpdateOne operations")
 	}
@@ -344,18 +493,38 @@ func (m *BundleMutation) OldMinGen(ctx context.Context) (v string, err error) {
 	return oldValue.MinGen, nil
 }
 
+// AddMinGen adds f to the "min_gen" field.
+func (m *BundleMutation) AddMinGen(f float64) {
+	if m.addmin_gen != nil {
+		*m.addmin_gen += f
+	} else {
+		m.addmin_gen = &f
+	}
+}
+
+// AddedMinGen returns the value that was added to the "min_gen" field in this mutation.
+func (m *BundleMutation) AddedMinGen() (r float64, exists bool) {
+	v := m.addmin_gen
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetMinGen resets all changes to the "min_gen" field.
 func (m *BundleMutation) ResetMinGen() {
 	m.min_gen = nil
+	m.addmin_gen = nil
 }
 
 // SetMaxGen sets the "max_gen" field.
-func (m *BundleMutation) SetMaxGen(s string) {
-	m.max_gen = &s
+func (m *BundleMutation) SetMaxGen(f float64) {
+	m.max_gen = &f
+	m.addmax_gen = nil
 }
 
 // MaxGen returns the value of the "max_gen" field in the mutation.
-func (m *BundleMutation) MaxGen() (r string, exists bool) {
+func (m *BundleMutation) MaxGen() (r float64, exists bool) {
 	v := m.max_gen
 	if v == nil {
 		return
@@ -366,7 +535,7 @@ func (m *BundleMutation) MaxGen() (r string, exists bool) {
 // OldMaxGen returns the old "max_gen" field's value of the Bundle entity.
 // If the Bundle object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BundleMutation) OldMaxGen(ctx context.Context) (v string, err error) {
+func (m *BundleMutation) OldMaxGen(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldMaxGen is only allowed on UpdateOne operations")
 	}
@@ -380,63 +549,229 @@ func (m *BundleMutation) OldMaxGen(ctx context.Context) (v string, err error) {
 	return oldValue.MaxGen, nil
 }
 
+// AddMaxGen adds f to the "max_gen" field.
+func (m *BundleMutation) AddMaxGen(f float64) {
+	if m.addmax_gen != nil {
+		*m.addmax_gen += f
+	} else {
+		m.addmax_gen = &f
+	}
+}
+
+// AddedMaxGen returns the value that was added to the "max_gen" field in this mutation.
+func (m *BundleMutation) AddedMaxGen() (r float64, exists bool) {
+	v := m.addmax_gen
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
 // ResetMaxGen resets all changes to the "max_gen" field.
 func (m *BundleMutation) ResetMaxGen() {
 	m.max_gen = nil
+	m.addmax_gen = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *BundleMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *BundleMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Bundle entity.
+// If the Bundle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BundleMutation) OldTitle(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ClearTitle clears the value of the "title" field.
+func (m *BundleMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[bundle.FieldTitle] = struct{}{}
+}
+
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *BundleMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[bundle.FieldTitle]
+	return ok
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *BundleMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, bundle.FieldTitle)
+}
+
+// SetDescription sets the "description" field.
+func (m *BundleMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *BundleMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Bundle entity.
+// If the Bundle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BundleMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *BundleMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[bundle.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *BundleMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[bundle.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *BundleMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, bundle.FieldDescription)
+}
+
+// SetNotes sets the "notes" field.
+func (m *BundleMutation) SetNotes(s string) {
+	m.notes = &s
+}
+
+// Notes returns the value of the "notes" field in the mutation.
+func (m *BundleMutation) Notes() (r string, exists bool) {
+	v := m.notes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotes returns the old "notes" field's value of the Bundle entity.
+// If the Bundle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BundleMutation) OldNotes(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
+	}
+	return oldValue.Notes, nil
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (m *BundleMutation) ClearNotes() {
+	m.notes = nil
+	m.clearedFields[bundle.FieldNotes] = struct{}{}
+}
+
+// NotesCleared returns if the "notes" field was cleared in this mutation.
+func (m *BundleMutation) NotesCleared() bool {
+	_, ok := m.clearedFields[bundle.FieldNotes]
+	return ok
+}
+
+// ResetNotes resets all changes to the "notes" field.
+func (m *BundleMutation) ResetNotes() {
+	m.notes = nil
+	delete(m.clearedFields, bundle.FieldNotes)
 }
 
-// AddPokemonIDs adds the "pokemons" edge to the Pokemon entity by ids.
-func (m *BundleMutation) AddPokemonIDs(ids ...int) {
-	if m.pokemons == nil {
-		m.pokemons = make(map[int]struct{})
+// AddSlotIDs adds the "slots" edge to the BundleSlot entity by ids.
+func (m *BundleMutation) AddSlotIDs(ids ...int) {
+	if m.slots == nil {
+		m.slots = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.pokemons[ids[i]] = struct{}{}
+		m.slots[ids[i]] = struct{}{}
 	}
 }
 
-// ClearPokemons clears the "pokemons" edge to the Pokemon entity.
-func (m *BundleMutation) ClearPokemons() {
-	m.clearedpokemons = true
+// ClearSlots clears the "slots" edge to the BundleSlot entity.
+func (m *BundleMutation) ClearSlots() {
+	m.clearedslots = true
 }
 
-// PokemonsCleared reports if the "pokemons" edge to the Pokemon entity was cleared.
-func (m *BundleMutation) PokemonsCleared() bool {
-	return m.clearedpokemons
+// SlotsCleared reports if the "slots" edge to the BundleSlot entity was cleared.
+func (m *BundleMutation) SlotsCleared() bool {
+	return m.clearedslots
 }
 
-// RemovePokemonIDs removes the "pokemons" edge to the Pokemon entity by IDs.
-func (m *BundleMutation) RemovePokemonIDs(ids ...int) {
-	if m.removedpokemons == nil {
-		m.removedpokemons = make(map[int]struct{})
+// RemoveSlotIDs removes the "slots" edge to the BundleSlot entity by IDs.
+func (m *BundleMutation) RemoveSlotIDs(ids ...int) {
+	if m.removedslots == nil {
+		m.removedslots = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.pokemons, ids[i])
-		m.removedpokemons[ids[i]] = struct{}{}
+		delete(m.slots, ids[i])
+		m.removedslots[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedPokemons returns the removed IDs of the "pokemons" edge to the Pokemon entity.
-func (m *BundleMutation) RemovedPokemonsIDs() (ids []int) {
-	for id := range m.removedpokemons {
+// RemovedSlots returns the removed IDs of the "slots" edge to the BundleSlot entity.
+func (m *BundleMutation) RemovedSlotsIDs() (ids []int) {
+	for id := range m.removedslots {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// PokemonsIDs returns the "pokemons" edge IDs in the mutation.
-func (m *BundleMutation) PokemonsIDs() (ids []int) {
-	for id := range m.pokemons {
+// SlotsIDs returns the "slots" edge IDs in the mutation.
+func (m *BundleMutation) SlotsIDs() (ids []int) {
+	for id := range m.slots {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetPokemons resets all changes to the "pokemons" edge.
-func (m *BundleMutation) ResetPokemons() {
-	m.pokemons = nil
-	m.clearedpokemons = false
-	m.removedpokemons = nil
+// ResetSlots resets all changes to the "slots" edge.
+func (m *BundleMutation) ResetSlots() {
+	m.slots = nil
+	m.clearedslots = false
+	m.removedslots = nil
 }
 
 // Where appends a list predicates to the BundleMutation builder.
@@ -473,7 +808,16 @@ func (m *BundleMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *BundleMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 12)
+	if m.visibility != nil {
+		fields = append(fields, bundle.FieldVisibility)
+	}
+	if m.visibility_changed_at != nil {
+		fields = append(fields, bundle.FieldVisibilityChangedAt)
+	}
+	if m.visibility_reason != nil {
+		fields = append(fields, bundle.FieldVisibilityReason)
+	}
 	if m.upload_datetime != nil {
 		fields = append(fields, bundle.FieldUploadDatetime)
 	}
@@ -492,6 +836,15 @@ func (m *BundleMutation) Fields() []string {
 	if m.max_gen != nil {
 		fields = append(fields, bundle.FieldMaxGen)
 	}
+	if m.title != nil {
+		fields = append(fields, bundle.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, bundle.FieldDescription)
+	}
+	if m.notes != nil {
+		fields = append(fields, bundle.FieldNotes)
+	}
 	return fields
 }
 
@@ -500,6 +853,12 @@ func (m *BundleMutation) Fields() []string {
 // schema.
 func (m *BundleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case bundle.FieldVisibility:
+		return m.Visibility()
+	case bundle.FieldVisibilityChangedAt:
+		return m.VisibilityChangedAt()
+	case bundle.FieldVisibilityReason:
+		return m.VisibilityReason()
 	case bundle.FieldUploadDatetime:
 		return m.UploadDatetime()
 	case bundle.FieldDownloadCode:
@@ -512,6 +871,12 @@ func (m *BundleMutation) Field(name string) (ent.Value, bool) {
 		return m.MinGen()
 	case bundle.FieldMaxGen:
 		return m.MaxGen()
+	case bundle.FieldTitle:
+		return m.Title()
+	case bundle.FieldDescription:
+		return m.Description()
+	case bundle.FieldNotes:
+		return m.Notes()
 	}
 	return nil, false
 }
@@ -521,6 +886,12 @@ func (m *BundleMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *BundleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
+	case bundle.FieldVisibility:
+		return m.OldVisibility(ctx)
+	case bundle.FieldVisibilityChangedAt:
+		return m.OldVisibilityChangedAt(ctx)
+	case bundle.FieldVisibilityReason:
+		return m.OldVisibilityReason(ctx)
 	case bundle.FieldUploadDatetime:
 		return m.OldUploadDatetime(ctx)
 	case bundle.FieldDownloadCode:
@@ -533,6 +904,12 @@ func (m *BundleMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldMinGen(ctx)
 	case bundle.FieldMaxGen:
 		return m.OldMaxGen(ctx)
+	case bundle.FieldTitle:
+		return m.OldTitle(ctx)
+	case bundle.FieldDescription:
+		return m.OldDescription(ctx)
+	case bundle.FieldNotes:
+		return m.OldNotes(ctx)
 	}
 	return nil, fmt.Errorf("unknown Bundle field %s", name)
 }
@@ -542,6 +919,27 @@ func (m *BundleMutation) OldField(ctx context.Context, name string) (ent.Value,
 // type.
 func (m *BundleMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case bundle.FieldVisibility:
+		v, ok := value.(bundle.Visibility)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisibility(v)
+		return nil
+	case bundle.FieldVisibilityChangedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisibilityChangedAt(v)
+		return nil
+	case bundle.FieldVisibilityReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisibilityReason(v)
+		return nil
 	case bundle.FieldUploadDatetime:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -571,19 +969,40 @@ func (m *BundleMutation) SetField(name string, value ent.Value) error {
 		m.SetLegal(v)
 		return nil
 	case bundle.FieldMinGen:
-		v, ok := value.(string)
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetMinGen(v)
 		return nil
 	case bundle.FieldMaxGen:
-		v, ok := value.(string)
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetMaxGen(v)
 		return nil
+	case bundle.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case bundle.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case bundle.FieldNotes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotes(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Bundle field %s", name)
 }
@@ -595,6 +1014,12 @@ func (m *BundleMutation) AddedFields() []string {
 	if m.adddownload_count != nil {
 		fields = append(fields, bundle.FieldDownloadCount)
 	}
+	if m.addmin_gen != nil {
+		fields = append(fields, bundle.FieldMinGen)
+	}
+	if m.addmax_gen != nil {
+		fields = append(fields, bundle.FieldMaxGen)
+	}
 	return fields
 }
 
@@ -605,6 +1030,10 @@ func (m *BundleMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	case bundle.FieldDownloadCount:
 		return m.AddedDownloadCount()
+	case bundle.FieldMinGen:
+		return m.AddedMinGen()
+	case bundle.FieldMaxGen:
+		return m.AddedMaxGen()
 	}
 	return nil, false
 }
@@ -621,6 +1050,20 @@ func (m *BundleMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddDownloadCount(v)
 		return nil
+	case bundle.FieldMinGen:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinGen(v)
+		return nil
+	case bundle.FieldMaxGen:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxGen(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Bundle numeric field %s", name)
 }
@@ -628,7 +1071,23 @@ func (m *BundleMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *BundleMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(bundle.FieldVisibilityChangedAt) {
+		fields = append(fields, bundle.FieldVisibilityChangedAt)
+	}
+	if m.FieldCleared(bundle.FieldVisibilityReason) {
+		fields = append(fields, bundle.FieldVisibilityReason)
+	}
+	if m.FieldCleared(bundle.FieldTitle) {
+		fields = append(fields, bundle.FieldTitle)
+	}
+	if m.FieldCleared(bundle.FieldDescription) {
+		fields = append(fields, bundle.FieldDescription)
+	}
+	if m.FieldCleared(bundle.FieldNotes) {
+		fields = append(fields, bundle.FieldNotes)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -641,6 +1100,23 @@ func (m *BundleMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *BundleMutation) ClearField(name string) error {
+	switch name {
+	case bundle.FieldVisibilityChangedAt:
+		m.ClearVisibilityChangedAt()
+		return nil
+	case bundle.FieldVisibilityReason:
+		m.ClearVisibilityReason()
+		return nil
+	case bundle.FieldTitle:
+		m.ClearTitle()
+		return nil
+	case bundle.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case bundle.FieldNotes:
+		m.ClearNotes()
+		return nil
+	}
 	return fmt.Errorf("unknown Bundle nullable field %s", name)
 }
 
@@ -648,6 +1124,15 @@ func (m *BundleMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *BundleMutation) ResetField(name string) error {
 	switch name {
+	case bundle.FieldVisibility:
+		m.ResetVisibility()
+		return nil
+	case bundle.FieldVisibilityChangedAt:
+		m.ResetVisibilityChangedAt()
+		return nil
+	case bundle.FieldVisibilityReason:
+		m.ResetVisibilityReason()
+		return nil
 	case bundle.FieldUploadDatetime:
 		m.ResetUploadDatetime()
 		return nil
@@ -666,6 +1151,15 @@ func (m *BundleMutation) ResetField(name string) error {
 	case bundle.FieldMaxGen:
 		m.ResetMaxGen()
 		return nil
+	case bundle.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case bundle.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case bundle.FieldNotes:
+		m.ResetNotes()
+		return nil
 	}
 	return fmt.Errorf("unknown Bundle field %s", name)
 }
@@ -673,8 +1167,8 @@ func (m *BundleMutation) ResetField(name string) error {
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *BundleMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.pokemons != nil {
-		edges = append(edges, bundle.EdgePokemons)
+	if m.slots != nil {
+		edges = append(edges, bundle.EdgeSlots)
 	}
 	return edges
 }
@@ -683,9 +1177,9 @@ func (m *BundleMutation) AddedEdges() []string {
 // name in this mutation.
 func (m *BundleMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case bundle.EdgePokemons:
-		ids := make([]ent.Value, 0, len(m.pokemons))
-		for id := range m.pokemons {
+	case bundle.EdgeSlots:
+		ids := make([]ent.Value, 0, len(m.slots))
+		for id := range m.slots {
 			ids = append(ids, id)
 		}
 		return ids
@@ -696,8 +1190,8 @@ func (m *BundleMutation) AddedIDs(name string) []ent.Value {
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *BundleMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedpokemons != nil {
-		edges = append(edges, bundle.EdgePokemons)
+	if m.removedslots != nil {
+		edges = append(edges, bundle.EdgeSlots)
 	}
 	return edges
 }
@@ -706,9 +1200,9 @@ func (m *BundleMutation) RemovedEdges() []string {
 // the given name in this mutation.
 func (m *BundleMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case bundle.EdgePokemons:
-		ids := make([]ent.Value, 0, len(m.removedpokemons))
-		for id := range m.removedpokemons {
+	case bundle.EdgeSlots:
+		ids := make([]ent.Value, 0, len(m.removedslots))
+		for id := range m.removedslots {
 			ids = append(ids, id)
 		}
 		return ids
@@ -719,8 +1213,8 @@ func (m *BundleMutation) RemovedIDs(name string) []ent.Value {
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *BundleMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedpokemons {
-		edges = append(edges, bundle.EdgePokemons)
+	if m.clearedslots {
+		edges = append(edges, bundle.EdgeSlots)
 	}
 	return edges
 }
@@ -729,8 +1223,8 @@ func (m *BundleMutation) ClearedEdges() []string {
 // was cleared in this mutation.
 func (m *BundleMutation) EdgeCleared(name string) bool {
 	switch name {
-	case bundle.EdgePokemons:
-		return m.clearedpokemons
+	case bundle.EdgeSlots:
+		return m.clearedslots
 	}
 	return false
 }
@@ -747,68 +1241,65 @@ func (m *BundleMutation) ClearEdge(name string) error {
 // It returns an error if the edge is not defined in the schema.
 func (m *BundleMutation) ResetEdge(name string) error {
 	switch name {
-	case bundle.EdgePokemons:
-		m.ResetPokemons()
+	case bundle.EdgeSlots:
+		m.ResetSlots()
 		return nil
 	}
 	return fmt.Errorf("unknown Bundle edge %s", name)
 }
 
-// PokemonMutation represents an operation that mutates the Pokemon nodes in the graph.
-type PokemonMutation struct {
+// BundleSlotMutation represents an operation that mutates the BundleSlot nodes in the graph.
+type BundleSlotMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	upload_datetime   *time.Time
-	download_code     *string
-	download_count    *int
-	adddownload_count *int
-	generation        *string
-	legal             *bool
-	base_64           *string
-	clearedFields     map[string]struct{}
-	bundles           map[int]struct{}
-	removedbundles    map[int]struct{}
-	clearedbundles    bool
-	done              bool
-	oldValue          func(context.Context) (*Pokemon, error)
-	predicates        []predicate.Pokemon
+	op             Op
+	typ            string
+	id             *int
+	position       *int
+	addposition    *int
+	generation     *string
+	clearedFields  map[string]struct{}
+	bundle         *int
+	clearedbundle  bool
+	pokemon        *int
+	clearedpokemon bool
+	done           bool
+	oldValue       func(context.Context) (*BundleSlot, error)
+	predicates     []predicate.BundleSlot
+}
+
+var _ ent.Mutation = (*BundleSlotMutation)(nil)
+
+// bundleslotOption allows management of the mutation configuration using functional options.
+type bundleslotOption func(*BundleSlotMutation)
+
+// newBundleSlotMutation creates new mutation for the BundleSlot entity.
+func newBundleSlotMutation(c config, op Op, opts ...bundleslotOption) *BundleSlotMutation {
+	m := &BundleSlotMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBundleSlot,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-var _ ent.Mutation = (*PokemonMutation)(nil)
-
-// pokemonOption allows management of the mutation configuration using functional options.
-type pokemonOption func(*PokemonMutation)
-
-// newPokemonMutation creates new mutation for the Pokemon entity.
-func newPokemonMutation(c config, op Op, opts ...pokemonOption) *PokemonMutation {
-	m := &PokemonMutation{
-		config:        c,
-		op:            op,
-		typ:           TypePokemon,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
-}
-
-// withPokemonID sets the ID field of the mutation.
-func withPokemonID(id int) pokemonOption {
-	return func(m *PokemonMutation) {
+// withBundleSlotID sets the ID field of the mutation.
+func withBundleSlotID(id int) bundleslotOption {
+	return func(m *BundleSlotMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Pokemon
+			value *BundleSlot
 		)
-		m.oldValue = func(ctx context.Context) (*Pokemon, error) {
+		m.oldValue = func(ctx context.Context) (*BundleSlot, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Pokemon.Get(ctx, id)
+					value, err = m.Client().BundleSlot.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -817,10 +1308,10 @@ func withPokemonID(id int) pokemonOption {
 	}
 }
 
-// withPokemon sets the old Pokemon of the mutation.
-func withPokemon(node *Pokemon) pokemonOption {
-	return func(m *PokemonMutation) {
-		m.oldValue = func(context.Context) (*Pokemon, error) {
+// withBundleSlot sets the old BundleSlot of the mutation.
+func withBundleSlot(node *BundleSlot) bundleslotOption {
+	return func(m *BundleSlotMutation) {
+		m.oldValue = func(context.Context) (*BundleSlot, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -829,7 +1320,7 @@ func withPokemon(node *Pokemon) pokemonOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PokemonMutation) Client() *Client {
+func (m BundleSlotMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -837,7 +1328,7 @@ func (m PokemonMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PokemonMutation) Tx() (*Tx, error) {
+func (m BundleSlotMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -848,7 +1339,7 @@ func (m PokemonMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PokemonMutation) ID() (id int, exists bool) {
+func (m *BundleSlotMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -859,7 +1350,7 @@ func (m *PokemonMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PokemonMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BundleSlotMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -868,147 +1359,147 @@ func (m *PokemonMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Pokemon.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BundleSlot.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUploadDatetime sets the "upload_datetime" field.
-func (m *PokemonMutation) SetUploadDatetime(t time.Time) {
-	m.upload_datetime = &t
+// SetBundleID sets the "bundle_id" field.
+func (m *BundleSlotMutation) SetBundleID(i int) {
+	m.bundle = &i
 }
 
-// UploadDatetime returns the value of the "upload_datetime" field in the mutation.
-func (m *PokemonMutation) UploadDatetime() (r time.Time, exists bool) {
-	v := m.upload_datetime
+// BundleID returns the value of the "bundle_id" field in the mutation.
+func (m *BundleSlotMutation) BundleID() (r int, exists bool) {
+	v := m.bundle
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUploadDatetime returns the old "upload_datetime" field's value of the Pokemon entity.
-// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// OldBundleID returns the old "bundle_id" field's value of the BundleSlot entity.
+// If the BundleSlot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PokemonMutation) OldUploadDatetime(ctx context.Context) (v time.Time, err error) {
+func (m *BundleSlotMutation) OldBundleID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUploadDatetime is only allowed on UpdateOne operations")
+		return v, errors.New("OldBundleID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUploadDatetime requires an ID field in the mutation")
+		return v, errors.New("OldBundleID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUploadDatetime: %w", err)
+		return v, fmt.Errorf("querying old value for OldBundleID: %w", err)
 	}
-	return oldValue.UploadDatetime, nil
+	return oldValue.BundleID, nil
 }
 
-// ResetUploadDatetime resets all changes to the "upload_datetime" field.
-func (m *PokemonMutation) ResetUploadDatetime() {
-	m.upload_datetime = nil
+// ResetBundleID resets all changes to the "bundle_id" field.
+func (m *BundleSlotMutation) ResetBundleID() {
+	m.bundle = nil
 }
 
-// SetDownloadCode sets the "download_code" field.
-func (m *PokemonMutation) SetDownloadCode(s string) {
-	m.download_code = &s
+// SetPokemonID sets the "pokemon_id" field.
+func (m *BundleSlotMutation) SetPokemonID(i int) {
+	m.pokemon = &i
 }
 
-// DownloadCode returns the value of the "download_code" field in the mutation.
-func (m *PokemonMutation) DownloadCode() (r string, exists bool) {
-	v := m.download_code
+// PokemonID returns the value of the "pokemon_id" field in the mutation.
+func (m *BundleSlotMutation) PokemonID() (r int, exists bool) {
+	v := m.pokemon
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDownloadCode returns the old "download_code" field's value of the Pokemon entity.
-// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// OldPokemonID returns the old "pokemon_id" field's value of the BundleSlot entity.
+// If the BundleSlot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PokemonMutation) OldDownloadCode(ctx context.Context) (v string, err error) {
+func (m *BundleSlotMutation) OldPokemonID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDownloadCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldPokemonID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDownloadCode requires an ID field in the mutation")
+		return v, errors.New("OldPokemonID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDownloadCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldPokemonID: %w", err)
 	}
-	return oldValue.DownloadCode, nil
+	return oldValue.PokemonID, nil
 }
 
-// ResetDownloadCode resets all changes to the "download_code" field.
-func (m *PokemonMutation) ResetDownloadCode() {
-	m.download_code = nil
+// ResetPokemonID resets all changes to the "pokemon_id" field.
+func (m *BundleSlotMutation) ResetPokemonID() {
+	m.pokemon = nil
 }
 
-// SetDownloadCount sets the "download_count" field.
-func (m *PokemonMutation) SetDownloadCount(i int) {
-	m.download_count = &i
-	m.adddownload_count = nil
+// SetPosition sets the "position" field.
+func (m *BundleSlotMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
 }
 
-// DownloadCount returns the value of the "download_count" field in the mutation.
-func (m *PokemonMutation) DownloadCount() (r int, exists bool) {
-	v := m.download_count
+// Position returns the value of the "position" field in the mutation.
+func (m *BundleSlotMutation) Position() (r int, exists bool) {
+	v := m.position
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDownloadCount returns the old "download_count" field's value of the Pokemon entity.
-// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// OldPosition returns the old "position" field's value of the BundleSlot entity.
+// If the BundleSlot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PokemonMutation) OldDownloadCount(ctx context.Context) (v int, err error) {
+func (m *BundleSlotMutation) OldPosition(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDownloadCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDownloadCount requires an ID field in the mutation")
+		return v, errors.New("OldPosition requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDownloadCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
 	}
-	return oldValue.DownloadCount, nil
+	return oldValue.Position, nil
 }
 
-// AddDownloadCount adds i to the "download_count" field.
-func (m *PokemonMutation) AddDownloadCount(i int) {
-	if m.adddownload_count != nil {
-		*m.adddownload_count += i
+// AddPosition adds i to the "position" field.
+func (m *BundleSlotMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
 	} else {
-		m.adddownload_count = &i
+		m.addposition = &i
 	}
 }
 
-// AddedDownloadCount returns the value that was added to the "download_count" field in this mutation.
-func (m *PokemonMutation) AddedDownloadCount() (r int, exists bool) {
-	v := m.adddownload_count
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *BundleSlotMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetDownloadCount resets all changes to the "download_count" field.
-func (m *PokemonMutation) ResetDownloadCount() {
-	m.download_count = nil
-	m.adddownload_count = nil
+// ResetPosition resets all changes to the "position" field.
+func (m *BundleSlotMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
 }
 
 // SetGeneration sets the "generation" field.
-func (m *PokemonMutation) SetGeneration(s string) {
+func (m *BundleSlotMutation) SetGeneration(s string) {
 	m.generation = &s
 }
 
 // Generation returns the value of the "generation" field in the mutation.
-func (m *PokemonMutation) Generation() (r string, exists bool) {
+func (m *BundleSlotMutation) Generation() (r string, exists bool) {
 	v := m.generation
 	if v == nil {
 		return
@@ -1016,10 +1507,10 @@ func (m *PokemonMutation) Generation() (r string, exists bool) {
 	return *v, true
 }
 
-// OldGeneration returns the old "generation" field's value of the Pokemon entity.
-// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// OldGeneration returns the old "generation" field's value of the BundleSlot entity.
+// If the BundleSlot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PokemonMutation) OldGeneration(ctx context.Context) (v string, err error) {
+func (m *BundleSlotMutation) OldGeneration(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldGeneration is only allowed on UpdateOne operations")
 	}
@@ -1034,145 +1525,73 @@ func (m *PokemonMutation) OldGeneration(ctx context.Context) (v string, err erro
 }
 
 // ResetGeneration resets all changes to the "generation" field.
-func (m *PokemonMutation) ResetGeneration() {
+func (m *BundleSlotMutation) ResetGeneration() {
 	m.generation = nil
 }
 
-// SetLegal sets the "legal" field.
-func (m *PokemonMutation) SetLegal(b bool) {
-	m.legal = &b
-}
-
-// Legal returns the value of the "legal" field in the mutation.
-func (m *PokemonMutation) Legal() (r bool, exists bool) {
-	v := m.legal
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldLegal returns the old "legal" field's value of the Pokemon entity.
-// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PokemonMutation) OldLegal(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLegal is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLegal requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLegal: %w", err)
-	}
-	return oldValue.Legal, nil
-}
-
-// ResetLegal resets all changes to the "legal" field.
-func (m *PokemonMutation) ResetLegal() {
-	m.legal = nil
-}
-
-// SetBase64 sets the "base_64" field.
-func (m *PokemonMutation) SetBase64(s string) {
-	m.base_64 = &s
-}
-
-// Base64 returns the value of the "base_64" field in the mutation.
-func (m *PokemonMutation) Base64() (r string, exists bool) {
-	v := m.base_64
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldBase64 returns the old "base_64" field's value of the Pokemon entity.
-// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PokemonMutation) OldBase64(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBase64 is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBase64 requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBase64: %w", err)
-	}
-	return oldValue.Base64, nil
+// ClearBundle clears the "bundle" edge to the Bundle entity.
+func (m *BundleSlotMutation) ClearBundle() {
+	m.clearedbundle = true
+	m.clearedFields[bundleslot.FieldBundleID] = struct{}{}
 }
 
-// ResetBase64 resets all changes to the "base_64" field.
-func (m *PokemonMutation) ResetBase64() {
-	m.base_64 = nil
+// BundleCleared reports if the "bundle" edge to the Bundle entity was cleared.
+func (m *BundleSlotMutation) BundleCleared() bool {
+	return m.clearedbundle
 }
 
-// AddBundleIDs adds the "bundles" edge to the Bundle entity by ids.
-func (m *PokemonMutation) AddBundleIDs(ids ...int) {
-	if m.bundles == nil {
-		m.bundles = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.bundles[ids[i]] = struct{}{}
+// BundleIDs returns the "bundle" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BundleID instead. It exists only for internal usage by the builders.
+func (m *BundleSlotMutation) BundleIDs() (ids []int) {
+	if id := m.bundle; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// ClearBundles clears the "bundles" edge to the Bundle entity.
-func (m *PokemonMutation) ClearBundles() {
-	m.clearedbundles = true
-}
-
-// BundlesCleared reports if the "bundles" edge to the Bundle entity was cleared.
-func (m *PokemonMutation) BundlesCleared() bool {
-	return m.clearedbundles
+// ResetBundle resets all changes to the "bundle" edge.
+func (m *BundleSlotMutation) ResetBundle() {
+	m.bundle = nil
+	m.clearedbundle = false
 }
 
-// RemoveBundleIDs removes the "bundles" edge to the Bundle entity by IDs.
-func (m *PokemonMutation) RemoveBundleIDs(ids ...int) {
-	if m.removedbundles == nil {
-		m.removedbundles = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.bundles, ids[i])
-		m.removedbundles[ids[i]] = struct{}{}
-	}
+// ClearPokemon clears the "pokemon" edge to the Pokemon entity.
+func (m *BundleSlotMutation) ClearPokemon() {
+	m.clearedpokemon = true
+	m.clearedFields[bundleslot.FieldPokemonID] = struct{}{}
 }
 
-// RemovedBundles returns the removed IDs of the "bundles" edge to the Bundle entity.
-func (m *PokemonMutation) RemovedBundlesIDs() (ids []int) {
-	for id := range m.removedbundles {
-		ids = append(ids, id)
-	}
-	return
+// PokemonCleared reports if the "pokemon" edge to the Pokemon entity was cleared.
+func (m *BundleSlotMutation) PokemonCleared() bool {
+	return m.clearedpokemon
 }
 
-// BundlesIDs returns the "bundles" edge IDs in the mutation.
-func (m *PokemonMutation) BundlesIDs() (ids []int) {
-	for id := range m.bundles {
-		ids = append(ids, id)
+// PokemonIDs returns the "pokemon" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PokemonID instead. It exists only for internal usage by the builders.
+func (m *BundleSlotMutation) PokemonIDs() (ids []int) {
+	if id := m.pokemon; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetBundles resets all changes to the "bundles" edge.
-func (m *PokemonMutation) ResetBundles() {
-	m.bundles = nil
-	m.clearedbundles = false
-	m.removedbundles = nil
+// ResetPokemon resets all changes to the "pokemon" edge.
+func (m *BundleSlotMutation) ResetPokemon() {
+	m.pokemon = nil
+	m.clearedpokemon = false
 }
 
-// Where appends a list predicates to the PokemonMutation builder.
-func (m *PokemonMutation) Where(ps ...predicate.Pokemon) {
+// Where appends a list predicates to the BundleSlotMutation builder.
+func (m *BundleSlotMutation) Where(ps ...predicate.BundleSlot) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PokemonMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BundleSlotMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PokemonMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Pokemon, len(ps))
+func (m *BundleSlotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BundleSlot, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1180,42 +1599,36 @@ func (m *PokemonMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PokemonMutation) Op() Op {
+func (m *BundleSlotMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PokemonMutation) SetOp(op Op) {
+func (m *BundleSlotMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Pokemon).
-func (m *PokemonMutation) Type() string {
+// Type returns the node type of this mutation (BundleSlot).
+func (m *BundleSlotMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PokemonMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.upload_datetime != nil {
-		fields = append(fields, pokemon.FieldUploadDatetime)
+func (m *BundleSlotMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.bundle != nil {
+		fields = append(fields, bundleslot.FieldBundleID)
 	}
-	if m.download_code != nil {
-		fields = append(fields, pokemon.FieldDownloadCode)
+	if m.pokemon != nil {
+		fields = append(fields, bundleslot.FieldPokemonID)
 	}
-	if m.download_count != nil {
-		fields = append(fields, pokemon.FieldDownloadCount)
+	if m.position != nil {
+		fields = append(fields, bundleslot.FieldPosition)
 	}
 	if m.generation != nil {
-		fields = append(fields, pokemon.FieldGeneration)
-	}
-	if m.legal != nil {
-		fields = append(fields, pokemon.FieldLegal)
-	}
-	if m.base_64 != nil {
-		fields = append(fields, pokemon.FieldBase64)
+		fields = append(fields, bundleslot.FieldGeneration)
 	}
 	return fields
 }
@@ -1223,20 +1636,16 @@ func (m *PokemonMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PokemonMutation) Field(name string) (ent.Value, bool) {
+func (m *BundleSlotMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case pokemon.FieldUploadDatetime:
-		return m.UploadDatetime()
-	case pokemon.FieldDownloadCode:
-		return m.DownloadCode()
-	case pokemon.FieldDownloadCount:
-		return m.DownloadCount()
-	case pokemon.FieldGeneration:
+	case bundleslot.FieldBundleID:
+		return m.BundleID()
+	case bundleslot.FieldPokemonID:
+		return m.PokemonID()
+	case bundleslot.FieldPosition:
+		return m.Position()
+	case bundleslot.FieldGeneration:
 		return m.Generation()
-	case pokemon.FieldLegal:
-		return m.Legal()
-	case pokemon.FieldBase64:
-		return m.Base64()
 	}
 	return nil, false
 }
@@ -1244,81 +1653,63 @@ func (m *PokemonMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PokemonMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BundleSlotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case pokemon.FieldUploadDatetime:
-		return m.OldUploadDatetime(ctx)
-	case pokemon.FieldDownloadCode:
-		return m.OldDownloadCode(ctx)
-	case pokemon.FieldDownloadCount:
-		return m.OldDownloadCount(ctx)
-	case pokemon.FieldGeneration:
+	case bundleslot.FieldBundleID:
+		return m.OldBundleID(ctx)
+	case bundleslot.FieldPokemonID:
+		return m.OldPokemonID(ctx)
+	case bundleslot.FieldPosition:
+		return m.OldPosition(ctx)
+	case bundleslot.FieldGeneration:
 		return m.OldGeneration(ctx)
-	case pokemon.FieldLegal:
-		return m.OldLegal(ctx)
-	case pokemon.FieldBase64:
-		return m.OldBase64(ctx)
 	}
-	return nil, fmt.Errorf("unknown Pokemon field %s", name)
+	return nil, fmt.Errorf("unknown BundleSlot field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PokemonMutation) SetField(name string, value ent.Value) error {
+func (m *BundleSlotMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case pokemon.FieldUploadDatetime:
-		v, ok := value.(time.Time)
+	case bundleslot.FieldBundleID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUploadDatetime(v)
+		m.SetBundleID(v)
 		return nil
-	case pokemon.FieldDownloadCode:
-		v, ok := value.(string)
+	case bundleslot.FieldPokemonID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDownloadCode(v)
+		m.SetPokemonID(v)
 		return nil
-	case pokemon.FieldDownloadCount:
+	case bundleslot.FieldPosition:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDownloadCount(v)
+		m.SetPosition(v)
 		return nil
-	case pokemon.FieldGeneration:
+	case bundleslot.FieldGeneration:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetGeneration(v)
 		return nil
-	case pokemon.FieldLegal:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLegal(v)
-		return nil
-	case pokemon.FieldBase64:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBase64(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Pokemon field %s", name)
+	return fmt.Errorf("unknown BundleSlot field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PokemonMutation) AddedFields() []string {
+func (m *BundleSlotMutation) AddedFields() []string {
 	var fields []string
-	if m.adddownload_count != nil {
-		fields = append(fields, pokemon.FieldDownloadCount)
+	if m.addposition != nil {
+		fields = append(fields, bundleslot.FieldPosition)
 	}
 	return fields
 }
@@ -1326,10 +1717,10 @@ func (m *PokemonMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PokemonMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BundleSlotMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case pokemon.FieldDownloadCount:
-		return m.AddedDownloadCount()
+	case bundleslot.FieldPosition:
+		return m.AddedPosition()
 	}
 	return nil, false
 }
@@ -1337,144 +1728,4473 @@ func (m *PokemonMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PokemonMutation) AddField(name string, value ent.Value) error {
+func (m *BundleSlotMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case pokemon.FieldDownloadCount:
+	case bundleslot.FieldPosition:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDownloadCount(v)
+		m.AddPosition(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Pokemon numeric field %s", name)
+	return fmt.Errorf("unknown BundleSlot numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PokemonMutation) ClearedFields() []string {
+func (m *BundleSlotMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PokemonMutation) FieldCleared(name string) bool {
+func (m *BundleSlotMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PokemonMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Pokemon nullable field %s", name)
+func (m *BundleSlotMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown BundleSlot nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PokemonMutation) ResetField(name string) error {
+func (m *BundleSlotMutation) ResetField(name string) error {
 	switch name {
-	case pokemon.FieldUploadDatetime:
-		m.ResetUploadDatetime()
+	case bundleslot.FieldBundleID:
+		m.ResetBundleID()
 		return nil
-	case pokemon.FieldDownloadCode:
-		m.ResetDownloadCode()
+	case bundleslot.FieldPokemonID:
+		m.ResetPokemonID()
 		return nil
-	case pokemon.FieldDownloadCount:
-		m.ResetDownloadCount()
+	case bundleslot.FieldPosition:
+		m.ResetPosition()
 		return nil
-	case pokemon.FieldGeneration:
+	case bundleslot.FieldGeneration:
 		m.ResetGeneration()
 		return nil
-	case pokemon.FieldLegal:
-		m.ResetLegal()
-		return nil
-	case pokemon.FieldBase64:
-		m.ResetBase64()
-		return nil
 	}
-	return fmt.Errorf("unknown Pokemon field %s", name)
+	return fmt.Errorf("unknown BundleSlot field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PokemonMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.bundles != nil {
-		edges = append(edges, pokemon.EdgeBundles)
+func (m *BundleSlotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.bundle != nil {
+		edges = append(edges, bundleslot.EdgeBundle)
+	}
+	if m.pokemon != nil {
+		edges = append(edges, bundleslot.EdgePokemon)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PokemonMutation) AddedIDs(name string) []ent.Value {
+func (m *BundleSlotMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case pokemon.EdgeBundles:
-		ids := make([]ent.Value, 0, len(m.bundles))
-		for id := range m.bundles {
-			ids = append(ids, id)
+	case bundleslot.EdgeBundle:
+		if id := m.bundle; id != nil {
+			return []ent.Value{*id}
+		}
+	case bundleslot.EdgePokemon:
+		if id := m.pokemon; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PokemonMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedbundles != nil {
-		edges = append(edges, pokemon.EdgeBundles)
-	}
+func (m *BundleSlotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PokemonMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case pokemon.EdgeBundles:
-		ids := make([]ent.Value, 0, len(m.removedbundles))
-		for id := range m.removedbundles {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *BundleSlotMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PokemonMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedbundles {
-		edges = append(edges, pokemon.EdgeBundles)
+func (m *BundleSlotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedbundle {
+		edges = append(edges, bundleslot.EdgeBundle)
+	}
+	if m.clearedpokemon {
+		edges = append(edges, bundleslot.EdgePokemon)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PokemonMutation) EdgeCleared(name string) bool {
+func (m *BundleSlotMutation) EdgeCleared(name string) bool {
 	switch name {
-	case pokemon.EdgeBundles:
-		return m.clearedbundles
+	case bundleslot.EdgeBundle:
+		return m.clearedbundle
+	case bundleslot.EdgePokemon:
+		return m.clearedpokemon
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PokemonMutation) ClearEdge(name string) error {
+func (m *BundleSlotMutation) ClearEdge(name string) error {
 	switch name {
+	case bundleslot.EdgeBundle:
+		m.ClearBundle()
+		return nil
+	case bundleslot.EdgePokemon:
+		m.ClearPokemon()
+		return nil
 	}
-	return fmt.Errorf("unknown Pokemon unique edge %s", name)
+	return fmt.Errorf("unknown BundleSlot unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PokemonMutation) ResetEdge(name string) error {
+func (m *BundleSlotMutation) ResetEdge(name string) error {
 	switch name {
-	case pokemon.EdgeBundles:
-		m.ResetBundles()
+	case bundleslot.EdgeBundle:
+		m.ResetBundle()
+		return nil
+	case bundleslot.EdgePokemon:
+		m.ResetPokemon()
 		return nil
 	}
-	return fmt.Errorf("unknown Pokemon edge %s", name)
+	return fmt.Errorf("unknown BundleSlot edge %s", name)
+}
+
+// LegalityCacheMutation represents an operation that mutates the LegalityCache nodes in the graph.
+type LegalityCacheMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	hash           *string
+	generation     *string
+	engine_version *string
+	legal          *bool
+	report         *[]string
+	appendreport   []string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*LegalityCache, error)
+	predicates     []predicate.LegalityCache
+}
+
+var _ ent.Mutation = (*LegalityCacheMutation)(nil)
+
+// legalitycacheOption allows management of the mutation configuration using functional options.
+type legalitycacheOption func(*LegalityCacheMutation)
+
+// newLegalityCacheMutation creates new mutation for the LegalityCache entity.
+func newLegalityCacheMutation(c config, op Op, opts ...legalitycacheOption) *LegalityCacheMutation {
+	m := &LegalityCacheMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLegalityCache,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLegalityCacheID sets the ID field of the mutation.
+func withLegalityCacheID(id int) legalitycacheOption {
+	return func(m *LegalityCacheMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LegalityCache
+		)
+		m.oldValue = func(ctx context.Context) (*LegalityCache, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LegalityCache.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLegalityCache sets the old LegalityCache of the mutation.
+func withLegalityCache(node *LegalityCache) legalitycacheOption {
+	return func(m *LegalityCacheMutation) {
+		m.oldValue = func(context.Context) (*LegalityCache, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LegalityCacheMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LegalityCacheMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LegalityCacheMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LegalityCacheMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LegalityCache.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetHash sets the "hash" field.
+func (m *LegalityCacheMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *LegalityCacheMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the LegalityCache entity.
+// If the LegalityCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LegalityCacheMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *LegalityCacheMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetGeneration sets the "generation" field.
+func (m *LegalityCacheMutation) SetGeneration(s string) {
+	m.generation = &s
+}
+
+// Generation returns the value of the "generation" field in the mutation.
+func (m *LegalityCacheMutation) Generation() (r string, exists bool) {
+	v := m.generation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGeneration returns the old "generation" field's value of the LegalityCache entity.
+// If the LegalityCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LegalityCacheMutation) OldGeneration(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGeneration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGeneration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGeneration: %w", err)
+	}
+	return oldValue.Generation, nil
+}
+
+// ResetGeneration resets all changes to the "generation" field.
+func (m *LegalityCacheMutation) ResetGeneration() {
+	m.generation = nil
+}
+
+// SetEngineVersion sets the "engine_version" field.
+func (m *LegalityCacheMutation) SetEngineVersion(s string) {
+	m.engine_version = &s
+}
+
+// EngineVersion returns the value of the "engine_version" field in the mutation.
+func (m *LegalityCacheMutation) EngineVersion() (r string, exists bool) {
+	v := m.engine_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEngineVersion returns the old "engine_version" field's value of the LegalityCache entity.
+// If the LegalityCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LegalityCacheMutation) OldEngineVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEngineVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEngineVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEngineVersion: %w", err)
+	}
+	return oldValue.EngineVersion, nil
+}
+
+// ResetEngineVersion resets all changes to the "engine_version" field.
+func (m *LegalityCacheMutation) ResetEngineVersion() {
+	m.engine_version = nil
+}
+
+// SetLegal sets the "legal" field.
+func (m *LegalityCacheMutation) SetLegal(b bool) {
+	m.legal = &b
+}
+
+// Legal returns the value of the "legal" field in the mutation.
+func (m *LegalityCacheMutation) Legal() (r bool, exists bool) {
+	v := m.legal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLegal returns the old "legal" field's value of the LegalityCache entity.
+// If the LegalityCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LegalityCacheMutation) OldLegal(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLegal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLegal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLegal: %w", err)
+	}
+	return oldValue.Legal, nil
+}
+
+// ResetLegal resets all changes to the "legal" field.
+func (m *LegalityCacheMutation) ResetLegal() {
+	m.legal = nil
+}
+
+// SetReport sets the "report" field.
+func (m *LegalityCacheMutation) SetReport(s []string) {
+	m.report = &s
+	m.appendreport = nil
+}
+
+// Report returns the value of the "report" field in the mutation.
+func (m *LegalityCacheMutation) Report() (r []string, exists bool) {
+	v := m.report
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReport returns the old "report" field's value of the LegalityCache entity.
+// If the LegalityCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LegalityCacheMutation) OldReport(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReport is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReport requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReport: %w", err)
+	}
+	return oldValue.Report, nil
+}
+
+// AppendReport adds s to the "report" field.
+func (m *LegalityCacheMutation) AppendReport(s []string) {
+	m.appendreport = append(m.appendreport, s...)
+}
+
+// AppendedReport returns the list of values that were appended to the "report" field in this mutation.
+func (m *LegalityCacheMutation) AppendedReport() ([]string, bool) {
+	if len(m.appendreport) == 0 {
+		return nil, false
+	}
+	return m.appendreport, true
+}
+
+// ResetReport resets all changes to the "report" field.
+func (m *LegalityCacheMutation) ResetReport() {
+	m.report = nil
+	m.appendreport = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LegalityCacheMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LegalityCacheMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LegalityCache entity.
+// If the LegalityCache object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LegalityCacheMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LegalityCacheMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the LegalityCacheMutation builder.
+func (m *LegalityCacheMutation) Where(ps ...predicate.LegalityCache) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LegalityCacheMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LegalityCacheMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LegalityCache, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LegalityCacheMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LegalityCacheMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LegalityCache).
+func (m *LegalityCacheMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LegalityCacheMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.hash != nil {
+		fields = append(fields, legalitycache.FieldHash)
+	}
+	if m.generation != nil {
+		fields = append(fields, legalitycache.FieldGeneration)
+	}
+	if m.engine_version != nil {
+		fields = append(fields, legalitycache.FieldEngineVersion)
+	}
+	if m.legal != nil {
+		fields = append(fields, legalitycache.FieldLegal)
+	}
+	if m.report != nil {
+		fields = append(fields, legalitycache.FieldReport)
+	}
+	if m.created_at != nil {
+		fields = append(fields, legalitycache.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LegalityCacheMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case legalitycache.FieldHash:
+		return m.Hash()
+	case legalitycache.FieldGeneration:
+		return m.Generation()
+	case legalitycache.FieldEngineVersion:
+		return m.EngineVersion()
+	case legalitycache.FieldLegal:
+		return m.Legal()
+	case legalitycache.FieldReport:
+		return m.Report()
+	case legalitycache.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LegalityCacheMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case legalitycache.FieldHash:
+		return m.OldHash(ctx)
+	case legalitycache.FieldGeneration:
+		return m.OldGeneration(ctx)
+	case legalitycache.FieldEngineVersion:
+		return m.OldEngineVersion(ctx)
+	case legalitycache.FieldLegal:
+		return m.OldLegal(ctx)
+	case legalitycache.FieldReport:
+		return m.OldReport(ctx)
+	case legalitycache.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LegalityCache field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LegalityCacheMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case legalitycache.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case legalitycache.FieldGeneration:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGeneration(v)
+		return nil
+	case legalitycache.FieldEngineVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEngineVersion(v)
+		return nil
+	case legalitycache.FieldLegal:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLegal(v)
+		return nil
+	case legalitycache.FieldReport:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReport(v)
+		return nil
+	case legalitycache.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LegalityCache field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LegalityCacheMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LegalityCacheMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LegalityCacheMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown LegalityCache numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LegalityCacheMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LegalityCacheMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LegalityCacheMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LegalityCache nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LegalityCacheMutation) ResetField(name string) error {
+	switch name {
+	case legalitycache.FieldHash:
+		m.ResetHash()
+		return nil
+	case legalitycache.FieldGeneration:
+		m.ResetGeneration()
+		return nil
+	case legalitycache.FieldEngineVersion:
+		m.ResetEngineVersion()
+		return nil
+	case legalitycache.FieldLegal:
+		m.ResetLegal()
+		return nil
+	case legalitycache.FieldReport:
+		m.ResetReport()
+		return nil
+	case legalitycache.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LegalityCache field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LegalityCacheMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LegalityCacheMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LegalityCacheMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LegalityCacheMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LegalityCacheMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LegalityCacheMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LegalityCacheMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LegalityCache unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LegalityCacheMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LegalityCache edge %s", name)
+}
+
+// PokemonMutation represents an operation that mutates the Pokemon nodes in the graph.
+type PokemonMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	visibility            *pokemon.Visibility
+	visibility_changed_at *time.Time
+	visibility_reason     *string
+	upload_datetime       *time.Time
+	download_code         *string
+	download_count        *int
+	adddownload_count     *int
+	generation            *string
+	legal                 *bool
+	data                  *[]byte
+	base_64               *string
+	content_hash          *string
+	legality_report       *[]string
+	appendlegality_report []string
+	engine_version        *string
+	legality_checked_at   *time.Time
+	species               *int
+	addspecies            *int
+	form                  *int
+	addform               *int
+	level                 *int
+	addlevel              *int
+	shiny                 *bool
+	nature                *int
+	addnature             *int
+	gender                *int
+	addgender             *int
+	ot_name               *string
+	tid                   *int
+	addtid                *int
+	sid                   *int
+	addsid                *int
+	ball                  *int
+	addball               *int
+	held_item             *int
+	addheld_item          *int
+	language              *int
+	addlanguage           *int
+	origin_game           *int
+	addorigin_game        *int
+	clearedFields         map[string]struct{}
+	bundle_slots          map[int]struct{}
+	removedbundle_slots   map[int]struct{}
+	clearedbundle_slots   bool
+	merged_into           *int
+	clearedmerged_into    bool
+	duplicates            map[int]struct{}
+	removedduplicates     map[int]struct{}
+	clearedduplicates     bool
+	done                  bool
+	oldValue              func(context.Context) (*Pokemon, error)
+	predicates            []predicate.Pokemon
+}
+
+var _ ent.Mutation = (*PokemonMutation)(nil)
+
+// pokemonOption allows management of the mutation configuration using functional options.
+type pokemonOption func(*PokemonMutation)
+
+// newPokemonMutation creates new mutation for the Pokemon entity.
+func newPokemonMutation(c config, op Op, opts ...pokemonOption) *PokemonMutation {
+	m := &PokemonMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePokemon,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPokemonID sets the ID field of the mutation.
+func withPokemonID(id int) pokemonOption {
+	return func(m *PokemonMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Pokemon
+		)
+		m.oldValue = func(ctx context.Context) (*Pokemon, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Pokemon.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPokemon sets the old Pokemon of the mutation.
+func withPokemon(node *Pokemon) pokemonOption {
+	return func(m *PokemonMutation) {
+		m.oldValue = func(context.Context) (*Pokemon, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PokemonMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PokemonMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PokemonMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PokemonMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Pokemon.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVisibility sets the "visibility" field.
+func (m *PokemonMutation) SetVisibility(po pokemon.Visibility) {
+	m.visibility = &po
+}
+
+// Visibility returns the value of the "visibility" field in the mutation.
+func (m *PokemonMutation) Visibility() (r pokemon.Visibility, exists bool) {
+	v := m.visibility
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisibility returns the old "visibility" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldVisibility(ctx context.Context) (v pokemon.Visibility, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisibility is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisibility requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisibility: %w", err)
+	}
+	return oldValue.Visibility, nil
+}
+
+// ResetVisibility resets all changes to the "visibility" field.
+func (m *PokemonMutation) ResetVisibility() {
+	m.visibility = nil
+}
+
+// SetVisibilityChangedAt sets the "visibility_changed_at" field.
+func (m *PokemonMutation) SetVisibilityChangedAt(t time.Time) {
+	m.visibility_changed_at = &t
+}
+
+// VisibilityChangedAt returns the value of the "visibility_changed_at" field in the mutation.
+func (m *PokemonMutation) VisibilityChangedAt() (r time.Time, exists bool) {
+	v := m.visibility_changed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisibilityChangedAt returns the old "visibility_changed_at" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldVisibilityChangedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisibilityChangedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisibilityChangedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisibilityChangedAt: %w", err)
+	}
+	return oldValue.VisibilityChangedAt, nil
+}
+
+// ClearVisibilityChangedAt clears the value of the "visibility_changed_at" field.
+func (m *PokemonMutation) ClearVisibilityChangedAt() {
+	m.visibility_changed_at = nil
+	m.clearedFields[pokemon.FieldVisibilityChangedAt] = struct{}{}
+}
+
+// VisibilityChangedAtCleared returns if the "visibility_changed_at" field was cleared in this mutation.
+func (m *PokemonMutation) VisibilityChangedAtCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldVisibilityChangedAt]
+	return ok
+}
+
+// ResetVisibilityChangedAt resets all changes to the "visibility_changed_at" field.
+func (m *PokemonMutation) ResetVisibilityChangedAt() {
+	m.visibility_changed_at = nil
+	delete(m.clearedFields, pokemon.FieldVisibilityChangedAt)
+}
+
+// SetVisibilityReason sets the "visibility_reason" field.
+func (m *PokemonMutation) SetVisibilityReason(s string) {
+	m.visibility_reason = &s
+}
+
+// VisibilityReason returns the value of the "visibility_reason" field in the mutation.
+func (m *PokemonMutation) VisibilityReason() (r string, exists bool) {
+	v := m.visibility_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisibilityReason returns the old "visibility_reason" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldVisibilityReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisibilityReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisibilityReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisibilityReason: %w", err)
+	}
+	return oldValue.VisibilityReason, nil
+}
+
+// ClearVisibilityReason clears the value of the "visibility_reason" field.
+func (m *PokemonMutation) ClearVisibilityReason() {
+	m.visibility_reason = nil
+	m.clearedFields[pokemon.FieldVisibilityReason] = struct{}{}
+}
+
+// VisibilityReasonCleared returns if the "visibility_reason" field was cleared in this mutation.
+func (m *PokemonMutation) VisibilityReasonCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldVisibilityReason]
+	return ok
+}
+
+// ResetVisibilityReason resets all changes to the "visibility_reason" field.
+func (m *PokemonMutation) ResetVisibilityReason() {
+	m.visibility_reason = nil
+	delete(m.clearedFields, pokemon.FieldVisibilityReason)
+}
+
+// SetUploadDatetime sets the "upload_datetime" field.
+func (m *PokemonMutation) SetUploadDatetime(t time.Time) {
+	m.upload_datetime = &t
+}
+
+// UploadDatetime returns the value of the "upload_datetime" field in the mutation.
+func (m *PokemonMutation) UploadDatetime() (r time.Time, exists bool) {
+	v := m.upload_datetime
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadDatetime returns the old "upload_datetime" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldUploadDatetime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadDatetime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadDatetime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadDatetime: %w", err)
+	}
+	return oldValue.UploadDatetime, nil
+}
+
+// ResetUploadDatetime resets all changes to the "upload_datetime" field.
+func (m *PokemonMutation) ResetUploadDatetime() {
+	m.upload_datetime = nil
+}
+
+// SetDownloadCode sets the "download_code" field.
+func (m *PokemonMutation) SetDownloadCode(s string) {
+	m.download_code = &s
+}
+
+// DownloadCode returns the value of the "download_code" field in the mutation.
+func (m *PokemonMutation) DownloadCode() (r string, exists bool) {
+	v := m.download_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDownloadCode returns the old "download_code" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldDownloadCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDownloadCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDownloadCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDownloadCode: %w", err)
+	}
+	return oldValue.DownloadCode, nil
+}
+
+// ResetDownloadCode resets all changes to the "download_code" field.
+func (m *PokemonMutation) ResetDownloadCode() {
+	m.download_code = nil
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (m *PokemonMutation) SetDownloadCount(i int) {
+	m.download_count = &i
+	m.adddownload_count = nil
+}
+
+// DownloadCount returns the value of the "download_count" field in the mutation.
+func (m *PokemonMutation) DownloadCount() (r int, exists bool) {
+	v := m.download_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDownloadCount returns the old "download_count" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldDownloadCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDownloadCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDownloadCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDownloadCount: %w", err)
+	}
+	return oldValue.DownloadCount, nil
+}
+
+// AddDownloadCount adds i to the "download_count" field.
+func (m *PokemonMutation) AddDownloadCount(i int) {
+	if m.adddownload_count != nil {
+		*m.adddownload_count += i
+	} else {
+		m.adddownload_count = &i
+	}
+}
+
+// AddedDownloadCount returns the value that was added to the "download_count" field in this mutation.
+func (m *PokemonMutation) AddedDownloadCount() (r int, exists bool) {
+	v := m.adddownload_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDownloadCount resets all changes to the "download_count" field.
+func (m *PokemonMutation) ResetDownloadCount() {
+	m.download_count = nil
+	m.adddownload_count = nil
+}
+
+// SetGeneration sets the "generation" field.
+func (m *PokemonMutation) SetGeneration(s string) {
+	m.generation = &s
+}
+
+// Generation returns the value of the "generation" field in the mutation.
+func (m *PokemonMutation) Generation() (r string, exists bool) {
+	v := m.generation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGeneration returns the old "generation" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldGeneration(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGeneration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGeneration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGeneration: %w", err)
+	}
+	return oldValue.Generation, nil
+}
+
+// ResetGeneration resets all changes to the "generation" field.
+func (m *PokemonMutation) ResetGeneration() {
+	m.generation = nil
+}
+
+// SetLegal sets the "legal" field.
+func (m *PokemonMutation) SetLegal(b bool) {
+	m.legal = &b
+}
+
+// Legal returns the value of the "legal" field in the mutation.
+func (m *PokemonMutation) Legal() (r bool, exists bool) {
+	v := m.legal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLegal returns the old "legal" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldLegal(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLegal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLegal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLegal: %w", err)
+	}
+	return oldValue.Legal, nil
+}
+
+// ResetLegal resets all changes to the "legal" field.
+func (m *PokemonMutation) ResetLegal() {
+	m.legal = nil
+}
+
+// SetData sets the "data" field.
+func (m *PokemonMutation) SetData(b []byte) {
+	m.data = &b
+}
+
+// Data returns the value of the "data" field in the mutation.
+func (m *PokemonMutation) Data() (r []byte, exists bool) {
+	v := m.data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldData returns the old "data" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldData(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldData: %w", err)
+	}
+	return oldValue.Data, nil
+}
+
+// ClearData clears the value of the "data" field.
+func (m *PokemonMutation) ClearData() {
+	m.data = nil
+	m.clearedFields[pokemon.FieldData] = struct{}{}
+}
+
+// DataCleared returns if the "data" field was cleared in this mutation.
+func (m *PokemonMutation) DataCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldData]
+	return ok
+}
+
+// ResetData resets all changes to the "data" field.
+func (m *PokemonMutation) ResetData() {
+	m.data = nil
+	delete(m.clearedFields, pokemon.FieldData)
+}
+
+// SetBase64 sets the "base_64" field.
+func (m *PokemonMutation) SetBase64(s string) {
+	m.base_64 = &s
+}
+
+// Base64 returns the value of the "base_64" field in the mutation.
+func (m *PokemonMutation) Base64() (r string, exists bool) {
+	v := m.base_64
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBase64 returns the old "base_64" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldBase64(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBase64 is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBase64 requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBase64: %w", err)
+	}
+	return oldValue.Base64, nil
+}
+
+// ClearBase64 clears the value of the "base_64" field.
+func (m *PokemonMutation) ClearBase64() {
+	m.base_64 = nil
+	m.clearedFields[pokemon.FieldBase64] = struct{}{}
+}
+
+// Base64Cleared returns if the "base_64" field was cleared in this mutation.
+func (m *PokemonMutation) Base64Cleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldBase64]
+	return ok
+}
+
+// ResetBase64 resets all changes to the "base_64" field.
+func (m *PokemonMutation) ResetBase64() {
+	m.base_64 = nil
+	delete(m.clearedFields, pokemon.FieldBase64)
+}
+
+// SetContentHash sets the "content_hash" field.
+func (m *PokemonMutation) SetContentHash(s string) {
+	m.content_hash = &s
+}
+
+// ContentHash returns the value of the "content_hash" field in the mutation.
+func (m *PokemonMutation) ContentHash() (r string, exists bool) {
+	v := m.content_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentHash returns the old "content_hash" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldContentHash(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentHash: %w", err)
+	}
+	return oldValue.ContentHash, nil
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (m *PokemonMutation) ClearContentHash() {
+	m.content_hash = nil
+	m.clearedFields[pokemon.FieldContentHash] = struct{}{}
+}
+
+// ContentHashCleared returns if the "content_hash" field was cleared in this mutation.
+func (m *PokemonMutation) ContentHashCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldContentHash]
+	return ok
+}
+
+// ResetContentHash resets all changes to the "content_hash" field.
+func (m *PokemonMutation) ResetContentHash() {
+	m.content_hash = nil
+	delete(m.clearedFields, pokemon.FieldContentHash)
+}
+
+// SetLegalityReport sets the "legality_report" field.
+func (m *PokemonMutation) SetLegalityReport(s []string) {
+	m.legality_report = &s
+	m.appendlegality_report = nil
+}
+
+// LegalityReport returns the value of the "legality_report" field in the mutation.
+func (m *PokemonMutation) LegalityReport() (r []string, exists bool) {
+	v := m.legality_report
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLegalityReport returns the old "legality_report" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldLegalityReport(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLegalityReport is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLegalityReport requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLegalityReport: %w", err)
+	}
+	return oldValue.LegalityReport, nil
+}
+
+// AppendLegalityReport adds s to the "legality_report" field.
+func (m *PokemonMutation) AppendLegalityReport(s []string) {
+	m.appendlegality_report = append(m.appendlegality_report, s...)
+}
+
+// AppendedLegalityReport returns the list of values that were appended to the "legality_report" field in this mutation.
+func (m *PokemonMutation) AppendedLegalityReport() ([]string, bool) {
+	if len(m.appendlegality_report) == 0 {
+		return nil, false
+	}
+	return m.appendlegality_report, true
+}
+
+// ClearLegalityReport clears the value of the "legality_report" field.
+func (m *PokemonMutation) ClearLegalityReport() {
+	m.legality_report = nil
+	m.appendlegality_report = nil
+	m.clearedFields[pokemon.FieldLegalityReport] = struct{}{}
+}
+
+// LegalityReportCleared returns if the "legality_report" field was cleared in this mutation.
+func (m *PokemonMutation) LegalityReportCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldLegalityReport]
+	return ok
+}
+
+// ResetLegalityReport resets all changes to the "legality_report" field.
+func (m *PokemonMutation) ResetLegalityReport() {
+	m.legality_report = nil
+	m.appendlegality_report = nil
+	delete(m.clearedFields, pokemon.FieldLegalityReport)
+}
+
+// SetEngineVersion sets the "engine_version" field.
+func (m *PokemonMutation) SetEngineVersion(s string) {
+	m.engine_version = &s
+}
+
+// EngineVersion returns the value of the "engine_version" field in the mutation.
+func (m *PokemonMutation) EngineVersion() (r string, exists bool) {
+	v := m.engine_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEngineVersion returns the old "engine_version" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldEngineVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEngineVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEngineVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEngineVersion: %w", err)
+	}
+	return oldValue.EngineVersion, nil
+}
+
+// ClearEngineVersion clears the value of the "engine_version" field.
+func (m *PokemonMutation) ClearEngineVersion() {
+	m.engine_version = nil
+	m.clearedFields[pokemon.FieldEngineVersion] = struct{}{}
+}
+
+// EngineVersionCleared returns if the "engine_version" field was cleared in this mutation.
+func (m *PokemonMutation) EngineVersionCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldEngineVersion]
+	return ok
+}
+
+// ResetEngineVersion resets all changes to the "engine_version" field.
+func (m *PokemonMutation) ResetEngineVersion() {
+	m.engine_version = nil
+	delete(m.clearedFields, pokemon.FieldEngineVersion)
+}
+
+// SetLegalityCheckedAt sets the "legality_checked_at" field.
+func (m *PokemonMutation) SetLegalityCheckedAt(t time.Time) {
+	m.legality_checked_at = &t
+}
+
+// LegalityCheckedAt returns the value of the "legality_checked_at" field in the mutation.
+func (m *PokemonMutation) LegalityCheckedAt() (r time.Time, exists bool) {
+	v := m.legality_checked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLegalityCheckedAt returns the old "legality_checked_at" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldLegalityCheckedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLegalityCheckedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLegalityCheckedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLegalityCheckedAt: %w", err)
+	}
+	return oldValue.LegalityCheckedAt, nil
+}
+
+// ClearLegalityCheckedAt clears the value of the "legality_checked_at" field.
+func (m *PokemonMutation) ClearLegalityCheckedAt() {
+	m.legality_checked_at = nil
+	m.clearedFields[pokemon.FieldLegalityCheckedAt] = struct{}{}
+}
+
+// LegalityCheckedAtCleared returns if the "legality_checked_at" field was cleared in this mutation.
+func (m *PokemonMutation) LegalityCheckedAtCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldLegalityCheckedAt]
+	return ok
+}
+
+// ResetLegalityCheckedAt resets all changes to the "legality_checked_at" field.
+func (m *PokemonMutation) ResetLegalityCheckedAt() {
+	m.legality_checked_at = nil
+	delete(m.clearedFields, pokemon.FieldLegalityCheckedAt)
+}
+
+// SetSpecies sets the "species" field.
+func (m *PokemonMutation) SetSpecies(i int) {
+	m.species = &i
+	m.addspecies = nil
+}
+
+// Species returns the value of the "species" field in the mutation.
+func (m *PokemonMutation) Species() (r int, exists bool) {
+	v := m.species
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpecies returns the old "species" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldSpecies(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpecies is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpecies requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpecies: %w", err)
+	}
+	return oldValue.Species, nil
+}
+
+// AddSpecies adds i to the "species" field.
+func (m *PokemonMutation) AddSpecies(i int) {
+	if m.addspecies != nil {
+		*m.addspecies += i
+	} else {
+		m.addspecies = &i
+	}
+}
+
+// AddedSpecies returns the value that was added to the "species" field in this mutation.
+func (m *PokemonMutation) AddedSpecies() (r int, exists bool) {
+	v := m.addspecies
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSpecies clears the value of the "species" field.
+func (m *PokemonMutation) ClearSpecies() {
+	m.species = nil
+	m.addspecies = nil
+	m.clearedFields[pokemon.FieldSpecies] = struct{}{}
+}
+
+// SpeciesCleared returns if the "species" field was cleared in this mutation.
+func (m *PokemonMutation) SpeciesCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldSpecies]
+	return ok
+}
+
+// ResetSpecies resets all changes to the "species" field.
+func (m *PokemonMutation) ResetSpecies() {
+	m.species = nil
+	m.addspecies = nil
+	delete(m.clearedFields, pokemon.FieldSpecies)
+}
+
+// SetForm sets the "form" field.
+func (m *PokemonMutation) SetForm(i int) {
+	m.form = &i
+	m.addform = nil
+}
+
+// Form returns the value of the "form" field in the mutation.
+func (m *PokemonMutation) Form() (r int, exists bool) {
+	v := m.form
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldForm returns the old "form" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldForm(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldForm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldForm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldForm: %w", err)
+	}
+	return oldValue.Form, nil
+}
+
+// AddForm adds i to the "form" field.
+func (m *PokemonMutation) AddForm(i int) {
+	if m.addform != nil {
+		*m.addform += i
+	} else {
+		m.addform = &i
+	}
+}
+
+// AddedForm returns the value that was added to the "form" field in this mutation.
+func (m *PokemonMutation) AddedForm() (r int, exists bool) {
+	v := m.addform
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearForm clears the value of the "form" field.
+func (m *PokemonMutation) ClearForm() {
+	m.form = nil
+	m.addform = nil
+	m.clearedFields[pokemon.FieldForm] = struct{}{}
+}
+
+// FormCleared returns if the "form" field was cleared in this mutation.
+func (m *PokemonMutation) FormCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldForm]
+	return ok
+}
+
+// ResetForm resets all changes to the "form" field.
+func (m *PokemonMutation) ResetForm() {
+	m.form = nil
+	m.addform = nil
+	delete(m.clearedFields, pokemon.FieldForm)
+}
+
+// SetLevel sets the "level" field.
+func (m *PokemonMutation) SetLevel(i int) {
+	m.level = &i
+	m.addlevel = nil
+}
+
+// Level returns the value of the "level" field in the mutation.
+func (m *PokemonMutation) Level() (r int, exists bool) {
+	v := m.level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLevel returns the old "level" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldLevel(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
+	}
+	return oldValue.Level, nil
+}
+
+// AddLevel adds i to the "level" field.
+func (m *PokemonMutation) AddLevel(i int) {
+	if m.addlevel != nil {
+		*m.addlevel += i
+	} else {
+		m.addlevel = &i
+	}
+}
+
+// AddedLevel returns the value that was added to the "level" field in this mutation.
+func (m *PokemonMutation) AddedLevel() (r int, exists bool) {
+	v := m.addlevel
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLevel clears the value of the "level" field.
+func (m *PokemonMutation) ClearLevel() {
+	m.level = nil
+	m.addlevel = nil
+	m.clearedFields[pokemon.FieldLevel] = struct{}{}
+}
+
+// LevelCleared returns if the "level" field was cleared in this mutation.
+func (m *PokemonMutation) LevelCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldLevel]
+	return ok
+}
+
+// ResetLevel resets all changes to the "level" field.
+func (m *PokemonMutation) ResetLevel() {
+	m.level = nil
+	m.addlevel = nil
+	delete(m.clearedFields, pokemon.FieldLevel)
+}
+
+// SetShiny sets the "shiny" field.
+func (m *PokemonMutation) SetShiny(b bool) {
+	m.shiny = &b
+}
+
+// Shiny returns the value of the "shiny" field in the mutation.
+func (m *PokemonMutation) Shiny() (r bool, exists bool) {
+	v := m.shiny
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShiny returns the old "shiny" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldShiny(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShiny is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShiny requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShiny: %w", err)
+	}
+	return oldValue.Shiny, nil
+}
+
+// ClearShiny clears the value of the "shiny" field.
+func (m *PokemonMutation) ClearShiny() {
+	m.shiny = nil
+	m.clearedFields[pokemon.FieldShiny] = struct{}{}
+}
+
+// ShinyCleared returns if the "shiny" field was cleared in this mutation.
+func (m *PokemonMutation) ShinyCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldShiny]
+	return ok
+}
+
+// ResetShiny resets all changes to the "shiny" field.
+func (m *PokemonMutation) ResetShiny() {
+	m.shiny = nil
+	delete(m.clearedFields, pokemon.FieldShiny)
+}
+
+// SetNature sets the "nature" field.
+func (m *PokemonMutation) SetNature(i int) {
+	m.nature = &i
+	m.addnature = nil
+}
+
+// Nature returns the value of the "nature" field in the mutation.
+func (m *PokemonMutation) Nature() (r int, exists bool) {
+	v := m.nature
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNature returns the old "nature" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldNature(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNature is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNature requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNature: %w", err)
+	}
+	return oldValue.Nature, nil
+}
+
+// AddNature adds i to the "nature" field.
+func (m *PokemonMutation) AddNature(i int) {
+	if m.addnature != nil {
+		*m.addnature += i
+	} else {
+		m.addnature = &i
+	}
+}
+
+// AddedNature returns the value that was added to the "nature" field in this mutation.
+func (m *PokemonMutation) AddedNature() (r int, exists bool) {
+	v := m.addnature
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearNature clears the value of the "nature" field.
+func (m *PokemonMutation) ClearNature() {
+	m.nature = nil
+	m.addnature = nil
+	m.clearedFields[pokemon.FieldNature] = struct{}{}
+}
+
+// NatureCleared returns if the "nature" field was cleared in this mutation.
+func (m *PokemonMutation) NatureCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldNature]
+	return ok
+}
+
+// ResetNature resets all changes to the "nature" field.
+func (m *PokemonMutation) ResetNature() {
+	m.nature = nil
+	m.addnature = nil
+	delete(m.clearedFields, pokemon.FieldNature)
+}
+
+// SetGender sets the "gender" field.
+func (m *PokemonMutation) SetGender(i int) {
+	m.gender = &i
+	m.addgender = nil
+}
+
+// Gender returns the value of the "gender" field in the mutation.
+func (m *PokemonMutation) Gender() (r int, exists bool) {
+	v := m.gender
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGender returns the old "gender" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldGender(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGender is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGender requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGender: %w", err)
+	}
+	return oldValue.Gender, nil
+}
+
+// AddGender adds i to the "gender" field.
+func (m *PokemonMutation) AddGender(i int) {
+	if m.addgender != nil {
+		*m.addgender += i
+	} else {
+		m.addgender = &i
+	}
+}
+
+// AddedGender returns the value that was added to the "gender" field in this mutation.
+func (m *PokemonMutation) AddedGender() (r int, exists bool) {
+	v := m.addgender
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearGender clears the value of the "gender" field.
+func (m *PokemonMutation) ClearGender() {
+	m.gender = nil
+	m.addgender = nil
+	m.clearedFields[pokemon.FieldGender] = struct{}{}
+}
+
+// GenderCleared returns if the "gender" field was cleared in this mutation.
+func (m *PokemonMutation) GenderCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldGender]
+	return ok
+}
+
+// ResetGender resets all changes to the "gender" field.
+func (m *PokemonMutation) ResetGender() {
+	m.gender = nil
+	m.addgender = nil
+	delete(m.clearedFields, pokemon.FieldGender)
+}
+
+// SetOtName sets the "ot_name" field.
+func (m *PokemonMutation) SetOtName(s string) {
+	m.ot_name = &s
+}
+
+// OtName returns the value of the "ot_name" field in the mutation.
+func (m *PokemonMutation) OtName() (r string, exists bool) {
+	v := m.ot_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOtName returns the old "ot_name" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldOtName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOtName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOtName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOtName: %w", err)
+	}
+	return oldValue.OtName, nil
+}
+
+// ClearOtName clears the value of the "ot_name" field.
+func (m *PokemonMutation) ClearOtName() {
+	m.ot_name = nil
+	m.clearedFields[pokemon.FieldOtName] = struct{}{}
+}
+
+// OtNameCleared returns if the "ot_name" field was cleared in this mutation.
+func (m *PokemonMutation) OtNameCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldOtName]
+	return ok
+}
+
+// ResetOtName resets all changes to the "ot_name" field.
+func (m *PokemonMutation) ResetOtName() {
+	m.ot_name = nil
+	delete(m.clearedFields, pokemon.FieldOtName)
+}
+
+// SetTid sets the "tid" field.
+func (m *PokemonMutation) SetTid(i int) {
+	m.tid = &i
+	m.addtid = nil
+}
+
+// Tid returns the value of the "tid" field in the mutation.
+func (m *PokemonMutation) Tid() (r int, exists bool) {
+	v := m.tid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTid returns the old "tid" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldTid(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTid: %w", err)
+	}
+	return oldValue.Tid, nil
+}
+
+// AddTid adds i to the "tid" field.
+func (m *PokemonMutation) AddTid(i int) {
+	if m.addtid != nil {
+		*m.addtid += i
+	} else {
+		m.addtid = &i
+	}
+}
+
+// AddedTid returns the value that was added to the "tid" field in this mutation.
+func (m *PokemonMutation) AddedTid() (r int, exists bool) {
+	v := m.addtid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTid clears the value of the "tid" field.
+func (m *PokemonMutation) ClearTid() {
+	m.tid = nil
+	m.addtid = nil
+	m.clearedFields[pokemon.FieldTid] = struct{}{}
+}
+
+// TidCleared returns if the "tid" field was cleared in this mutation.
+func (m *PokemonMutation) TidCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldTid]
+	return ok
+}
+
+// ResetTid resets all changes to the "tid" field.
+func (m *PokemonMutation) ResetTid() {
+	m.tid = nil
+	m.addtid = nil
+	delete(m.clearedFields, pokemon.FieldTid)
+}
+
+// SetSid sets the "sid" field.
+func (m *PokemonMutation) SetSid(i int) {
+	m.sid = &i
+	m.addsid = nil
+}
+
+// Sid returns the value of the "sid" field in the mutation.
+func (m *PokemonMutation) Sid() (r int, exists bool) {
+	v := m.sid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSid returns the old "sid" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldSid(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSid: %w", err)
+	}
+	return oldValue.Sid, nil
+}
+
+// AddSid adds i to the "sid" field.
+func (m *PokemonMutation) AddSid(i int) {
+	if m.addsid != nil {
+		*m.addsid += i
+	} else {
+		m.addsid = &i
+	}
+}
+
+// AddedSid returns the value that was added to the "sid" field in this mutation.
+func (m *PokemonMutation) AddedSid() (r int, exists bool) {
+	v := m.addsid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSid clears the value of the "sid" field.
+func (m *PokemonMutation) ClearSid() {
+	m.sid = nil
+	m.addsid = nil
+	m.clearedFields[pokemon.FieldSid] = struct{}{}
+}
+
+// SidCleared returns if the "sid" field was cleared in this mutation.
+func (m *PokemonMutation) SidCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldSid]
+	return ok
+}
+
+// ResetSid resets all changes to the "sid" field.
+func (m *PokemonMutation) ResetSid() {
+	m.sid = nil
+	m.addsid = nil
+	delete(m.clearedFields, pokemon.FieldSid)
+}
+
+// SetBall sets the "ball" field.
+func (m *PokemonMutation) SetBall(i int) {
+	m.ball = &i
+	m.addball = nil
+}
+
+// Ball returns the value of the "ball" field in the mutation.
+func (m *PokemonMutation) Ball() (r int, exists bool) {
+	v := m.ball
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBall returns the old "ball" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldBall(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBall is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBall requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBall: %w", err)
+	}
+	return oldValue.Ball, nil
+}
+
+// AddBall adds i to the "ball" field.
+func (m *PokemonMutation) AddBall(i int) {
+	if m.addball != nil {
+		*m.addball += i
+	} else {
+		m.addball = &i
+	}
+}
+
+// AddedBall returns the value that was added to the "ball" field in this mutation.
+func (m *PokemonMutation) AddedBall() (r int, exists bool) {
+	v := m.addball
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBall clears the value of the "ball" field.
+func (m *PokemonMutation) ClearBall() {
+	m.ball = nil
+	m.addball = nil
+	m.clearedFields[pokemon.FieldBall] = struct{}{}
+}
+
+// BallCleared returns if the "ball" field was cleared in this mutation.
+func (m *PokemonMutation) BallCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldBall]
+	return ok
+}
+
+// ResetBall resets all changes to the "ball" field.
+func (m *PokemonMutation) ResetBall() {
+	m.ball = nil
+	m.addball = nil
+	delete(m.clearedFields, pokemon.FieldBall)
+}
+
+// SetHeldItem sets the "held_item" field.
+func (m *PokemonMutation) SetHeldItem(i int) {
+	m.held_item = &i
+	m.addheld_item = nil
+}
+
+// HeldItem returns the value of the "held_item" field in the mutation.
+func (m *PokemonMutation) HeldItem() (r int, exists bool) {
+	v := m.held_item
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeldItem returns the old "held_item" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldHeldItem(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeldItem is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeldItem requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeldItem: %w", err)
+	}
+	return oldValue.HeldItem, nil
+}
+
+// AddHeldItem adds i to the "held_item" field.
+func (m *PokemonMutation) AddHeldItem(i int) {
+	if m.addheld_item != nil {
+		*m.addheld_item += i
+	} else {
+		m.addheld_item = &i
+	}
+}
+
+// AddedHeldItem returns the value that was added to the "held_item" field in this mutation.
+func (m *PokemonMutation) AddedHeldItem() (r int, exists bool) {
+	v := m.addheld_item
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearHeldItem clears the value of the "held_item" field.
+func (m *PokemonMutation) ClearHeldItem() {
+	m.held_item = nil
+	m.addheld_item = nil
+	m.clearedFields[pokemon.FieldHeldItem] = struct{}{}
+}
+
+// HeldItemCleared returns if the "held_item" field was cleared in this mutation.
+func (m *PokemonMutation) HeldItemCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldHeldItem]
+	return ok
+}
+
+// ResetHeldItem resets all changes to the "held_item" field.
+func (m *PokemonMutation) ResetHeldItem() {
+	m.held_item = nil
+	m.addheld_item = nil
+	delete(m.clearedFields, pokemon.FieldHeldItem)
+}
+
+// SetLanguage sets the "language" field.
+func (m *PokemonMutation) SetLanguage(i int) {
+	m.language = &i
+	m.addlanguage = nil
+}
+
+// Language returns the value of the "language" field in the mutation.
+func (m *PokemonMutation) Language() (r int, exists bool) {
+	v := m.language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguage returns the old "language" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldLanguage(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
+}
+
+// AddLanguage adds i to the "language" field.
+func (m *PokemonMutation) AddLanguage(i int) {
+	if m.addlanguage != nil {
+		*m.addlanguage += i
+	} else {
+		m.addlanguage = &i
+	}
+}
+
+// AddedLanguage returns the value that was added to the "language" field in this mutation.
+func (m *PokemonMutation) AddedLanguage() (r int, exists bool) {
+	v := m.addlanguage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (m *PokemonMutation) ClearLanguage() {
+	m.language = nil
+	m.addlanguage = nil
+	m.clearedFields[pokemon.FieldLanguage] = struct{}{}
+}
+
+// LanguageCleared returns if the "language" field was cleared in this mutation.
+func (m *PokemonMutation) LanguageCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldLanguage]
+	return ok
+}
+
+// ResetLanguage resets all changes to the "language" field.
+func (m *PokemonMutation) ResetLanguage() {
+	m.language = nil
+	m.addlanguage = nil
+	delete(m.clearedFields, pokemon.FieldLanguage)
+}
+
+// SetOriginGame sets the "origin_game" field.
+func (m *PokemonMutation) SetOriginGame(i int) {
+	m.origin_game = &i
+	m.addorigin_game = nil
+}
+
+// OriginGame returns the value of the "origin_game" field in the mutation.
+func (m *PokemonMutation) OriginGame() (r int, exists bool) {
+	v := m.origin_game
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOriginGame returns the old "origin_game" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldOriginGame(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOriginGame is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOriginGame requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOriginGame: %w", err)
+	}
+	return oldValue.OriginGame, nil
+}
+
+// AddOriginGame adds i to the "origin_game" field.
+func (m *PokemonMutation) AddOriginGame(i int) {
+	if m.addorigin_game != nil {
+		*m.addorigin_game += i
+	} else {
+		m.addorigin_game = &i
+	}
+}
+
+// AddedOriginGame returns the value that was added to the "origin_game" field in this mutation.
+func (m *PokemonMutation) AddedOriginGame() (r int, exists bool) {
+	v := m.addorigin_game
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOriginGame clears the value of the "origin_game" field.
+func (m *PokemonMutation) ClearOriginGame() {
+	m.origin_game = nil
+	m.addorigin_game = nil
+	m.clearedFields[pokemon.FieldOriginGame] = struct{}{}
+}
+
+// OriginGameCleared returns if the "origin_game" field was cleared in this mutation.
+func (m *PokemonMutation) OriginGameCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldOriginGame]
+	return ok
+}
+
+// ResetOriginGame resets all changes to the "origin_game" field.
+func (m *PokemonMutation) ResetOriginGame() {
+	m.origin_game = nil
+	m.addorigin_game = nil
+	delete(m.clearedFields, pokemon.FieldOriginGame)
+}
+
+// SetMergedIntoID sets the "merged_into_id" field.
+func (m *PokemonMutation) SetMergedIntoID(i int) {
+	m.merged_into = &i
+}
+
+// MergedIntoID returns the value of the "merged_into_id" field in the mutation.
+func (m *PokemonMutation) MergedIntoID() (r int, exists bool) {
+	v := m.merged_into
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMergedIntoID returns the old "merged_into_id" field's value of the Pokemon entity.
+// If the Pokemon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PokemonMutation) OldMergedIntoID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMergedIntoID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMergedIntoID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMergedIntoID: %w", err)
+	}
+	return oldValue.MergedIntoID, nil
+}
+
+// ClearMergedIntoID clears the value of the "merged_into_id" field.
+func (m *PokemonMutation) ClearMergedIntoID() {
+	m.merged_into = nil
+	m.clearedFields[pokemon.FieldMergedIntoID] = struct{}{}
+}
+
+// MergedIntoIDCleared returns if the "merged_into_id" field was cleared in this mutation.
+func (m *PokemonMutation) MergedIntoIDCleared() bool {
+	_, ok := m.clearedFields[pokemon.FieldMergedIntoID]
+	return ok
+}
+
+// ResetMergedIntoID resets all changes to the "merged_into_id" field.
+func (m *PokemonMutation) ResetMergedIntoID() {
+	m.merged_into = nil
+	delete(m.clearedFields, pokemon.FieldMergedIntoID)
+}
+
+// AddBundleSlotIDs adds the "bundle_slots" edge to the BundleSlot entity by ids.
+func (m *PokemonMutation) AddBundleSlotIDs(ids ...int) {
+	if m.bundle_slots == nil {
+		m.bundle_slots = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.bundle_slots[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBundleSlots clears the "bundle_slots" edge to the BundleSlot entity.
+func (m *PokemonMutation) ClearBundleSlots() {
+	m.clearedbundle_slots = true
+}
+
+// BundleSlotsCleared reports if the "bundle_slots" edge to the BundleSlot entity was cleared.
+func (m *PokemonMutation) BundleSlotsCleared() bool {
+	return m.clearedbundle_slots
+}
+
+// RemoveBundleSlotIDs removes the "bundle_slots" edge to the BundleSlot entity by IDs.
+func (m *PokemonMutation) RemoveBundleSlotIDs(ids ...int) {
+	if m.removedbundle_slots == nil {
+		m.removedbundle_slots = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.bundle_slots, ids[i])
+		m.removedbundle_slots[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBundleSlots returns the removed IDs of the "bundle_slots" edge to the BundleSlot entity.
+func (m *PokemonMutation) RemovedBundleSlotsIDs() (ids []int) {
+	for id := range m.removedbundle_slots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BundleSlotsIDs returns the "bundle_slots" edge IDs in the mutation.
+func (m *PokemonMutation) BundleSlotsIDs() (ids []int) {
+	for id := range m.bundle_slots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBundleSlots resets all changes to the "bundle_slots" edge.
+func (m *PokemonMutation) ResetBundleSlots() {
+	m.bundle_slots = nil
+	m.clearedbundle_slots = false
+	m.removedbundle_slots = nil
+}
+
+// ClearMergedInto clears the "merged_into" edge to the Pokemon entity.
+func (m *PokemonMutation) ClearMergedInto() {
+	m.clearedmerged_into = true
+	m.clearedFields[pokemon.FieldMergedIntoID] = struct{}{}
+}
+
+// MergedIntoCleared reports if the "merged_into" edge to the Pokemon entity was cleared.
+func (m *PokemonMutation) MergedIntoCleared() bool {
+	return m.MergedIntoIDCleared() || m.clearedmerged_into
+}
+
+// MergedIntoIDs returns the "merged_into" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MergedIntoID instead. It exists only for internal usage by the builders.
+func (m *PokemonMutation) MergedIntoIDs() (ids []int) {
+	if id := m.merged_into; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMergedInto resets all changes to the "merged_into" edge.
+func (m *PokemonMutation) ResetMergedInto() {
+	m.merged_into = nil
+	m.clearedmerged_into = false
+}
+
+// AddDuplicateIDs adds the "duplicates" edge to the Pokemon entity by ids.
+func (m *PokemonMutation) AddDuplicateIDs(ids ...int) {
+	if m.duplicates == nil {
+		m.duplicates = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.duplicates[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDuplicates clears the "duplicates" edge to the Pokemon entity.
+func (m *PokemonMutation) ClearDuplicates() {
+	m.clearedduplicates = true
+}
+
+// DuplicatesCleared reports if the "duplicates" edge to the Pokemon entity was cleared.
+func (m *PokemonMutation) DuplicatesCleared() bool {
+	return m.clearedduplicates
+}
+
+// RemoveDuplicateIDs removes the "duplicates" edge to the Pokemon entity by IDs.
+func (m *PokemonMutation) RemoveDuplicateIDs(ids ...int) {
+	if m.removedduplicates == nil {
+		m.removedduplicates = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.duplicates, ids[i])
+		m.removedduplicates[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDuplicates returns the removed IDs of the "duplicates" edge to the Pokemon entity.
+func (m *PokemonMutation) RemovedDuplicatesIDs() (ids []int) {
+	for id := range m.removedduplicates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DuplicatesIDs returns the "duplicates" edge IDs in the mutation.
+func (m *PokemonMutation) DuplicatesIDs() (ids []int) {
+	for id := range m.duplicates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDuplicates resets all changes to the "duplicates" edge.
+func (m *PokemonMutation) ResetDuplicates() {
+	m.duplicates = nil
+	m.clearedduplicates = false
+	m.removedduplicates = nil
+}
+
+// Where appends a list predicates to the PokemonMutation builder.
+func (m *PokemonMutation) Where(ps ...predicate.Pokemon) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PokemonMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PokemonMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Pokemon, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PokemonMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PokemonMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Pokemon).
+func (m *PokemonMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PokemonMutation) Fields() []string {
+	fields := make([]string, 0, 28)
+	if m.visibility != nil {
+		fields = append(fields, pokemon.FieldVisibility)
+	}
+	if m.visibility_changed_at != nil {
+		fields = append(fields, pokemon.FieldVisibilityChangedAt)
+	}
+	if m.visibility_reason != nil {
+		fields = append(fields, pokemon.FieldVisibilityReason)
+	}
+	if m.upload_datetime != nil {
+		fields = append(fields, pokemon.FieldUploadDatetime)
+	}
+	if m.download_code != nil {
+		fields = append(fields, pokemon.FieldDownloadCode)
+	}
+	if m.download_count != nil {
+		fields = append(fields, pokemon.FieldDownloadCount)
+	}
+	if m.generation != nil {
+		fields = append(fields, pokemon.FieldGeneration)
+	}
+	if m.legal != nil {
+		fields = append(fields, pokemon.FieldLegal)
+	}
+	if m.data != nil {
+		fields = append(fields, pokemon.FieldData)
+	}
+	if m.base_64 != nil {
+		fields = append(fields, pokemon.FieldBase64)
+	}
+	if m.content_hash != nil {
+		fields = append(fields, pokemon.FieldContentHash)
+	}
+	if m.legality_report != nil {
+		fields = append(fields, pokemon.FieldLegalityReport)
+	}
+	if m.engine_version != nil {
+		fields = append(fields, pokemon.FieldEngineVersion)
+	}
+	if m.legality_checked_at != nil {
+		fields = append(fields, pokemon.FieldLegalityCheckedAt)
+	}
+	if m.species != nil {
+		fields = append(fields, pokemon.FieldSpecies)
+	}
+	if m.form != nil {
+		fields = append(fields, pokemon.FieldForm)
+	}
+	if m.level != nil {
+		fields = append(fields, pokemon.FieldLevel)
+	}
+	if m.shiny != nil {
+		fields = append(fields, pokemon.FieldShiny)
+	}
+	if m.nature != nil {
+		fields = append(fields, pokemon.FieldNature)
+	}
+	if m.gender != nil {
+		fields = append(fields, pokemon.FieldGender)
+	}
+	if m.ot_name != nil {
+		fields = append(fields, pokemon.FieldOtName)
+	}
+	if m.tid != nil {
+		fields = append(fields, pokemon.FieldTid)
+	}
+	if m.sid != nil {
+		fields = append(fields, pokemon.FieldSid)
+	}
+	if m.ball != nil {
+		fields = append(fields, pokemon.FieldBall)
+	}
+	if m.held_item != nil {
+		fields = append(fields, pokemon.FieldHeldItem)
+	}
+	if m.language != nil {
+		fields = append(fields, pokemon.FieldLanguage)
+	}
+	if m.origin_game != nil {
+		fields = append(fields, pokemon.FieldOriginGame)
+	}
+	if m.merged_into != nil {
+		fields = append(fields, pokemon.FieldMergedIntoID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PokemonMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pokemon.FieldVisibility:
+		return m.Visibility()
+	case pokemon.FieldVisibilityChangedAt:
+		return m.VisibilityChangedAt()
+	case pokemon.FieldVisibilityReason:
+		return m.VisibilityReason()
+	case pokemon.FieldUploadDatetime:
+		return m.UploadDatetime()
+	case pokemon.FieldDownloadCode:
+		return m.DownloadCode()
+	case pokemon.FieldDownloadCount:
+		return m.DownloadCount()
+	case pokemon.FieldGeneration:
+		return m.Generation()
+	case pokemon.FieldLegal:
+		return m.Legal()
+	case pokemon.FieldData:
+		return m.Data()
+	case pokemon.FieldBase64:
+		return m.Base64()
+	case pokemon.FieldContentHash:
+		return m.ContentHash()
+	case pokemon.FieldLegalityReport:
+		return m.LegalityReport()
+	case pokemon.FieldEngineVersion:
+		return m.EngineVersion()
+	case pokemon.FieldLegalityCheckedAt:
+		return m.LegalityCheckedAt()
+	case pokemon.FieldSpecies:
+		return m.Species()
+	case pokemon.FieldForm:
+		return m.Form()
+	case pokemon.FieldLevel:
+		return m.Level()
+	case pokemon.FieldShiny:
+		return m.Shiny()
+	case pokemon.FieldNature:
+		return m.Nature()
+	case pokemon.FieldGender:
+		return m.Gender()
+	case pokemon.FieldOtName:
+		return m.OtName()
+	case pokemon.FieldTid:
+		return m.Tid()
+	case pokemon.FieldSid:
+		return m.Sid()
+	case pokemon.FieldBall:
+		return m.Ball()
+	case pokemon.FieldHeldItem:
+		return m.HeldItem()
+	case pokemon.FieldLanguage:
+		return m.Language()
+	case pokemon.FieldOriginGame:
+		return m.OriginGame()
+	case pokemon.FieldMergedIntoID:
+		return m.MergedIntoID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PokemonMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pokemon.FieldVisibility:
+		return m.OldVisibility(ctx)
+	case pokemon.FieldVisibilityChangedAt:
+		return m.OldVisibilityChangedAt(ctx)
+	case pokemon.FieldVisibilityReason:
+		return m.OldVisibilityReason(ctx)
+	case pokemon.FieldUploadDatetime:
+		return m.OldUploadDatetime(ctx)
+	case pokemon.FieldDownloadCode:
+		return m.OldDownloadCode(ctx)
+	case pokemon.FieldDownloadCount:
+		return m.OldDownloadCount(ctx)
+	case pokemon.FieldGeneration:
+		return m.OldGeneration(ctx)
+	case pokemon.FieldLegal:
+		return m.OldLegal(ctx)
+	case pokemon.FieldData:
+		return m.OldData(ctx)
+	case pokemon.FieldBase64:
+		return m.OldBase64(ctx)
+	case pokemon.FieldContentHash:
+		return m.OldContentHash(ctx)
+	case pokemon.FieldLegalityReport:
+		return m.OldLegalityReport(ctx)
+	case pokemon.FieldEngineVersion:
+		return m.OldEngineVersion(ctx)
+	case pokemon.FieldLegalityCheckedAt:
+		return m.OldLegalityCheckedAt(ctx)
+	case pokemon.FieldSpecies:
+		return m.OldSpecies(ctx)
+	case pokemon.FieldForm:
+		return m.OldForm(ctx)
+	case pokemon.FieldLevel:
+		return m.OldLevel(ctx)
+	case pokemon.FieldShiny:
+		return m.OldShiny(ctx)
+	case pokemon.FieldNature:
+		return m.OldNature(ctx)
+	case pokemon.FieldGender:
+		return m.OldGender(ctx)
+	case pokemon.FieldOtName:
+		return m.OldOtName(ctx)
+	case pokemon.FieldTid:
+		return m.OldTid(ctx)
+	case pokemon.FieldSid:
+		return m.OldSid(ctx)
+	case pokemon.FieldBall:
+		return m.OldBall(ctx)
+	case pokemon.FieldHeldItem:
+		return m.OldHeldItem(ctx)
+	case pokemon.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case pokemon.FieldOriginGame:
+		return m.OldOriginGame(ctx)
+	case pokemon.FieldMergedIntoID:
+		return m.OldMergedIntoID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Pokemon field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PokemonMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pokemon.FieldVisibility:
+		v, ok := value.(pokemon.Visibility)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisibility(v)
+		return nil
+	case pokemon.FieldVisibilityChangedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisibilityChangedAt(v)
+		return nil
+	case pokemon.FieldVisibilityReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisibilityReason(v)
+		return nil
+	case pokemon.FieldUploadDatetime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadDatetime(v)
+		return nil
+	case pokemon.FieldDownloadCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDownloadCode(v)
+		return nil
+	case pokemon.FieldDownloadCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDownloadCount(v)
+		return nil
+	case pokemon.FieldGeneration:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGeneration(v)
+		return nil
+	case pokemon.FieldLegal:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLegal(v)
+		return nil
+	case pokemon.FieldData:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetData(v)
+		return nil
+	case pokemon.FieldBase64:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBase64(v)
+		return nil
+	case pokemon.FieldContentHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentHash(v)
+		return nil
+	case pokemon.FieldLegalityReport:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLegalityReport(v)
+		return nil
+	case pokemon.FieldEngineVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEngineVersion(v)
+		return nil
+	case pokemon.FieldLegalityCheckedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLegalityCheckedAt(v)
+		return nil
+	case pokemon.FieldSpecies:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpecies(v)
+		return nil
+	case pokemon.FieldForm:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetForm(v)
+		return nil
+	case pokemon.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevel(v)
+		return nil
+	case pokemon.FieldShiny:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShiny(v)
+		return nil
+	case pokemon.FieldNature:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNature(v)
+		return nil
+	case pokemon.FieldGender:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGender(v)
+		return nil
+	case pokemon.FieldOtName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOtName(v)
+		return nil
+	case pokemon.FieldTid:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTid(v)
+		return nil
+	case pokemon.FieldSid:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSid(v)
+		return nil
+	case pokemon.FieldBall:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBall(v)
+		return nil
+	case pokemon.FieldHeldItem:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeldItem(v)
+		return nil
+	case pokemon.FieldLanguage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguage(v)
+		return nil
+	case pokemon.FieldOriginGame:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOriginGame(v)
+		return nil
+	case pokemon.FieldMergedIntoID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMergedIntoID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Pokemon field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PokemonMutation) AddedFields() []string {
+	var fields []string
+	if m.adddownload_count != nil {
+		fields = append(fields, pokemon.FieldDownloadCount)
+	}
+	if m.addspecies != nil {
+		fields = append(fields, pokemon.FieldSpecies)
+	}
+	if m.addform != nil {
+		fields = append(fields, pokemon.FieldForm)
+	}
+	if m.addlevel != nil {
+		fields = append(fields, pokemon.FieldLevel)
+	}
+	if m.addnature != nil {
+		fields = append(fields, pokemon.FieldNature)
+	}
+	if m.addgender != nil {
+		fields = append(fields, pokemon.FieldGender)
+	}
+	if m.addtid != nil {
+		fields = append(fields, pokemon.FieldTid)
+	}
+	if m.addsid != nil {
+		fields = append(fields, pokemon.FieldSid)
+	}
+	if m.addball != nil {
+		fields = append(fields, pokemon.FieldBall)
+	}
+	if m.addheld_item != nil {
+		fields = append(fields, pokemon.FieldHeldItem)
+	}
+	if m.addlanguage != nil {
+		fields = append(fields, pokemon.FieldLanguage)
+	}
+	if m.addorigin_game != nil {
+		fields = append(fields, pokemon.FieldOriginGame)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PokemonMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case pokemon.FieldDownloadCount:
+		return m.AddedDownloadCount()
+	case pokemon.FieldSpecies:
+		return m.AddedSpecies()
+	case pokemon.FieldForm:
+		return m.AddedForm()
+	case pokemon.FieldLevel:
+		return m.AddedLevel()
+	case pokemon.FieldNature:
+		return m.AddedNature()
+	case pokemon.FieldGender:
+		return m.AddedGender()
+	case pokemon.FieldTid:
+		return m.AddedTid()
+	case pokemon.FieldSid:
+		return m.AddedSid()
+	case pokemon.FieldBall:
+		return m.AddedBall()
+	case pokemon.FieldHeldItem:
+		return m.AddedHeldItem()
+	case pokemon.FieldLanguage:
+		return m.AddedLanguage()
+	case pokemon.FieldOriginGame:
+		return m.AddedOriginGame()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PokemonMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case pokemon.FieldDownloadCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDownloadCount(v)
+		return nil
+	case pokemon.FieldSpecies:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpecies(v)
+		return nil
+	case pokemon.FieldForm:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddForm(v)
+		return nil
+	case pokemon.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLevel(v)
+		return nil
+	case pokemon.FieldNature:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNature(v)
+		return nil
+	case pokemon.FieldGender:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGender(v)
+		return nil
+	case pokemon.FieldTid:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTid(v)
+		return nil
+	case pokemon.FieldSid:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSid(v)
+		return nil
+	case pokemon.FieldBall:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBall(v)
+		return nil
+	case pokemon.FieldHeldItem:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeldItem(v)
+		return nil
+	case pokemon.FieldLanguage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLanguage(v)
+		return nil
+	case pokemon.FieldOriginGame:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOriginGame(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Pokemon numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PokemonMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pokemon.FieldVisibilityChangedAt) {
+		fields = append(fields, pokemon.FieldVisibilityChangedAt)
+	}
+	if m.FieldCleared(pokemon.FieldVisibilityReason) {
+		fields = append(fields, pokemon.FieldVisibilityReason)
+	}
+	if m.FieldCleared(pokemon.FieldData) {
+		fields = append(fields, pokemon.FieldData)
+	}
+	if m.FieldCleared(pokemon.FieldBase64) {
+		fields = append(fields, pokemon.FieldBase64)
+	}
+	if m.FieldCleared(pokemon.FieldContentHash) {
+		fields = append(fields, pokemon.FieldContentHash)
+	}
+	if m.FieldCleared(pokemon.FieldLegalityReport) {
+		fields = append(fields, pokemon.FieldLegalityReport)
+	}
+	if m.FieldCleared(pokemon.FieldEngineVersion) {
+		fields = append(fields, pokemon.FieldEngineVersion)
+	}
+	if m.FieldCleared(pokemon.FieldLegalityCheckedAt) {
+		fields = append(fields, pokemon.FieldLegalityCheckedAt)
+	}
+	if m.FieldCleared(pokemon.FieldSpecies) {
+		fields = append(fields, pokemon.FieldSpecies)
+	}
+	if m.FieldCleared(pokemon.FieldForm) {
+		fields = append(fields, pokemon.FieldForm)
+	}
+	if m.FieldCleared(pokemon.FieldLevel) {
+		fields = append(fields, pokemon.FieldLevel)
+	}
+	if m.FieldCleared(pokemon.FieldShiny) {
+		fields = append(fields, pokemon.FieldShiny)
+	}
+	if m.FieldCleared(pokemon.FieldNature) {
+		fields = append(fields, pokemon.FieldNature)
+	}
+	if m.FieldCleared(pokemon.FieldGender) {
+		fields = append(fields, pokemon.FieldGender)
+	}
+	if m.FieldCleared(pokemon.FieldOtName) {
+		fields = append(fields, pokemon.FieldOtName)
+	}
+	if m.FieldCleared(pokemon.FieldTid) {
+		fields = append(fields, pokemon.FieldTid)
+	}
+	if m.FieldCleared(pokemon.FieldSid) {
+		fields = append(fields, pokemon.FieldSid)
+	}
+	if m.FieldCleared(pokemon.FieldBall) {
+		fields = append(fields, pokemon.FieldBall)
+	}
+	if m.FieldCleared(pokemon.FieldHeldItem) {
+		fields = append(fields, pokemon.FieldHeldItem)
+	}
+	if m.FieldCleared(pokemon.FieldLanguage) {
+		fields = append(fields, pokemon.FieldLanguage)
+	}
+	if m.FieldCleared(pokemon.FieldOriginGame) {
+		fields = append(fields, pokemon.FieldOriginGame)
+	}
+	if m.FieldCleared(pokemon.FieldMergedIntoID) {
+		fields = append(fields, pokemon.FieldMergedIntoID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PokemonMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PokemonMutation) ClearField(name string) error {
+	switch name {
+	case pokemon.FieldVisibilityChangedAt:
+		m.ClearVisibilityChangedAt()
+		return nil
+	case pokemon.FieldVisibilityReason:
+		m.ClearVisibilityReason()
+		return nil
+	case pokemon.FieldData:
+		m.ClearData()
+		return nil
+	case pokemon.FieldBase64:
+		m.ClearBase64()
+		return nil
+	case pokemon.FieldContentHash:
+		m.ClearContentHash()
+		return nil
+	case pokemon.FieldLegalityReport:
+		m.ClearLegalityReport()
+		return nil
+	case pokemon.FieldEngineVersion:
+		m.ClearEngineVersion()
+		return nil
+	case pokemon.FieldLegalityCheckedAt:
+		m.ClearLegalityCheckedAt()
+		return nil
+	case pokemon.FieldSpecies:
+		m.ClearSpecies()
+		return nil
+	case pokemon.FieldForm:
+		m.ClearForm()
+		return nil
+	case pokemon.FieldLevel:
+		m.ClearLevel()
+		return nil
+	case pokemon.FieldShiny:
+		m.ClearShiny()
+		return nil
+	case pokemon.FieldNature:
+		m.ClearNature()
+		return nil
+	case pokemon.FieldGender:
+		m.ClearGender()
+		return nil
+	case pokemon.FieldOtName:
+		m.ClearOtName()
+		return nil
+	case pokemon.FieldTid:
+		m.ClearTid()
+		return nil
+	case pokemon.FieldSid:
+		m.ClearSid()
+		return nil
+	case pokemon.FieldBall:
+		m.ClearBall()
+		return nil
+	case pokemon.FieldHeldItem:
+		m.ClearHeldItem()
+		return nil
+	case pokemon.FieldLanguage:
+		m.ClearLanguage()
+		return nil
+	case pokemon.FieldOriginGame:
+		m.ClearOriginGame()
+		return nil
+	case pokemon.FieldMergedIntoID:
+		m.ClearMergedIntoID()
+		return nil
+	}
+	return fmt.Errorf("unknown Pokemon nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PokemonMutation) ResetField(name string) error {
+	switch name {
+	case pokemon.FieldVisibility:
+		m.ResetVisibility()
+		return nil
+	case pokemon.FieldVisibilityChangedAt:
+		m.ResetVisibilityChangedAt()
+		return nil
+	case pokemon.FieldVisibilityReason:
+		m.ResetVisibilityReason()
+		return nil
+	case pokemon.FieldUploadDatetime:
+		m.ResetUploadDatetime()
+		return nil
+	case pokemon.FieldDownloadCode:
+		m.ResetDownloadCode()
+		return nil
+	case pokemon.FieldDownloadCount:
+		m.ResetDownloadCount()
+		return nil
+	case pokemon.FieldGeneration:
+		m.ResetGeneration()
+		return nil
+	case pokemon.FieldLegal:
+		m.ResetLegal()
+		return nil
+	case pokemon.FieldData:
+		m.ResetData()
+		return nil
+	case pokemon.FieldBase64:
+		m.ResetBase64()
+		return nil
+	case pokemon.FieldContentHash:
+		m.ResetContentHash()
+		return nil
+	case pokemon.FieldLegalityReport:
+		m.ResetLegalityReport()
+		return nil
+	case pokemon.FieldEngineVersion:
+		m.ResetEngineVersion()
+		return nil
+	case pokemon.FieldLegalityCheckedAt:
+		m.ResetLegalityCheckedAt()
+		return nil
+	case pokemon.FieldSpecies:
+		m.ResetSpecies()
+		return nil
+	case pokemon.FieldForm:
+		m.ResetForm()
+		return nil
+	case pokemon.FieldLevel:
+		m.ResetLevel()
+		return nil
+	case pokemon.FieldShiny:
+		m.ResetShiny()
+		return nil
+	case pokemon.FieldNature:
+		m.ResetNature()
+		return nil
+	case pokemon.FieldGender:
+		m.ResetGender()
+		return nil
+	case pokemon.FieldOtName:
+		m.ResetOtName()
+		return nil
+	case pokemon.FieldTid:
+		m.ResetTid()
+		return nil
+	case pokemon.FieldSid:
+		m.ResetSid()
+		return nil
+	case pokemon.FieldBall:
+		m.ResetBall()
+		return nil
+	case pokemon.FieldHeldItem:
+		m.ResetHeldItem()
+		return nil
+	case pokemon.FieldLanguage:
+		m.ResetLanguage()
+		return nil
+	case pokemon.FieldOriginGame:
+		m.ResetOriginGame()
+		return nil
+	case pokemon.FieldMergedIntoID:
+		m.ResetMergedIntoID()
+		return nil
+	}
+	return fmt.Errorf("unknown Pokemon field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PokemonMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.bundle_slots != nil {
+		edges = append(edges, pokemon.EdgeBundleSlots)
+	}
+	if m.merged_into != nil {
+		edges = append(edges, pokemon.EdgeMergedInto)
+	}
+	if m.duplicates != nil {
+		edges = append(edges, pokemon.EdgeDuplicates)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PokemonMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pokemon.EdgeBundleSlots:
+		ids := make([]ent.Value, 0, len(m.bundle_slots))
+		for id := range m.bundle_slots {
+			ids = append(ids, id)
+		}
+		return ids
+	case pokemon.EdgeMergedInto:
+		if id := m.merged_into; id != nil {
+			return []ent.Value{*id}
+		}
+	case pokemon.EdgeDuplicates:
+		ids := make([]ent.Value, 0, len(m.duplicates))
+		for id := range m.duplicates {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PokemonMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedbundle_slots != nil {
+		edges = append(edges, pokemon.EdgeBundleSlots)
+	}
+	if m.removedduplicates != nil {
+		edges = append(edges, pokemon.EdgeDuplicates)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PokemonMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case pokemon.EdgeBundleSlots:
+		ids := make([]ent.Value, 0, len(m.removedbundle_slots))
+		for id := range m.removedbundle_slots {
+			ids = append(ids, id)
+		}
+		return ids
+	case pokemon.EdgeDuplicates:
+		ids := make([]ent.Value, 0, len(m.removedduplicates))
+		for id := range m.removedduplicates {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PokemonMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedbundle_slots {
+		edges = append(edges, pokemon.EdgeBundleSlots)
+	}
+	if m.clearedmerged_into {
+		edges = append(edges, pokemon.EdgeMergedInto)
+	}
+	if m.clearedduplicates {
+		edges = append(edges, pokemon.EdgeDuplicates)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PokemonMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pokemon.EdgeBundleSlots:
+		return m.clearedbundle_slots
+	case pokemon.EdgeMergedInto:
+		return m.clearedmerged_into
+	case pokemon.EdgeDuplicates:
+		return m.clearedduplicates
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PokemonMutation) ClearEdge(name string) error {
+	switch name {
+	case pokemon.EdgeMergedInto:
+		m.ClearMergedInto()
+		return nil
+	}
+	return fmt.Errorf("unknown Pokemon unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PokemonMutation) ResetEdge(name string) error {
+	switch name {
+	case pokemon.EdgeBundleSlots:
+		m.ResetBundleSlots()
+		return nil
+	case pokemon.EdgeMergedInto:
+		m.ResetMergedInto()
+		return nil
+	case pokemon.EdgeDuplicates:
+		m.ResetDuplicates()
+		return nil
+	}
+	return fmt.Errorf("unknown Pokemon edge %s", name)
+}
+
+// RecheckJobMutation represents an operation that mutates the RecheckJob nodes in the graph.
+type RecheckJobMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	engine_version     *string
+	status             *recheckjob.Status
+	total              *int
+	addtotal           *int
+	checked            *int
+	addchecked         *int
+	failed             *int
+	addfailed          *int
+	last_pokemon_id    *int
+	addlast_pokemon_id *int
+	started_at         *time.Time
+	finished_at        *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*RecheckJob, error)
+	predicates         []predicate.RecheckJob
+}
+
+var _ ent.Mutation = (*RecheckJobMutation)(nil)
+
+// recheckjobOption allows management of the mutation configuration using functional options.
+type recheckjobOption func(*RecheckJobMutation)
+
+// newRecheckJobMutation creates new mutation for the RecheckJob entity.
+func newRecheckJobMutation(c config, op Op, opts ...recheckjobOption) *RecheckJobMutation {
+	m := &RecheckJobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRecheckJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRecheckJobID sets the ID field of the mutation.
+func withRecheckJobID(id int) recheckjobOption {
+	return func(m *RecheckJobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RecheckJob
+		)
+		m.oldValue = func(ctx context.Context) (*RecheckJob, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RecheckJob.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRecheckJob sets the old RecheckJob of the mutation.
+func withRecheckJob(node *RecheckJob) recheckjobOption {
+	return func(m *RecheckJobMutation) {
+		m.oldValue = func(context.Context) (*RecheckJob, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RecheckJobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RecheckJobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RecheckJobMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RecheckJobMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RecheckJob.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEngineVersion sets the "engine_version" field.
+func (m *RecheckJobMutation) SetEngineVersion(s string) {
+	m.engine_version = &s
+}
+
+// EngineVersion returns the value of the "engine_version" field in the mutation.
+func (m *RecheckJobMutation) EngineVersion() (r string, exists bool) {
+	v := m.engine_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEngineVersion returns the old "engine_version" field's value of the RecheckJob entity.
+// If the RecheckJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecheckJobMutation) OldEngineVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEngineVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEngineVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEngineVersion: %w", err)
+	}
+	return oldValue.EngineVersion, nil
+}
+
+// ResetEngineVersion resets all changes to the "engine_version" field.
+func (m *RecheckJobMutation) ResetEngineVersion() {
+	m.engine_version = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *RecheckJobMutation) SetStatus(r recheckjob.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *RecheckJobMutation) Status() (r recheckjob.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the RecheckJob entity.
+// If the RecheckJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecheckJobMutation) OldStatus(ctx context.Context) (v recheckjob.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *RecheckJobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetTotal sets the "total" field.
+func (m *RecheckJobMutation) SetTotal(i int) {
+	m.total = &i
+	m.addtotal = nil
+}
+
+// Total returns the value of the "total" field in the mutation.
+func (m *RecheckJobMutation) Total() (r int, exists bool) {
+	v := m.total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotal returns the old "total" field's value of the RecheckJob entity.
+// If the RecheckJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecheckJobMutation) OldTotal(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotal: %w", err)
+	}
+	return oldValue.Total, nil
+}
+
+// AddTotal adds i to the "total" field.
+func (m *RecheckJobMutation) AddTotal(i int) {
+	if m.addtotal != nil {
+		*m.addtotal += i
+	} else {
+		m.addtotal = &i
+	}
+}
+
+// AddedTotal returns the value that was added to the "total" field in this mutation.
+func (m *RecheckJobMutation) AddedTotal() (r int, exists bool) {
+	v := m.addtotal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotal resets all changes to the "total" field.
+func (m *RecheckJobMutation) ResetTotal() {
+	m.total = nil
+	m.addtotal = nil
+}
+
+// SetChecked sets the "checked" field.
+func (m *RecheckJobMutation) SetChecked(i int) {
+	m.checked = &i
+	m.addchecked = nil
+}
+
+// Checked returns the value of the "checked" field in the mutation.
+func (m *RecheckJobMutation) Checked() (r int, exists bool) {
+	v := m.checked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChecked returns the old "checked" field's value of the RecheckJob entity.
+// If the RecheckJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecheckJobMutation) OldChecked(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChecked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChecked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChecked: %w", err)
+	}
+	return oldValue.Checked, nil
+}
+
+// AddChecked adds i to the "checked" field.
+func (m *RecheckJobMutation) AddChecked(i int) {
+	if m.addchecked != nil {
+		*m.addchecked += i
+	} else {
+		m.addchecked = &i
+	}
+}
+
+// AddedChecked returns the value that was added to the "checked" field in this mutation.
+func (m *RecheckJobMutation) AddedChecked() (r int, exists bool) {
+	v := m.addchecked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChecked resets all changes to the "checked" field.
+func (m *RecheckJobMutation) ResetChecked() {
+	m.checked = nil
+	m.addchecked = nil
+}
+
+// SetFailed sets the "failed" field.
+func (m *RecheckJobMutation) SetFailed(i int) {
+	m.failed = &i
+	m.addfailed = nil
+}
+
+// Failed returns the value of the "failed" field in the mutation.
+func (m *RecheckJobMutation) Failed() (r int, exists bool) {
+	v := m.failed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailed returns the old "failed" field's value of the RecheckJob entity.
+// If the RecheckJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecheckJobMutation) OldFailed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailed: %w", err)
+	}
+	return oldValue.Failed, nil
+}
+
+// AddFailed adds i to the "failed" field.
+func (m *RecheckJobMutation) AddFailed(i int) {
+	if m.addfailed != nil {
+		*m.addfailed += i
+	} else {
+		m.addfailed = &i
+	}
+}
+
+// AddedFailed returns the value that was added to the "failed" field in this mutation.
+func (m *RecheckJobMutation) AddedFailed() (r int, exists bool) {
+	v := m.addfailed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFailed resets all changes to the "failed" field.
+func (m *RecheckJobMutation) ResetFailed() {
+	m.failed = nil
+	m.addfailed = nil
+}
+
+// SetLastPokemonID sets the "last_pokemon_id" field.
+func (m *RecheckJobMutation) SetLastPokemonID(i int) {
+	m.last_pokemon_id = &i
+	m.addlast_pokemon_id = nil
+}
+
+// LastPokemonID returns the value of the "last_pokemon_id" field in the mutation.
+func (m *RecheckJobMutation) LastPokemonID() (r int, exists bool) {
+	v := m.last_pokemon_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastPokemonID returns the old "last_pokemon_id" field's value of the RecheckJob entity.
+// If the RecheckJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecheckJobMutation) OldLastPokemonID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastPokemonID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastPokemonID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastPokemonID: %w", err)
+	}
+	return oldValue.LastPokemonID, nil
+}
+
+// AddLastPokemonID adds i to the "last_pokemon_id" field.
+func (m *RecheckJobMutation) AddLastPokemonID(i int) {
+	if m.addlast_pokemon_id != nil {
+		*m.addlast_pokemon_id += i
+	} else {
+		m.addlast_pokemon_id = &i
+	}
+}
+
+// AddedLastPokemonID returns the value that was added to the "last_pokemon_id" field in this mutation.
+func (m *RecheckJobMutation) AddedLastPokemonID() (r int, exists bool) {
+	v := m.addlast_pokemon_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLastPokemonID resets all changes to the "last_pokemon_id" field.
+func (m *RecheckJobMutation) ResetLastPokemonID() {
+	m.last_pokemon_id = nil
+	m.addlast_pokemon_id = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *RecheckJobMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *RecheckJobMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the RecheckJob entity.
+// If the RecheckJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecheckJobMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *RecheckJobMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *RecheckJobMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *RecheckJobMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the RecheckJob entity.
+// If the RecheckJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecheckJobMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *RecheckJobMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[recheckjob.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *RecheckJobMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[recheckjob.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *RecheckJobMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, recheckjob.FieldFinishedAt)
+}
+
+// Where appends a list predicates to the RecheckJobMutation builder.
+func (m *RecheckJobMutation) Where(ps ...predicate.RecheckJob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RecheckJobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RecheckJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RecheckJob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RecheckJobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RecheckJobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RecheckJob).
+func (m *RecheckJobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RecheckJobMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.engine_version != nil {
+		fields = append(fields, recheckjob.FieldEngineVersion)
+	}
+	if m.status != nil {
+		fields = append(fields, recheckjob.FieldStatus)
+	}
+	if m.total != nil {
+		fields = append(fields, recheckjob.FieldTotal)
+	}
+	if m.checked != nil {
+		fields = append(fields, recheckjob.FieldChecked)
+	}
+	if m.failed != nil {
+		fields = append(fields, recheckjob.FieldFailed)
+	}
+	if m.last_pokemon_id != nil {
+		fields = append(fields, recheckjob.FieldLastPokemonID)
+	}
+	if m.started_at != nil {
+		fields = append(fields, recheckjob.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, recheckjob.FieldFinishedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RecheckJobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case recheckjob.FieldEngineVersion:
+		return m.EngineVersion()
+	case recheckjob.FieldStatus:
+		return m.Status()
+	case recheckjob.FieldTotal:
+		return m.Total()
+	case recheckjob.FieldChecked:
+		return m.Checked()
+	case recheckjob.FieldFailed:
+		return m.Failed()
+	case recheckjob.FieldLastPokemonID:
+		return m.LastPokemonID()
+	case recheckjob.FieldStartedAt:
+		return m.StartedAt()
+	case recheckjob.FieldFinishedAt:
+		return m.FinishedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RecheckJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case recheckjob.FieldEngineVersion:
+		return m.OldEngineVersion(ctx)
+	case recheckjob.FieldStatus:
+		return m.OldStatus(ctx)
+	case recheckjob.FieldTotal:
+		return m.OldTotal(ctx)
+	case recheckjob.FieldChecked:
+		return m.OldChecked(ctx)
+	case recheckjob.FieldFailed:
+		return m.OldFailed(ctx)
+	case recheckjob.FieldLastPokemonID:
+		return m.OldLastPokemonID(ctx)
+	case recheckjob.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case recheckjob.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RecheckJob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecheckJobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case recheckjob.FieldEngineVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEngineVersion(v)
+		return nil
+	case recheckjob.FieldStatus:
+		v, ok := value.(recheckjob.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case recheckjob.FieldTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotal(v)
+		return nil
+	case recheckjob.FieldChecked:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChecked(v)
+		return nil
+	case recheckjob.FieldFailed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailed(v)
+		return nil
+	case recheckjob.FieldLastPokemonID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastPokemonID(v)
+		return nil
+	case recheckjob.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case recheckjob.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RecheckJob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RecheckJobMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotal != nil {
+		fields = append(fields, recheckjob.FieldTotal)
+	}
+	if m.addchecked != nil {
+		fields = append(fields, recheckjob.FieldChecked)
+	}
+	if m.addfailed != nil {
+		fields = append(fields, recheckjob.FieldFailed)
+	}
+	if m.addlast_pokemon_id != nil {
+		fields = append(fields, recheckjob.FieldLastPokemonID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RecheckJobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case recheckjob.FieldTotal:
+		return m.AddedTotal()
+	case recheckjob.FieldChecked:
+		return m.AddedChecked()
+	case recheckjob.FieldFailed:
+		return m.AddedFailed()
+	case recheckjob.FieldLastPokemonID:
+		return m.AddedLastPokemonID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecheckJobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case recheckjob.FieldTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotal(v)
+		return nil
+	case recheckjob.FieldChecked:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChecked(v)
+		return nil
+	case recheckjob.FieldFailed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailed(v)
+		return nil
+	case recheckjob.FieldLastPokemonID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastPokemonID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RecheckJob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RecheckJobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(recheckjob.FieldFinishedAt) {
+		fields = append(fields, recheckjob.FieldFinishedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RecheckJobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RecheckJobMutation) ClearField(name string) error {
+	switch name {
+	case recheckjob.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RecheckJob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RecheckJobMutation) ResetField(name string) error {
+	switch name {
+	case recheckjob.FieldEngineVersion:
+		m.ResetEngineVersion()
+		return nil
+	case recheckjob.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case recheckjob.FieldTotal:
+		m.ResetTotal()
+		return nil
+	case recheckjob.FieldChecked:
+		m.ResetChecked()
+		return nil
+	case recheckjob.FieldFailed:
+		m.ResetFailed()
+		return nil
+	case recheckjob.FieldLastPokemonID:
+		m.ResetLastPokemonID()
+		return nil
+	case recheckjob.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case recheckjob.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RecheckJob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RecheckJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RecheckJobMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RecheckJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RecheckJobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RecheckJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RecheckJobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RecheckJobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RecheckJob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RecheckJobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RecheckJob edge %s", name)
 }
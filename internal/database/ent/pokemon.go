@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -17,6 +18,12 @@ type Pokemon struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// Visibility holds the value of the "visibility" field.
+	Visibility pokemon.Visibility `json:"visibility,omitempty"`
+	// VisibilityChangedAt holds the value of the "visibility_changed_at" field.
+	VisibilityChangedAt *time.Time `json:"visibility_changed_at,omitempty"`
+	// VisibilityReason holds the value of the "visibility_reason" field.
+	VisibilityReason *string `json:"visibility_reason,omitempty"`
 	// UploadDatetime holds the value of the "upload_datetime" field.
 	UploadDatetime time.Time `json:"upload_datetime,omitempty"`
 	// DownloadCode holds the value of the "download_code" field.
@@ -27,8 +34,46 @@ type Pokemon struct {
 	Generation string `json:"generation,omitempty"`
 	// Legal holds the value of the "legal" field.
 	Legal bool `json:"legal,omitempty"`
+	// Data holds the value of the "data" field.
+	Data []byte `json:"data,omitempty"`
 	// Base64 holds the value of the "base_64" field.
 	Base64 string `json:"base_64,omitempty"`
+	// ContentHash holds the value of the "content_hash" field.
+	ContentHash *string `json:"content_hash,omitempty"`
+	// LegalityReport holds the value of the "legality_report" field.
+	LegalityReport []string `json:"legality_report,omitempty"`
+	// EngineVersion holds the value of the "engine_version" field.
+	EngineVersion string `json:"engine_version,omitempty"`
+	// LegalityCheckedAt holds the value of the "legality_checked_at" field.
+	LegalityCheckedAt *time.Time `json:"legality_checked_at,omitempty"`
+	// Species holds the value of the "species" field.
+	Species *int `json:"species,omitempty"`
+	// Form holds the value of the "form" field.
+	Form *int `json:"form,omitempty"`
+	// Level holds the value of the "level" field.
+	Level *int `json:"level,omitempty"`
+	// Shiny holds the value of the "shiny" field.
+	Shiny *bool `json:"shiny,omitempty"`
+	// Nature holds the value of the "nature" field.
+	Nature *int `json:"nature,omitempty"`
+	// Gender holds the value of the "gender" field.
+	Gender *int `json:"gender,omitempty"`
+	// OtName holds the value of the "ot_name" field.
+	OtName *string `json:"ot_name,omitempty"`
+	// Tid holds the value of the "tid" field.
+	Tid *int `json:"tid,omitempty"`
+	// Sid holds the value of the "sid" field.
+	Sid *int `json:"sid,omitempty"`
+	// Ball holds the value of the "ball" field.
+	Ball *int `json:"ball,omitempty"`
+	// HeldItem holds the value of the "held_item" field.
+	HeldItem *int `json:"held_item,omitempty"`
+	// Language holds the value of the "language" field.
+	Language *int `json:"language,omitempty"`
+	// OriginGame holds the value of the "origin_game" field.
+	OriginGame *int `json:"origin_game,omitempty"`
+	// MergedIntoID holds the value of the "merged_into_id" field.
+	MergedIntoID *int `json:"merged_into_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PokemonQuery when eager-loading is set.
 	Edges        PokemonEdges `json:"edges"`
@@ -37,20 +82,44 @@ type Pokemon struct {
 
 // PokemonEdges holds the relations/edges for other nodes in the graph.
 type PokemonEdges struct {
-	// Bundles holds the value of the bundles edge.
-	Bundles []*Bundle `json:"bundles,omitempty"`
+	// BundleSlots holds the value of the bundle_slots edge.
+	BundleSlots []*BundleSlot `json:"bundle_slots,omitempty"`
+	// MergedInto holds the value of the merged_into edge.
+	MergedInto *Pokemon `json:"merged_into,omitempty"`
+	// Duplicates holds the value of the duplicates edge.
+	Duplicates []*Pokemon `json:"duplicates,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
-// BundlesOrErr returns the Bundles value or an error if the edge
+// BundleSlotsOrErr returns the BundleSlots value or an error if the edge
 // was not loaded in eager-loading.
-func (e PokemonEdges) BundlesOrErr() ([]*Bundle, error) {
+func (e PokemonEdges) BundleSlotsOrErr() ([]*BundleSlot, error) {
 	if e.loadedTypes[0] {
-		return e.Bundles, nil
+		return e.BundleSlots, nil
 	}
-	return nil, &NotLoadedError{edge: "bundles"}
+	return nil, &NotLoadedError{edge: "bundle_slots"}
+}
+
+// MergedIntoOrErr returns the MergedInto value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PokemonEdges) MergedIntoOrErr() (*Pokemon, error) {
+	if e.MergedInto != nil {
+		return e.MergedInto, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: pokemon.Label}
+	}
+	return nil, &NotLoadedError{edge: "merged_into"}
+}
+
+// DuplicatesOrErr returns the Duplicates value or an error if the edge
+// was not loaded in eager-loading.
+func (e PokemonEdges) DuplicatesOrErr() ([]*Pokemon, error) {
+	if e.loadedTypes[2] {
+		return e.Duplicates, nil
+	}
+	return nil, &NotLoadedError{edge: "duplicates"}
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -58,13 +127,15 @@ func (*Pokemon) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case pokemon.FieldLegal:
+		case pokemon.FieldData, pokemon.FieldLegalityReport:
+			values[i] = new([]byte)
+		case pokemon.FieldLegal, pokemon.FieldShiny:
 			values[i] = new(sql.NullBool)
-		case pokemon.FieldID, pokemon.FieldDownloadCount:
+		case pokemon.FieldID, pokemon.FieldDownloadCount, pokemon.FieldSpecies, pokemon.FieldForm, pokemon.FieldLevel, pokemon.FieldNature, pokemon.FieldGender, pokemon.FieldTid, pokemon.FieldSid, pokemon.FieldBall, pokemon.FieldHeldItem, pokemon.FieldLanguage, pokemon.FieldOriginGame, pokemon.FieldMergedIntoID:
 			values[i] = new(sql.NullInt64)
-		case pokemon.FieldDownloadCode, pokemon.FieldGeneration, pokemon.FieldBase64:
+		case pokemon.FieldVisibility, pokemon.FieldVisibilityReason, pokemon.FieldDownloadCode, pokemon.FieldGeneration, pokemon.FieldBase64, pokemon.FieldContentHash, pokemon.FieldEngineVersion, pokemon.FieldOtName:
 			values[i] = new(sql.NullString)
-		case pokemon.FieldUploadDatetime:
+		case pokemon.FieldVisibilityChangedAt, pokemon.FieldUploadDatetime, pokemon.FieldLegalityCheckedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -87,6 +158,26 @@ func (_m *Pokemon) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			_m.ID = int(value.Int64)
+		case pokemon.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				_m.Visibility = pokemon.Visibility(value.String)
+			}
+		case pokemon.FieldVisibilityChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility_changed_at", values[i])
+			} else if value.Valid {
+				_m.VisibilityChangedAt = new(time.Time)
+				*_m.VisibilityChangedAt = value.Time
+			}
+		case pokemon.FieldVisibilityReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility_reason", values[i])
+			} else if value.Valid {
+				_m.VisibilityReason = new(string)
+				*_m.VisibilityReason = value.String
+			}
 		case pokemon.FieldUploadDatetime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field upload_datetime", values[i])
@@ -117,12 +208,144 @@ func (_m *Pokemon) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Legal = value.Bool
 			}
+		case pokemon.FieldData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field data", values[i])
+			} else if value != nil {
+				_m.Data = *value
+			}
 		case pokemon.FieldBase64:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field base_64", values[i])
 			} else if value.Valid {
 				_m.Base64 = value.String
 			}
+		case pokemon.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				_m.ContentHash = new(string)
+				*_m.ContentHash = value.String
+			}
+		case pokemon.FieldLegalityReport:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field legality_report", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.LegalityReport); err != nil {
+					return fmt.Errorf("unmarshal field legality_report: %w", err)
+				}
+			}
+		case pokemon.FieldEngineVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field engine_version", values[i])
+			} else if value.Valid {
+				_m.EngineVersion = value.String
+			}
+		case pokemon.FieldLegalityCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field legality_checked_at", values[i])
+			} else if value.Valid {
+				_m.LegalityCheckedAt = new(time.Time)
+				*_m.LegalityCheckedAt = value.Time
+			}
+		case pokemon.FieldSpecies:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field species", values[i])
+			} else if value.Valid {
+				_m.Species = new(int)
+				*_m.Species = int(value.Int64)
+			}
+		case pokemon.FieldForm:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field form", values[i])
+			} else if value.Valid {
+				_m.Form = new(int)
+				*_m.Form = int(value.Int64)
+			}
+		case pokemon.FieldLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field level", values[i])
+			} else if value.Valid {
+				_m.Level = new(int)
+				*_m.Level = int(value.Int64)
+			}
+		case pokemon.FieldShiny:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field shiny", values[i])
+			} else if value.Valid {
+				_m.Shiny = new(bool)
+				*_m.Shiny = value.Bool
+			}
+		case pokemon.FieldNature:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field nature", values[i])
+			} else if value.Valid {
+				_m.Nature = new(int)
+				*_m.Nature = int(value.Int64)
+			}
+		case pokemon.FieldGender:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gender", values[i])
+			} else if value.Valid {
+				_m.Gender = new(int)
+				*_m.Gender = int(value.Int64)
+			}
+		case pokemon.FieldOtName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ot_name", values[i])
+			} else if value.Valid {
+				_m.OtName = new(string)
+				*_m.OtName = value.String
+			}
+		case pokemon.FieldTid:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tid", values[i])
+			} else if value.Valid {
+				_m.Tid = new(int)
+				*_m.Tid = int(value.Int64)
+			}
+		case pokemon.FieldSid:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sid", values[i])
+			} else if value.Valid {
+				_m.Sid = new(int)
+				*_m.Sid = int(value.Int64)
+			}
+		case pokemon.FieldBall:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ball", values[i])
+			} else if value.Valid {
+				_m.Ball = new(int)
+				*_m.Ball = int(value.Int64)
+			}
+		case pokemon.FieldHeldItem:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field held_item", values[i])
+			} else if value.Valid {
+				_m.HeldItem = new(int)
+				*_m.HeldItem = int(value.Int64)
+			}
+		case pokemon.FieldLanguage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				_m.Language = new(int)
+				*_m.Language = int(value.Int64)
+			}
+		case pokemon.FieldOriginGame:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field origin_game", values[i])
+			} else if value.Valid {
+				_m.OriginGame = new(int)
+				*_m.OriginGame = int(value.Int64)
+			}
+		case pokemon.FieldMergedIntoID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field merged_into_id", values[i])
+			} else if value.Valid {
+				_m.MergedIntoID = new(int)
+				*_m.MergedIntoID = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -136,9 +359,19 @@ func (_m *Pokemon) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
-// QueryBundles queries the "bundles" edge of the Pokemon entity.
-func (_m *Pokemon) QueryBundles() *BundleQuery {
-	return NewPokemonClient(_m.config).QueryBundles(_m)
+// QueryBundleSlots queries the "bundle_slots" edge of the Pokemon entity.
+func (_m *Pokemon) QueryBundleSlots() *BundleSlotQuery {
+	return NewPokemonClient(_m.config).QueryBundleSlots(_m)
+}
+
+// QueryMergedInto queries the "merged_into" edge of the Pokemon entity.
+func (_m *Pokemon) QueryMergedInto() *PokemonQuery {
+	return NewPokemonClient(_m.config).QueryMergedInto(_m)
+}
+
+// QueryDuplicates queries the "duplicates" edge of the Pokemon entity.
+func (_m *Pokemon) QueryDuplicates() *PokemonQuery {
+	return NewPokemonClient(_m.config).QueryDuplicates(_m)
 }
 
 // Update returns a builder for updating this Pokemon.
@@ -164,6 +397,19 @@ func (_m *Pokemon) String() string {
 	var builder strings.Builder
 	builder.WriteString("Pokemon(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Visibility))
+	builder.WriteString(", ")
+	if v := _m.VisibilityChangedAt; v != nil {
+		builder.WriteString("visibility_changed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.VisibilityReason; v != nil {
+		builder.WriteString("visibility_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("upload_datetime=")
 	builder.WriteString(_m.UploadDatetime.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -179,8 +425,97 @@ func (_m *Pokemon) String() string {
 	builder.WriteString("legal=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Legal))
 	builder.WriteString(", ")
+	builder.WriteString("data=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Data))
+	builder.WriteString(", ")
 	builder.WriteString("base_64=")
 	builder.WriteString(_m.Base64)
+	builder.WriteString(", ")
+	if v := _m.ContentHash; v != nil {
+		builder.WriteString("content_hash=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("legality_report=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LegalityReport))
+	builder.WriteString(", ")
+	builder.WriteString("engine_version=")
+	builder.WriteString(_m.EngineVersion)
+	builder.WriteString(", ")
+	if v := _m.LegalityCheckedAt; v != nil {
+		builder.WriteString("legality_checked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Species; v != nil {
+		builder.WriteString("species=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Form; v != nil {
+		builder.WriteString("form=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Level; v != nil {
+		builder.WriteString("level=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Shiny; v != nil {
+		builder.WriteString("shiny=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Nature; v != nil {
+		builder.WriteString("nature=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Gender; v != nil {
+		builder.WriteString("gender=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.OtName; v != nil {
+		builder.WriteString("ot_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Tid; v != nil {
+		builder.WriteString("tid=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Sid; v != nil {
+		builder.WriteString("sid=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Ball; v != nil {
+		builder.WriteString("ball=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.HeldItem; v != nil {
+		builder.WriteString("held_item=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Language; v != nil {
+		builder.WriteString("language=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.OriginGame; v != nil {
+		builder.WriteString("origin_game=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MergedIntoID; v != nil {
+		builder.WriteString("merged_into_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
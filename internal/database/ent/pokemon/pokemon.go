@@ -3,6 +3,8 @@
 package pokemon
 
 import (
+	"fmt"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -12,6 +14,12 @@ const (
 	Label = "pokemon"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldVisibility holds the string denoting the visibility field in the database.
+	FieldVisibility = "visibility"
+	// FieldVisibilityChangedAt holds the string denoting the visibility_changed_at field in the database.
+	FieldVisibilityChangedAt = "visibility_changed_at"
+	// FieldVisibilityReason holds the string denoting the visibility_reason field in the database.
+	FieldVisibilityReason = "visibility_reason"
 	// FieldUploadDatetime holds the string denoting the upload_datetime field in the database.
 	FieldUploadDatetime = "upload_datetime"
 	// FieldDownloadCode holds the string denoting the download_code field in the database.
@@ -22,36 +30,104 @@ const (
 	FieldGeneration = "generation"
 	// FieldLegal holds the string denoting the legal field in the database.
 	FieldLegal = "legal"
+	// FieldData holds the string denoting the data field in the database.
+	FieldData = "data"
 	// FieldBase64 holds the string denoting the base_64 field in the database.
 	FieldBase64 = "base_64"
-	// EdgeBundles holds the string denoting the bundles edge name in mutations.
-	EdgeBundles = "bundles"
+	// FieldContentHash holds the string denoting the content_hash field in the database.
+	FieldContentHash = "content_hash"
+	// FieldLegalityReport holds the string denoting the legality_report field in the database.
+	FieldLegalityReport = "legality_report"
+	// FieldEngineVersion holds the string denoting the engine_version field in the database.
+	FieldEngineVersion = "engine_version"
+	// FieldLegalityCheckedAt holds the string denoting the legality_checked_at field in the database.
+	FieldLegalityCheckedAt = "legality_checked_at"
+	// FieldSpecies holds the string denoting the species field in the database.
+	FieldSpecies = "species"
+	// FieldForm holds the string denoting the form field in the database.
+	FieldForm = "form"
+	// FieldLevel holds the string denoting the level field in the database.
+	FieldLevel = "level"
+	// FieldShiny holds the string denoting the shiny field in the database.
+	FieldShiny = "shiny"
+	// FieldNature holds the string denoting the nature field in the database.
+	FieldNature = "nature"
+	// FieldGender holds the string denoting the gender field in the database.
+	FieldGender = "gender"
+	// FieldOtName holds the string denoting the ot_name field in the database.
+	FieldOtName = "ot_name"
+	// FieldTid holds the string denoting the tid field in the database.
+	FieldTid = "tid"
+	// FieldSid holds the string denoting the sid field in the database.
+	FieldSid = "sid"
+	// FieldBall holds the string denoting the ball field in the database.
+	FieldBall = "ball"
+	// FieldHeldItem holds the string denoting the held_item field in the database.
+	FieldHeldItem = "held_item"
+	// FieldLanguage holds the string denoting the language field in the database.
+	FieldLanguage = "language"
+	// FieldOriginGame holds the string denoting the origin_game field in the database.
+	FieldOriginGame = "origin_game"
+	// FieldMergedIntoID holds the string denoting the merged_into_id field in the database.
+	FieldMergedIntoID = "merged_into_id"
+	// EdgeBundleSlots holds the string denoting the bundle_slots edge name in mutations.
+	EdgeBundleSlots = "bundle_slots"
+	// EdgeMergedInto holds the string denoting the merged_into edge name in mutations.
+	EdgeMergedInto = "merged_into"
+	// EdgeDuplicates holds the string denoting the duplicates edge name in mutations.
+	EdgeDuplicates = "duplicates"
 	// Table holds the table name of the pokemon in the database.
 	Table = "pokemons"
-	// BundlesTable is the table that holds the bundles relation/edge. The primary key declared below.
-	BundlesTable = "bundle_pokemons"
-	// BundlesInverseTable is the table name for the Bundle entity.
-	// It exists in this package in order to avoid circular dependency with the "bundle" package.
-	BundlesInverseTable = "bundles"
+	// BundleSlotsTable is the table that holds the bundle_slots relation/edge.
+	BundleSlotsTable = "bundle_slots"
+	// BundleSlotsInverseTable is the table name for the BundleSlot entity.
+	// It exists in this package in order to avoid circular dependency with the "bundleslot" package.
+	BundleSlotsInverseTable = "bundle_slots"
+	// BundleSlotsColumn is the table column denoting the bundle_slots relation/edge.
+	BundleSlotsColumn = "pokemon_id"
+	// MergedIntoTable is the table that holds the merged_into relation/edge.
+	MergedIntoTable = "pokemons"
+	// MergedIntoColumn is the table column denoting the merged_into relation/edge.
+	MergedIntoColumn = "merged_into_id"
+	// DuplicatesTable is the table that holds the duplicates relation/edge.
+	DuplicatesTable = "pokemons"
+	// DuplicatesColumn is the table column denoting the duplicates relation/edge.
+	DuplicatesColumn = "merged_into_id"
 )
 
 // Columns holds all SQL columns for pokemon fields.
 var Columns = []string{
 	FieldID,
+	FieldVisibility,
+	FieldVisibilityChangedAt,
+	FieldVisibilityReason,
 	FieldUploadDatetime,
 	FieldDownloadCode,
 	FieldDownloadCount,
 	FieldGeneration,
 	FieldLegal,
+	FieldData,
 	FieldBase64,
+	FieldContentHash,
+	FieldLegalityReport,
+	FieldEngineVersion,
+	FieldLegalityCheckedAt,
+	FieldSpecies,
+	FieldForm,
+	FieldLevel,
+	FieldShiny,
+	FieldNature,
+	FieldGender,
+	FieldOtName,
+	FieldTid,
+	FieldSid,
+	FieldBall,
+	FieldHeldItem,
+	FieldLanguage,
+	FieldOriginGame,
+	FieldMergedIntoID,
 }
 
-var (
-	// BundlesPrimaryKey and BundlesColumn2 are the table columns denoting the
-	// primary key for the bundles relation (M2M).
-	BundlesPrimaryKey = []string{"bundle_id", "pokemon_id"}
-)
-
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -67,6 +143,33 @@ var (
 	DefaultDownloadCount int
 )
 
+// Visibility defines the type for the "visibility" enum field.
+type Visibility string
+
+// VisibilityVisible is the default value of the Visibility enum.
+const DefaultVisibility = VisibilityVisible
+
+// Visibility values.
+const (
+	VisibilityVisible Visibility = "visible"
+	VisibilityHidden  Visibility = "hidden"
+	VisibilityDeleted Visibility = "deleted"
+)
+
+func (v Visibility) String() string {
+	return string(v)
+}
+
+// VisibilityValidator is a validator for the "visibility" field enum values. It is called by the builders before save.
+func VisibilityValidator(v Visibility) error {
+	switch v {
+	case VisibilityVisible, VisibilityHidden, VisibilityDeleted:
+		return nil
+	default:
+		return fmt.Errorf("pokemon: invalid enum value for visibility field: %q", v)
+	}
+}
+
 // OrderOption defines the ordering options for the Pokemon queries.
 type OrderOption func(*sql.Selector)
 
@@ -75,6 +178,21 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByVisibility orders the results by the visibility field.
+func ByVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisibility, opts...).ToFunc()
+}
+
+// ByVisibilityChangedAt orders the results by the visibility_changed_at field.
+func ByVisibilityChangedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisibilityChangedAt, opts...).ToFunc()
+}
+
+// ByVisibilityReason orders the results by the visibility_reason field.
+func ByVisibilityReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisibilityReason, opts...).ToFunc()
+}
+
 // ByUploadDatetime orders the results by the upload_datetime field.
 func ByUploadDatetime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUploadDatetime, opts...).ToFunc()
@@ -105,23 +223,143 @@ func ByBase64(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBase64, opts...).ToFunc()
 }
 
-// ByBundlesCount orders the results by bundles count.
-func ByBundlesCount(opts ...sql.OrderTermOption) OrderOption {
+// ByContentHash orders the results by the content_hash field.
+func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
+}
+
+// ByEngineVersion orders the results by the engine_version field.
+func ByEngineVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEngineVersion, opts...).ToFunc()
+}
+
+// ByLegalityCheckedAt orders the results by the legality_checked_at field.
+func ByLegalityCheckedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLegalityCheckedAt, opts...).ToFunc()
+}
+
+// BySpecies orders the results by the species field.
+func BySpecies(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpecies, opts...).ToFunc()
+}
+
+// ByForm orders the results by the form field.
+func ByForm(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldForm, opts...).ToFunc()
+}
+
+// ByLevel orders the results by the level field.
+func ByLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLevel, opts...).ToFunc()
+}
+
+// ByShiny orders the results by the shiny field.
+func ByShiny(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShiny, opts...).ToFunc()
+}
+
+// ByNature orders the results by the nature field.
+func ByNature(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNature, opts...).ToFunc()
+}
+
+// ByGender orders the results by the gender field.
+func ByGender(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGender, opts...).ToFunc()
+}
+
+// ByOtName orders the results by the ot_name field.
+func ByOtName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOtName, opts...).ToFunc()
+}
+
+// ByTid orders the results by the tid field.
+func ByTid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTid, opts...).ToFunc()
+}
+
+// BySid orders the results by the sid field.
+func BySid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSid, opts...).ToFunc()
+}
+
+// ByBall orders the results by the ball field.
+func ByBall(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBall, opts...).ToFunc()
+}
+
+// ByHeldItem orders the results by the held_item field.
+func ByHeldItem(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeldItem, opts...).ToFunc()
+}
+
+// ByLanguage orders the results by the language field.
+func ByLanguage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLanguage, opts...).ToFunc()
+}
+
+// ByOriginGame orders the results by the origin_game field.
+func ByOriginGame(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginGame, opts...).ToFunc()
+}
+
+// ByMergedIntoID orders the results by the merged_into_id field.
+func ByMergedIntoID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMergedIntoID, opts...).ToFunc()
+}
+
+// ByBundleSlotsCount orders the results by bundle_slots count.
+func ByBundleSlotsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborsCount(s, newBundlesStep(), opts...)
+		sqlgraph.OrderByNeighborsCount(s, newBundleSlotsStep(), opts...)
 	}
 }
 
-// ByBundles orders the results by bundles terms.
-func ByBundles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+// ByBundleSlots orders the results by bundle_slots terms.
+func ByBundleSlots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newBundlesStep(), append([]sql.OrderTerm{term}, terms...)...)
+		sqlgraph.OrderByNeighborTerms(s, newBundleSlotsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
-func newBundlesStep() *sqlgraph.Step {
+
+// ByMergedIntoField orders the results by merged_into field.
+func ByMergedIntoField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMergedIntoStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDuplicatesCount orders the results by duplicates count.
+func ByDuplicatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDuplicatesStep(), opts...)
+	}
+}
+
+// ByDuplicates orders the results by duplicates terms.
+func ByDuplicates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDuplicatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newBundleSlotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BundleSlotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BundleSlotsTable, BundleSlotsColumn),
+	)
+}
+func newMergedIntoStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, MergedIntoTable, MergedIntoColumn),
+	)
+}
+func newDuplicatesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
-		sqlgraph.To(BundlesInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.M2M, true, BundlesTable, BundlesPrimaryKey...),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DuplicatesTable, DuplicatesColumn),
 	)
 }
@@ -55,6 +55,16 @@ func IDLTE(id int) predicate.Pokemon {
 	return predicate.Pokemon(sql.FieldLTE(FieldID, id))
 }
 
+// VisibilityChangedAt applies equality check predicate on the "visibility_changed_at" field. It's identical to VisibilityChangedAtEQ.
+func VisibilityChangedAt(v time.Time) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldVisibilityChangedAt, v))
+}
+
+// VisibilityReason applies equality check predicate on the "visibility_reason" field. It's identical to VisibilityReasonEQ.
+func VisibilityReason(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldVisibilityReason, v))
+}
+
 // UploadDatetime applies equality check predicate on the "upload_datetime" field. It's identical to UploadDatetimeEQ.
 func UploadDatetime(v time.Time) predicate.Pokemon {
 	return predicate.Pokemon(sql.FieldEQ(FieldUploadDatetime, v))
@@ -80,11 +90,246 @@ func Legal(v bool) predicate.Pokemon {
 	return predicate.Pokemon(sql.FieldEQ(FieldLegal, v))
 }
 
+// Data applies equality check predicate on the "data" field. It's identical to DataEQ.
+func Data(v []byte) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldData, v))
+}
+
 // Base64 applies equality check predicate on the "base_64" field. It's identical to Base64EQ.
 func Base64(v string) predicate.Pokemon {
 	return predicate.Pokemon(sql.FieldEQ(FieldBase64, v))
 }
 
+// ContentHash applies equality check predicate on the "content_hash" field. It's identical to ContentHashEQ.
+func ContentHash(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldContentHash, v))
+}
+
+// EngineVersion applies equality check predicate on the "engine_version" field. It's identical to EngineVersionEQ.
+func EngineVersion(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldEngineVersion, v))
+}
+
+// LegalityCheckedAt applies equality check predicate on the "legality_checked_at" field. It's identical to LegalityCheckedAtEQ.
+func LegalityCheckedAt(v time.Time) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldLegalityCheckedAt, v))
+}
+
+// Species applies equality check predicate on the "species" field. It's identical to SpeciesEQ.
+func Species(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldSpecies, v))
+}
+
+// Form applies equality check predicate on the "form" field. It's identical to FormEQ.
+func Form(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldForm, v))
+}
+
+// Level applies equality check predicate on the "level" field. It's identical to LevelEQ.
+func Level(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldLevel, v))
+}
+
+// Shiny applies equality check predicate on the "shiny" field. It's identical to ShinyEQ.
+func Shiny(v bool) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldShiny, v))
+}
+
+// Nature applies equality check predicate on the "nature" field. It's identical to NatureEQ.
+func Nature(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldNature, v))
+}
+
+// Gender applies equality check predicate on the "gender" field. It's identical to GenderEQ.
+func Gender(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldGender, v))
+}
+
+// OtName applies equality check predicate on the "ot_name" field. It's identical to OtNameEQ.
+func OtName(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldOtName, v))
+}
+
+// Tid applies equality check predicate on the "tid" field. It's identical to TidEQ.
+func Tid(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldTid, v))
+}
+
+// Sid applies equality check predicate on the "sid" field. It's identical to SidEQ.
+func Sid(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldSid, v))
+}
+
+// Ball applies equality check predicate on the "ball" field. It's identical to BallEQ.
+func Ball(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldBall, v))
+}
+
+// HeldItem applies equality check predicate on the "held_item" field. It's identical to HeldItemEQ.
+func HeldItem(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldHeldItem, v))
+}
+
+// Language applies equality check predicate on the "language" field. It's identical to LanguageEQ.
+func Language(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldLanguage, v))
+}
+
+// OriginGame applies equality check predicate on the "origin_game" field. It's identical to OriginGameEQ.
+func OriginGame(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldOriginGame, v))
+}
+
+// MergedIntoID applies equality check predicate on the "merged_into_id" field. It's identical to MergedIntoIDEQ.
+func MergedIntoID(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldMergedIntoID, v))
+}
+
+// VisibilityEQ applies the EQ predicate on the "visibility" field.
+func VisibilityEQ(v Visibility) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldVisibility, v))
+}
+
+// VisibilityNEQ applies the NEQ predicate on the "visibility" field.
+func VisibilityNEQ(v Visibility) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldVisibility, v))
+}
+
+// VisibilityIn applies the In predicate on the "visibility" field.
+func VisibilityIn(vs ...Visibility) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIn(FieldVisibility, vs...))
+}
+
+// VisibilityNotIn applies the NotIn predicate on the "visibility" field.
+func VisibilityNotIn(vs ...Visibility) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotIn(FieldVisibility, vs...))
+}
+
+// VisibilityChangedAtEQ applies the EQ predicate on the "visibility_changed_at" field.
+func VisibilityChangedAtEQ(v time.Time) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldVisibilityChangedAt, v))
+}
+
+// VisibilityChangedAtNEQ applies the NEQ predicate on the "visibility_changed_at" field.
+func VisibilityChangedAtNEQ(v time.Time) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldVisibilityChangedAt, v))
+}
+
+// VisibilityChangedAtIn applies the In predicate on the "visibility_changed_at" field.
+func VisibilityChangedAtIn(vs ...time.Time) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIn(FieldVisibilityChangedAt, vs...))
+}
+
+// VisibilityChangedAtNotIn applies the NotIn predicate on the "visibility_changed_at" field.
+func VisibilityChangedAtNotIn(vs ...time.Time) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotIn(FieldVisibilityChangedAt, vs...))
+}
+
+// VisibilityChangedAtGT applies the GT predicate on the "visibility_changed_at" field.
+func VisibilityChangedAtGT(v time.Time) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGT(FieldVisibilityChangedAt, v))
+}
+
+// VisibilityChangedAtGTE applies the GTE predicate on the "visibility_changed_at" field.
+func VisibilityChangedAtGTE(v time.Time) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGTE(FieldVisibilityChangedAt, v))
+}
+
+// VisibilityChangedAtLT applies the LT predicate on the "visibility_changed_at" field.
+func VisibilityChangedAtLT(v time.Time) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLT(FieldVisibilityChangedAt, v))
+}
+
+// VisibilityChangedAtLTE applies the LTE predicate on the "visibility_changed_at" field.
+func VisibilityChangedAtLTE(v time.Time) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLTE(FieldVisibilityChangedAt, v))
+}
+
+// VisibilityChangedAtIsNil applies the IsNil predicate on the "visibility_changed_at" field.
+func VisibilityChangedAtIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldVisibilityChangedAt))
+}
+
+// VisibilityChangedAtNotNil applies the NotNil predicate on the "visibility_changed_at" field.
+func VisibilityChangedAtNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldVisibilityChangedAt))
+}
+
+// VisibilityReasonEQ applies the EQ predicate on the "visibility_reason" field.
+func VisibilityReasonEQ(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldVisibilityReason, v))
+}
+
+// VisibilityReasonNEQ applies the NEQ predicate on the "visibility_reason" field.
+func VisibilityReasonNEQ(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldVisibilityReason, v))
+}
+
+// VisibilityReasonIn applies the In predicate on the "visibility_reason" field.
+func VisibilityReasonIn(vs ...string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIn(FieldVisibilityReason, vs...))
+}
+
+// VisibilityReasonNotIn applies the NotIn predicate on the "visibility_reason" field.
+func VisibilityReasonNotIn(vs ...string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotIn(FieldVisibilityReason, vs...))
+}
+
+// VisibilityReasonGT applies the GT predicate on the "visibility_reason" field.
+func VisibilityReasonGT(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGT(FieldVisibilityReason, v))
+}
+
+// VisibilityReasonGTE applies the GTE predicate on the "visibility_reason" field.
+func VisibilityReasonGTE(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGTE(FieldVisibilityReason, v))
+}
+
+// VisibilityReasonLT applies the LT predicate on the "visibility_reason" field.
+func VisibilityReasonLT(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLT(FieldVisibilityReason, v))
+}
+
+// VisibilityReasonLTE applies the LTE predicate on the "visibility_reason" field.
+func VisibilityReasonLTE(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLTE(FieldVisibilityReason, v))
+}
+
+// VisibilityReasonContains applies the Contains predicate on the "visibility_reason" field.
+func VisibilityReasonContains(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldContains(FieldVisibilityReason, v))
+}
+
+// VisibilityReasonHasPrefix applies the HasPrefix predicate on the "visibility_reason" field.
+func VisibilityReasonHasPrefix(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldHasPrefix(FieldVisibilityReason, v))
+}
+
+// VisibilityReasonHasSuffix applies the HasSuffix predicate on the "visibility_reason" field.
+func VisibilityReasonHasSuffix(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldHasSuffix(FieldVisibilityReason, v))
+}
+
+// VisibilityReasonIsNil applies the IsNil predicate on the "visibility_reason" field.
+func VisibilityReasonIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldVisibilityReason))
+}
+
+// VisibilityReasonNotNil applies the NotNil predicate on the "visibility_reason" field.
+func VisibilityReasonNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldVisibilityReason))
+}
+
+// VisibilityReasonEqualFold applies the EqualFold predicate on the "visibility_reason" field.
+func VisibilityReasonEqualFold(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEqualFold(FieldVisibilityReason, v))
+}
+
+// VisibilityReasonContainsFold applies the ContainsFold predicate on the "visibility_reason" field.
+func VisibilityReasonContainsFold(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldContainsFold(FieldVisibilityReason, v))
+}
+
 // UploadDatetimeEQ applies the EQ predicate on the "upload_datetime" field.
 func UploadDatetimeEQ(v time.Time) predicate.Pokemon {
 	return predicate.Pokemon(sql.FieldEQ(FieldUploadDatetime, v))
@@ -305,6 +550,56 @@ func LegalNEQ(v bool) predicate.Pokemon {
 	return predicate.Pokemon(sql.FieldNEQ(FieldLegal, v))
 }
 
+// DataEQ applies the EQ predicate on the "data" field.
+func DataEQ(v []byte) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldData, v))
+}
+
+// DataNEQ applies the NEQ predicate on the "data" field.
+func DataNEQ(v []byte) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldData, v))
+}
+
+// DataIn applies the In predicate on the "data" field.
+func DataIn(vs ...[]byte) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIn(FieldData, vs...))
+}
+
+// DataNotIn applies the NotIn predicate on the "data" field.
+func DataNotIn(vs ...[]byte) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotIn(FieldData, vs...))
+}
+
+// DataGT applies the GT predicate on the "data" field.
+func DataGT(v []byte) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGT(FieldData, v))
+}
+
+// DataGTE applies the GTE predicate on the "data" field.
+func DataGTE(v []byte) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGTE(FieldData, v))
+}
+
+// DataLT applies the LT predicate on the "data" field.
+func DataLT(v []byte) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLT(FieldData, v))
+}
+
+// DataLTE applies the LTE predicate on the "data" field.
+func DataLTE(v []byte) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLTE(FieldData, v))
+}
+
+// DataIsNil applies the IsNil predicate on the "data" field.
+func DataIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldData))
+}
+
+// DataNotNil applies the NotNil predicate on the "data" field.
+func DataNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldData))
+}
+
 // Base64EQ applies the EQ predicate on the "base_64" field.
 func Base64EQ(v string) predicate.Pokemon {
 	return predicate.Pokemon(sql.FieldEQ(FieldBase64, v))
@@ -360,6 +655,16 @@ func Base64HasSuffix(v string) predicate.Pokemon {
 	return predicate.Pokemon(sql.FieldHasSuffix(FieldBase64, v))
 }
 
+// Base64IsNil applies the IsNil predicate on the "base_64" field.
+func Base64IsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldBase64))
+}
+
+// Base64NotNil applies the NotNil predicate on the "base_64" field.
+func Base64NotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldBase64))
+}
+
 // Base64EqualFold applies the EqualFold predicate on the "base_64" field.
 func Base64EqualFold(v string) predicate.Pokemon {
 	return predicate.Pokemon(sql.FieldEqualFold(FieldBase64, v))
@@ -370,21 +675,952 @@ func Base64ContainsFold(v string) predicate.Pokemon {
 	return predicate.Pokemon(sql.FieldContainsFold(FieldBase64, v))
 }
 
-// HasBundles applies the HasEdge predicate on the "bundles" edge.
-func HasBundles() predicate.Pokemon {
+// ContentHashEQ applies the EQ predicate on the "content_hash" field.
+func ContentHashEQ(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ContentHashNEQ applies the NEQ predicate on the "content_hash" field.
+func ContentHashNEQ(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldContentHash, v))
+}
+
+// ContentHashIn applies the In predicate on the "content_hash" field.
+func ContentHashIn(vs ...string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIn(FieldContentHash, vs...))
+}
+
+// ContentHashNotIn applies the NotIn predicate on the "content_hash" field.
+func ContentHashNotIn(vs ...string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotIn(FieldContentHash, vs...))
+}
+
+// ContentHashGT applies the GT predicate on the "content_hash" field.
+func ContentHashGT(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGT(FieldContentHash, v))
+}
+
+// ContentHashGTE applies the GTE predicate on the "content_hash" field.
+func ContentHashGTE(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGTE(FieldContentHash, v))
+}
+
+// ContentHashLT applies the LT predicate on the "content_hash" field.
+func ContentHashLT(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLT(FieldContentHash, v))
+}
+
+// ContentHashLTE applies the LTE predicate on the "content_hash" field.
+func ContentHashLTE(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLTE(FieldContentHash, v))
+}
+
+// ContentHashContains applies the Contains predicate on the "content_hash" field.
+func ContentHashContains(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldContains(FieldContentHash, v))
+}
+
+// ContentHashHasPrefix applies the HasPrefix predicate on the "content_hash" field.
+func ContentHashHasPrefix(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldHasPrefix(FieldContentHash, v))
+}
+
+// ContentHashHasSuffix applies the HasSuffix predicate on the "content_hash" field.
+func ContentHashHasSuffix(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldHasSuffix(FieldContentHash, v))
+}
+
+// ContentHashIsNil applies the IsNil predicate on the "content_hash" field.
+func ContentHashIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldContentHash))
+}
+
+// ContentHashNotNil applies the NotNil predicate on the "content_hash" field.
+func ContentHashNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldContentHash))
+}
+
+// ContentHashEqualFold applies the EqualFold predicate on the "content_hash" field.
+func ContentHashEqualFold(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEqualFold(FieldContentHash, v))
+}
+
+// ContentHashContainsFold applies the ContainsFold predicate on the "content_hash" field.
+func ContentHashContainsFold(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldContainsFold(FieldContentHash, v))
+}
+
+// LegalityReportIsNil applies the IsNil predicate on the "legality_report" field.
+func LegalityReportIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldLegalityReport))
+}
+
+// LegalityReportNotNil applies the NotNil predicate on the "legality_report" field.
+func LegalityReportNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldLegalityReport))
+}
+
+// EngineVersionEQ applies the EQ predicate on the "engine_version" field.
+func EngineVersionEQ(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldEngineVersion, v))
+}
+
+// EngineVersionNEQ applies the NEQ predicate on the "engine_version" field.
+func EngineVersionNEQ(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldEngineVersion, v))
+}
+
+// EngineVersionIn applies the In predicate on the "engine_version" field.
+func EngineVersionIn(vs ...string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIn(FieldEngineVersion, vs...))
+}
+
+// EngineVersionNotIn applies the NotIn predicate on the "engine_version" field.
+func EngineVersionNotIn(vs ...string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotIn(FieldEngineVersion, vs...))
+}
+
+// EngineVersionGT applies the GT predicate on the "engine_version" field.
+func EngineVersionGT(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGT(FieldEngineVersion, v))
+}
+
+// EngineVersionGTE applies the GTE predicate on the "engine_version" field.
+func EngineVersionGTE(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGTE(FieldEngineVersion, v))
+}
+
+// EngineVersionLT applies the LT predicate on the "engine_version" field.
+func EngineVersionLT(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLT(FieldEngineVersion, v))
+}
+
+// EngineVersionLTE applies the LTE predicate on the "engine_version" field.
+func EngineVersionLTE(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLTE(FieldEngineVersion, v))
+}
+
+// EngineVersionContains applies the Contains predicate on the "engine_version" field.
+func EngineVersionContains(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldContains(FieldEngineVersion, v))
+}
+
+// EngineVersionHasPrefix applies the HasPrefix predicate on the "engine_version" field.
+func EngineVersionHasPrefix(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldHasPrefix(FieldEngineVersion, v))
+}
+
+// EngineVersionHasSuffix applies the HasSuffix predicate on the "engine_version" field.
+func EngineVersionHasSuffix(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldHasSuffix(FieldEngineVersion, v))
+}
+
+// EngineVersionIsNil applies the IsNil predicate on the "engine_version" field.
+func EngineVersionIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldEngineVersion))
+}
+
+// EngineVersionNotNil applies the NotNil predicate on the "engine_version" field.
+func EngineVersionNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldEngineVersion))
+}
+
+// EngineVersionEqualFold applies the EqualFold predicate on the "engine_version" field.
+func EngineVersionEqualFold(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEqualFold(FieldEngineVersion, v))
+}
+
+// EngineVersionContainsFold applies the ContainsFold predicate on the "engine_version" field.
+func EngineVersionContainsFold(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldContainsFold(FieldEngineVersion, v))
+}
+
+// LegalityCheckedAtEQ applies the EQ predicate on the "legality_checked_at" field.
+func LegalityCheckedAtEQ(v time.Time) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldLegalityCheckedAt, v))
+}
+
+// LegalityCheckedAtNEQ applies the NEQ predicate on the "legality_checked_at" field.
+func LegalityCheckedAtNEQ(v time.Time) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldLegalityCheckedAt, v))
+}
+
+// LegalityCheckedAtIn applies the In predicate on the "legality_checked_at" field.
+func LegalityCheckedAtIn(vs ...time.Time) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIn(FieldLegalityCheckedAt, vs...))
+}
+
+// LegalityCheckedAtNotIn applies the NotIn predicate on the "legality_checked_at" field.
+func LegalityCheckedAtNotIn(vs ...time.Time) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotIn(FieldLegalityCheckedAt, vs...))
+}
+
+// LegalityCheckedAtGT applies the GT predicate on the "legality_checked_at" field.
+func LegalityCheckedAtGT(v time.Time) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGT(FieldLegalityCheckedAt, v))
+}
+
+// LegalityCheckedAtGTE applies the GTE predicate on the "legality_checked_at" field.
+func LegalityCheckedAtGTE(v time.Time) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGTE(FieldLegalityCheckedAt, v))
+}
+
+// LegalityCheckedAtLT applies the LT predicate on the "legality_checked_at" field.
+func LegalityCheckedAtLT(v time.Time) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLT(FieldLegalityCheckedAt, v))
+}
+
+// LegalityCheckedAtLTE applies the LTE predicate on the "legality_checked_at" field.
+func LegalityCheckedAtLTE(v time.Time) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLTE(FieldLegalityCheckedAt, v))
+}
+
+// LegalityCheckedAtIsNil applies the IsNil predicate on the "legality_checked_at" field.
+func LegalityCheckedAtIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldLegalityCheckedAt))
+}
+
+// LegalityCheckedAtNotNil applies the NotNil predicate on the "legality_checked_at" field.
+func LegalityCheckedAtNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldLegalityCheckedAt))
+}
+
+// SpeciesEQ applies the EQ predicate on the "species" field.
+func SpeciesEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldSpecies, v))
+}
+
+// SpeciesNEQ applies the NEQ predicate on the "species" field.
+func SpeciesNEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldSpecies, v))
+}
+
+// SpeciesIn applies the In predicate on the "species" field.
+func SpeciesIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIn(FieldSpecies, vs...))
+}
+
+// SpeciesNotIn applies the NotIn predicate on the "species" field.
+func SpeciesNotIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotIn(FieldSpecies, vs...))
+}
+
+// SpeciesGT applies the GT predicate on the "species" field.
+func SpeciesGT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGT(FieldSpecies, v))
+}
+
+// SpeciesGTE applies the GTE predicate on the "species" field.
+func SpeciesGTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGTE(FieldSpecies, v))
+}
+
+// SpeciesLT applies the LT predicate on the "species" field.
+func SpeciesLT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLT(FieldSpecies, v))
+}
+
+// SpeciesLTE applies the LTE predicate on the "species" field.
+func SpeciesLTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLTE(FieldSpecies, v))
+}
+
+// SpeciesIsNil applies the IsNil predicate on the "species" field.
+func SpeciesIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldSpecies))
+}
+
+// SpeciesNotNil applies the NotNil predicate on the "species" field.
+func SpeciesNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldSpecies))
+}
+
+// FormEQ applies the EQ predicate on the "form" field.
+func FormEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldForm, v))
+}
+
+// FormNEQ applies the NEQ predicate on the "form" field.
+func FormNEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldForm, v))
+}
+
+// FormIn applies the In predicate on the "form" field.
+func FormIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIn(FieldForm, vs...))
+}
+
+// FormNotIn applies the NotIn predicate on the "form" field.
+func FormNotIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotIn(FieldForm, vs...))
+}
+
+// FormGT applies the GT predicate on the "form" field.
+func FormGT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGT(FieldForm, v))
+}
+
+// FormGTE applies the GTE predicate on the "form" field.
+func FormGTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGTE(FieldForm, v))
+}
+
+// FormLT applies the LT predicate on the "form" field.
+func FormLT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLT(FieldForm, v))
+}
+
+// FormLTE applies the LTE predicate on the "form" field.
+func FormLTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLTE(FieldForm, v))
+}
+
+// FormIsNil applies the IsNil predicate on the "form" field.
+func FormIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldForm))
+}
+
+// FormNotNil applies the NotNil predicate on the "form" field.
+func FormNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldForm))
+}
+
+// LevelEQ applies the EQ predicate on the "level" field.
+func LevelEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldLevel, v))
+}
+
+// LevelNEQ applies the NEQ predicate on the "level" field.
+func LevelNEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldLevel, v))
+}
+
+// LevelIn applies the In predicate on the "level" field.
+func LevelIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIn(FieldLevel, vs...))
+}
+
+// LevelNotIn applies the NotIn predicate on the "level" field.
+func LevelNotIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotIn(FieldLevel, vs...))
+}
+
+// LevelGT applies the GT predicate on the "level" field.
+func LevelGT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGT(FieldLevel, v))
+}
+
+// LevelGTE applies the GTE predicate on the "level" field.
+func LevelGTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGTE(FieldLevel, v))
+}
+
+// LevelLT applies the LT predicate on the "level" field.
+func LevelLT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLT(FieldLevel, v))
+}
+
+// LevelLTE applies the LTE predicate on the "level" field.
+func LevelLTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLTE(FieldLevel, v))
+}
+
+// LevelIsNil applies the IsNil predicate on the "level" field.
+func LevelIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldLevel))
+}
+
+// LevelNotNil applies the NotNil predicate on the "level" field.
+func LevelNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldLevel))
+}
+
+// ShinyEQ applies the EQ predicate on the "shiny" field.
+func ShinyEQ(v bool) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldShiny, v))
+}
+
+// ShinyNEQ applies the NEQ predicate on the "shiny" field.
+func ShinyNEQ(v bool) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldShiny, v))
+}
+
+// ShinyIsNil applies the IsNil predicate on the "shiny" field.
+func ShinyIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldShiny))
+}
+
+// ShinyNotNil applies the NotNil predicate on the "shiny" field.
+func ShinyNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldShiny))
+}
+
+// NatureEQ applies the EQ predicate on the "nature" field.
+func NatureEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldNature, v))
+}
+
+// NatureNEQ applies the NEQ predicate on the "nature" field.
+func NatureNEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldNature, v))
+}
+
+// NatureIn applies the In predicate on the "nature" field.
+func NatureIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIn(FieldNature, vs...))
+}
+
+// NatureNotIn applies the NotIn predicate on the "nature" field.
+func NatureNotIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotIn(FieldNature, vs...))
+}
+
+// NatureGT applies the GT predicate on the "nature" field.
+func NatureGT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGT(FieldNature, v))
+}
+
+// NatureGTE applies the GTE predicate on the "nature" field.
+func NatureGTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGTE(FieldNature, v))
+}
+
+// NatureLT applies the LT predicate on the "nature" field.
+func NatureLT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLT(FieldNature, v))
+}
+
+// NatureLTE applies the LTE predicate on the "nature" field.
+func NatureLTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLTE(FieldNature, v))
+}
+
+// NatureIsNil applies the IsNil predicate on the "nature" field.
+func NatureIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldNature))
+}
+
+// NatureNotNil applies the NotNil predicate on the "nature" field.
+func NatureNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldNature))
+}
+
+// GenderEQ applies the EQ predicate on the "gender" field.
+func GenderEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldGender, v))
+}
+
+// GenderNEQ applies the NEQ predicate on the "gender" field.
+func GenderNEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldGender, v))
+}
+
+// GenderIn applies the In predicate on the "gender" field.
+func GenderIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIn(FieldGender, vs...))
+}
+
+// GenderNotIn applies the NotIn predicate on the "gender" field.
+func GenderNotIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotIn(FieldGender, vs...))
+}
+
+// GenderGT applies the GT predicate on the "gender" field.
+func GenderGT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGT(FieldGender, v))
+}
+
+// GenderGTE applies the GTE predicate on the "gender" field.
+func GenderGTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGTE(FieldGender, v))
+}
+
+// GenderLT applies the LT predicate on the "gender" field.
+func GenderLT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLT(FieldGender, v))
+}
+
+// GenderLTE applies the LTE predicate on the "gender" field.
+func GenderLTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLTE(FieldGender, v))
+}
+
+// GenderIsNil applies the IsNil predicate on the "gender" field.
+func GenderIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldGender))
+}
+
+// GenderNotNil applies the NotNil predicate on the "gender" field.
+func GenderNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldGender))
+}
+
+// OtNameEQ applies the EQ predicate on the "ot_name" field.
+func OtNameEQ(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldOtName, v))
+}
+
+// OtNameNEQ applies the NEQ predicate on the "ot_name" field.
+func OtNameNEQ(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldOtName, v))
+}
+
+// OtNameIn applies the In predicate on the "ot_name" field.
+func OtNameIn(vs ...string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIn(FieldOtName, vs...))
+}
+
+// OtNameNotIn applies the NotIn predicate on the "ot_name" field.
+func OtNameNotIn(vs ...string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotIn(FieldOtName, vs...))
+}
+
+// OtNameGT applies the GT predicate on the "ot_name" field.
+func OtNameGT(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGT(FieldOtName, v))
+}
+
+// OtNameGTE applies the GTE predicate on the "ot_name" field.
+func OtNameGTE(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGTE(FieldOtName, v))
+}
+
+// OtNameLT applies the LT predicate on the "ot_name" field.
+func OtNameLT(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLT(FieldOtName, v))
+}
+
+// OtNameLTE applies the LTE predicate on the "ot_name" field.
+func OtNameLTE(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLTE(FieldOtName, v))
+}
+
+// OtNameContains applies the Contains predicate on the "ot_name" field.
+func OtNameContains(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldContains(FieldOtName, v))
+}
+
+// OtNameHasPrefix applies the HasPrefix predicate on the "ot_name" field.
+func OtNameHasPrefix(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldHasPrefix(FieldOtName, v))
+}
+
+// OtNameHasSuffix applies the HasSuffix predicate on the "ot_name" field.
+func OtNameHasSuffix(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldHasSuffix(FieldOtName, v))
+}
+
+// OtNameIsNil applies the IsNil predicate on the "ot_name" field.
+func OtNameIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldOtName))
+}
+
+// OtNameNotNil applies the NotNil predicate on the "ot_name" field.
+func OtNameNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldOtName))
+}
+
+// OtNameEqualFold applies the EqualFold predicate on the "ot_name" field.
+func OtNameEqualFold(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEqualFold(FieldOtName, v))
+}
+
+// OtNameContainsFold applies the ContainsFold predicate on the "ot_name" field.
+func OtNameContainsFold(v string) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldContainsFold(FieldOtName, v))
+}
+
+// TidEQ applies the EQ predicate on the "tid" field.
+func TidEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldTid, v))
+}
+
+// TidNEQ applies the NEQ predicate on the "tid" field.
+func TidNEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldTid, v))
+}
+
+// TidIn applies the In predicate on the "tid" field.
+func TidIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIn(FieldTid, vs...))
+}
+
+// TidNotIn applies the NotIn predicate on the "tid" field.
+func TidNotIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotIn(FieldTid, vs...))
+}
+
+// TidGT applies the GT predicate on the "tid" field.
+func TidGT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGT(FieldTid, v))
+}
+
+// TidGTE applies the GTE predicate on the "tid" field.
+func TidGTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGTE(FieldTid, v))
+}
+
+// TidLT applies the LT predicate on the "tid" field.
+func TidLT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLT(FieldTid, v))
+}
+
+// TidLTE applies the LTE predicate on the "tid" field.
+func TidLTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLTE(FieldTid, v))
+}
+
+// TidIsNil applies the IsNil predicate on the "tid" field.
+func TidIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldTid))
+}
+
+// TidNotNil applies the NotNil predicate on the "tid" field.
+func TidNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldTid))
+}
+
+// SidEQ applies the EQ predicate on the "sid" field.
+func SidEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldSid, v))
+}
+
+// SidNEQ applies the NEQ predicate on the "sid" field.
+func SidNEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldSid, v))
+}
+
+// SidIn applies the In predicate on the "sid" field.
+func SidIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIn(FieldSid, vs...))
+}
+
+// SidNotIn applies the NotIn predicate on the "sid" field.
+func SidNotIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotIn(FieldSid, vs...))
+}
+
+// SidGT applies the GT predicate on the "sid" field.
+func SidGT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGT(FieldSid, v))
+}
+
+// SidGTE applies the GTE predicate on the "sid" field.
+func SidGTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGTE(FieldSid, v))
+}
+
+// SidLT applies the LT predicate on the "sid" field.
+func SidLT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLT(FieldSid, v))
+}
+
+// SidLTE applies the LTE predicate on the "sid" field.
+func SidLTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLTE(FieldSid, v))
+}
+
+// SidIsNil applies the IsNil predicate on the "sid" field.
+func SidIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldSid))
+}
+
+// SidNotNil applies the NotNil predicate on the "sid" field.
+func SidNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldSid))
+}
+
+// BallEQ applies the EQ predicate on the "ball" field.
+func BallEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldBall, v))
+}
+
+// BallNEQ applies the NEQ predicate on the "ball" field.
+func BallNEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldBall, v))
+}
+
+// BallIn applies the In predicate on the "ball" field.
+func BallIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIn(FieldBall, vs...))
+}
+
+// BallNotIn applies the NotIn predicate on the "ball" field.
+func BallNotIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotIn(FieldBall, vs...))
+}
+
+// BallGT applies the GT predicate on the "ball" field.
+func BallGT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGT(FieldBall, v))
+}
+
+// BallGTE applies the GTE predicate on the "ball" field.
+func BallGTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGTE(FieldBall, v))
+}
+
+// BallLT applies the LT predicate on the "ball" field.
+func BallLT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLT(FieldBall, v))
+}
+
+// BallLTE applies the LTE predicate on the "ball" field.
+func BallLTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLTE(FieldBall, v))
+}
+
+// BallIsNil applies the IsNil predicate on the "ball" field.
+func BallIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldBall))
+}
+
+// BallNotNil applies the NotNil predicate on the "ball" field.
+func BallNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldBall))
+}
+
+// HeldItemEQ applies the EQ predicate on the "held_item" field.
+func HeldItemEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldHeldItem, v))
+}
+
+// HeldItemNEQ applies the NEQ predicate on the "held_item" field.
+func HeldItemNEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldHeldItem, v))
+}
+
+// HeldItemIn applies the In predicate on the "held_item" field.
+func HeldItemIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIn(FieldHeldItem, vs...))
+}
+
+// HeldItemNotIn applies the NotIn predicate on the "held_item" field.
+func HeldItemNotIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotIn(FieldHeldItem, vs...))
+}
+
+// HeldItemGT applies the GT predicate on the "held_item" field.
+func HeldItemGT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGT(FieldHeldItem, v))
+}
+
+// HeldItemGTE applies the GTE predicate on the "held_item" field.
+func HeldItemGTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGTE(FieldHeldItem, v))
+}
+
+// HeldItemLT applies the LT predicate on the "held_item" field.
+func HeldItemLT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLT(FieldHeldItem, v))
+}
+
+// HeldItemLTE applies the LTE predicate on the "held_item" field.
+func HeldItemLTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLTE(FieldHeldItem, v))
+}
+
+// HeldItemIsNil applies the IsNil predicate on the "held_item" field.
+func HeldItemIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldHeldItem))
+}
+
+// HeldItemNotNil applies the NotNil predicate on the "held_item" field.
+func HeldItemNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldHeldItem))
+}
+
+// LanguageEQ applies the EQ predicate on the "language" field.
+func LanguageEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldLanguage, v))
+}
+
+// LanguageNEQ applies the NEQ predicate on the "language" field.
+func LanguageNEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldLanguage, v))
+}
+
+// LanguageIn applies the In predicate on the "language" field.
+func LanguageIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIn(FieldLanguage, vs...))
+}
+
+// LanguageNotIn applies the NotIn predicate on the "language" field.
+func LanguageNotIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotIn(FieldLanguage, vs...))
+}
+
+// LanguageGT applies the GT predicate on the "language" field.
+func LanguageGT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGT(FieldLanguage, v))
+}
+
+// LanguageGTE applies the GTE predicate on the "language" field.
+func LanguageGTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGTE(FieldLanguage, v))
+}
+
+// LanguageLT applies the LT predicate on the "language" field.
+func LanguageLT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLT(FieldLanguage, v))
+}
+
+// LanguageLTE applies the LTE predicate on the "language" field.
+func LanguageLTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLTE(FieldLanguage, v))
+}
+
+// LanguageIsNil applies the IsNil predicate on the "language" field.
+func LanguageIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldLanguage))
+}
+
+// LanguageNotNil applies the NotNil predicate on the "language" field.
+func LanguageNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldLanguage))
+}
+
+// OriginGameEQ applies the EQ predicate on the "origin_game" field.
+func OriginGameEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldOriginGame, v))
+}
+
+// OriginGameNEQ applies the NEQ predicate on the "origin_game" field.
+func OriginGameNEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldOriginGame, v))
+}
+
+// OriginGameIn applies the In predicate on the "origin_game" field.
+func OriginGameIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIn(FieldOriginGame, vs...))
+}
+
+// OriginGameNotIn applies the NotIn predicate on the "origin_game" field.
+func OriginGameNotIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotIn(FieldOriginGame, vs...))
+}
+
+// OriginGameGT applies the GT predicate on the "origin_game" field.
+func OriginGameGT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGT(FieldOriginGame, v))
+}
+
+// OriginGameGTE applies the GTE predicate on the "origin_game" field.
+func OriginGameGTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldGTE(FieldOriginGame, v))
+}
+
+// OriginGameLT applies the LT predicate on the "origin_game" field.
+func OriginGameLT(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLT(FieldOriginGame, v))
+}
+
+// OriginGameLTE applies the LTE predicate on the "origin_game" field.
+func OriginGameLTE(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldLTE(FieldOriginGame, v))
+}
+
+// OriginGameIsNil applies the IsNil predicate on the "origin_game" field.
+func OriginGameIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldOriginGame))
+}
+
+// OriginGameNotNil applies the NotNil predicate on the "origin_game" field.
+func OriginGameNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldOriginGame))
+}
+
+// MergedIntoIDEQ applies the EQ predicate on the "merged_into_id" field.
+func MergedIntoIDEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldEQ(FieldMergedIntoID, v))
+}
+
+// MergedIntoIDNEQ applies the NEQ predicate on the "merged_into_id" field.
+func MergedIntoIDNEQ(v int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNEQ(FieldMergedIntoID, v))
+}
+
+// MergedIntoIDIn applies the In predicate on the "merged_into_id" field.
+func MergedIntoIDIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIn(FieldMergedIntoID, vs...))
+}
+
+// MergedIntoIDNotIn applies the NotIn predicate on the "merged_into_id" field.
+func MergedIntoIDNotIn(vs ...int) predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotIn(FieldMergedIntoID, vs...))
+}
+
+// MergedIntoIDIsNil applies the IsNil predicate on the "merged_into_id" field.
+func MergedIntoIDIsNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldIsNull(FieldMergedIntoID))
+}
+
+// MergedIntoIDNotNil applies the NotNil predicate on the "merged_into_id" field.
+func MergedIntoIDNotNil() predicate.Pokemon {
+	return predicate.Pokemon(sql.FieldNotNull(FieldMergedIntoID))
+}
+
+// HasBundleSlots applies the HasEdge predicate on the "bundle_slots" edge.
+func HasBundleSlots() predicate.Pokemon {
+	return predicate.Pokemon(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BundleSlotsTable, BundleSlotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBundleSlotsWith applies the HasEdge predicate on the "bundle_slots" edge with a given conditions (other predicates).
+func HasBundleSlotsWith(preds ...predicate.BundleSlot) predicate.Pokemon {
+	return predicate.Pokemon(func(s *sql.Selector) {
+		step := newBundleSlotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMergedInto applies the HasEdge predicate on the "merged_into" edge.
+func HasMergedInto() predicate.Pokemon {
+	return predicate.Pokemon(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MergedIntoTable, MergedIntoColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMergedIntoWith applies the HasEdge predicate on the "merged_into" edge with a given conditions (other predicates).
+func HasMergedIntoWith(preds ...predicate.Pokemon) predicate.Pokemon {
+	return predicate.Pokemon(func(s *sql.Selector) {
+		step := newMergedIntoStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDuplicates applies the HasEdge predicate on the "duplicates" edge.
+func HasDuplicates() predicate.Pokemon {
 	return predicate.Pokemon(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, BundlesTable, BundlesPrimaryKey...),
+			sqlgraph.Edge(sqlgraph.O2M, false, DuplicatesTable, DuplicatesColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
 }
 
-// HasBundlesWith applies the HasEdge predicate on the "bundles" edge with a given conditions (other predicates).
-func HasBundlesWith(preds ...predicate.Bundle) predicate.Pokemon {
+// HasDuplicatesWith applies the HasEdge predicate on the "duplicates" edge with a given conditions (other predicates).
+func HasDuplicatesWith(preds ...predicate.Pokemon) predicate.Pokemon {
 	return predicate.Pokemon(func(s *sql.Selector) {
-		step := newBundlesStep()
+		step := newDuplicatesStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -10,7 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
-	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
 )
 
@@ -21,6 +21,48 @@ type PokemonCreate struct {
 	hooks    []Hook
 }
 
+// SetVisibility sets the "visibility" field.
+func (_c *PokemonCreate) SetVisibility(v pokemon.Visibility) *PokemonCreate {
+	_c.mutation.SetVisibility(v)
+	return _c
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableVisibility(v *pokemon.Visibility) *PokemonCreate {
+	if v != nil {
+		_c.SetVisibility(*v)
+	}
+	return _c
+}
+
+// SetVisibilityChangedAt sets the "visibility_changed_at" field.
+func (_c *PokemonCreate) SetVisibilityChangedAt(v time.Time) *PokemonCreate {
+	_c.mutation.SetVisibilityChangedAt(v)
+	return _c
+}
+
+// SetNillableVisibilityChangedAt sets the "visibility_changed_at" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableVisibilityChangedAt(v *time.Time) *PokemonCreate {
+	if v != nil {
+		_c.SetVisibilityChangedAt(*v)
+	}
+	return _c
+}
+
+// SetVisibilityReason sets the "visibility_reason" field.
+func (_c *PokemonCreate) SetVisibilityReason(v string) *PokemonCreate {
+	_c.mutation.SetVisibilityReason(v)
+	return _c
+}
+
+// SetNillableVisibilityReason sets the "visibility_reason" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableVisibilityReason(v *string) *PokemonCreate {
+	if v != nil {
+		_c.SetVisibilityReason(*v)
+	}
+	return _c
+}
+
 // SetUploadDatetime sets the "upload_datetime" field.
 func (_c *PokemonCreate) SetUploadDatetime(v time.Time) *PokemonCreate {
 	_c.mutation.SetUploadDatetime(v)
@@ -59,25 +101,303 @@ func (_c *PokemonCreate) SetLegal(v bool) *PokemonCreate {
 	return _c
 }
 
+// SetData sets the "data" field.
+func (_c *PokemonCreate) SetData(v []byte) *PokemonCreate {
+	_c.mutation.SetData(v)
+	return _c
+}
+
 // SetBase64 sets the "base_64" field.
 func (_c *PokemonCreate) SetBase64(v string) *PokemonCreate {
 	_c.mutation.SetBase64(v)
 	return _c
 }
 
-// AddBundleIDs adds the "bundles" edge to the Bundle entity by IDs.
-func (_c *PokemonCreate) AddBundleIDs(ids ...int) *PokemonCreate {
-	_c.mutation.AddBundleIDs(ids...)
+// SetNillableBase64 sets the "base_64" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableBase64(v *string) *PokemonCreate {
+	if v != nil {
+		_c.SetBase64(*v)
+	}
+	return _c
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_c *PokemonCreate) SetContentHash(v string) *PokemonCreate {
+	_c.mutation.SetContentHash(v)
+	return _c
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableContentHash(v *string) *PokemonCreate {
+	if v != nil {
+		_c.SetContentHash(*v)
+	}
+	return _c
+}
+
+// SetLegalityReport sets the "legality_report" field.
+func (_c *PokemonCreate) SetLegalityReport(v []string) *PokemonCreate {
+	_c.mutation.SetLegalityReport(v)
+	return _c
+}
+
+// SetEngineVersion sets the "engine_version" field.
+func (_c *PokemonCreate) SetEngineVersion(v string) *PokemonCreate {
+	_c.mutation.SetEngineVersion(v)
+	return _c
+}
+
+// SetNillableEngineVersion sets the "engine_version" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableEngineVersion(v *string) *PokemonCreate {
+	if v != nil {
+		_c.SetEngineVersion(*v)
+	}
+	return _c
+}
+
+// SetLegalityCheckedAt sets the "legality_checked_at" field.
+func (_c *PokemonCreate) SetLegalityCheckedAt(v time.Time) *PokemonCreate {
+	_c.mutation.SetLegalityCheckedAt(v)
+	return _c
+}
+
+// SetNillableLegalityCheckedAt sets the "legality_checked_at" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableLegalityCheckedAt(v *time.Time) *PokemonCreate {
+	if v != nil {
+		_c.SetLegalityCheckedAt(*v)
+	}
+	return _c
+}
+
+// SetSpecies sets the "species" field.
+func (_c *PokemonCreate) SetSpecies(v int) *PokemonCreate {
+	_c.mutation.SetSpecies(v)
+	return _c
+}
+
+// SetNillableSpecies sets the "species" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableSpecies(v *int) *PokemonCreate {
+	if v != nil {
+		_c.SetSpecies(*v)
+	}
+	return _c
+}
+
+// SetForm sets the "form" field.
+func (_c *PokemonCreate) SetForm(v int) *PokemonCreate {
+	_c.mutation.SetForm(v)
+	return _c
+}
+
+// SetNillableForm sets the "form" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableForm(v *int) *PokemonCreate {
+	if v != nil {
+		_c.SetForm(*v)
+	}
+	return _c
+}
+
+// SetLevel sets the "level" field.
+func (_c *PokemonCreate) SetLevel(v int) *PokemonCreate {
+	_c.mutation.SetLevel(v)
+	return _c
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableLevel(v *int) *PokemonCreate {
+	if v != nil {
+		_c.SetLevel(*v)
+	}
+	return _c
+}
+
+// SetShiny sets the "shiny" field.
+func (_c *PokemonCreate) SetShiny(v bool) *PokemonCreate {
+	_c.mutation.SetShiny(v)
+	return _c
+}
+
+// SetNillableShiny sets the "shiny" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableShiny(v *bool) *PokemonCreate {
+	if v != nil {
+		_c.SetShiny(*v)
+	}
+	return _c
+}
+
+// SetNature sets the "nature" field.
+func (_c *PokemonCreate) SetNature(v int) *PokemonCreate {
+	_c.mutation.SetNature(v)
+	return _c
+}
+
+// SetNillableNature sets the "nature" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableNature(v *int) *PokemonCreate {
+	if v != nil {
+		_c.SetNature(*v)
+	}
+	return _c
+}
+
+// SetGender sets the "gender" field.
+func (_c *PokemonCreate) SetGender(v int) *PokemonCreate {
+	_c.mutation.SetGender(v)
+	return _c
+}
+
+// SetNillableGender sets the "gender" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableGender(v *int) *PokemonCreate {
+	if v != nil {
+		_c.SetGender(*v)
+	}
+	return _c
+}
+
+// SetOtName sets the "ot_name" field.
+func (_c *PokemonCreate) SetOtName(v string) *PokemonCreate {
+	_c.mutation.SetOtName(v)
+	return _c
+}
+
+// SetNillableOtName sets the "ot_name" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableOtName(v *string) *PokemonCreate {
+	if v != nil {
+		_c.SetOtName(*v)
+	}
+	return _c
+}
+
+// SetTid sets the "tid" field.
+func (_c *PokemonCreate) SetTid(v int) *PokemonCreate {
+	_c.mutation.SetTid(v)
+	return _c
+}
+
+// SetNillableTid sets the "tid" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableTid(v *int) *PokemonCreate {
+	if v != nil {
+		_c.SetTid(*v)
+	}
+	return _c
+}
+
+// SetSid sets the "sid" field.
+func (_c *PokemonCreate) SetSid(v int) *PokemonCreate {
+	_c.mutation.SetSid(v)
+	return _c
+}
+
+// SetNillableSid sets the "sid" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableSid(v *int) *PokemonCreate {
+	if v != nil {
+		_c.SetSid(*v)
+	}
+	return _c
+}
+
+// SetBall sets the "ball" field.
+func (_c *PokemonCreate) SetBall(v int) *PokemonCreate {
+	_c.mutation.SetBall(v)
+	return _c
+}
+
+// SetNillableBall sets the "ball" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableBall(v *int) *PokemonCreate {
+	if v != nil {
+		_c.SetBall(*v)
+	}
+	return _c
+}
+
+// SetHeldItem sets the "held_item" field.
+func (_c *PokemonCreate) SetHeldItem(v int) *PokemonCreate {
+	_c.mutation.SetHeldItem(v)
+	return _c
+}
+
+// SetNillableHeldItem sets the "held_item" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableHeldItem(v *int) *PokemonCreate {
+	if v != nil {
+		_c.SetHeldItem(*v)
+	}
+	return _c
+}
+
+// SetLanguage sets the "language" field.
+func (_c *PokemonCreate) SetLanguage(v int) *PokemonCreate {
+	_c.mutation.SetLanguage(v)
+	return _c
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableLanguage(v *int) *PokemonCreate {
+	if v != nil {
+		_c.SetLanguage(*v)
+	}
+	return _c
+}
+
+// SetOriginGame sets the "origin_game" field.
+func (_c *PokemonCreate) SetOriginGame(v int) *PokemonCreate {
+	_c.mutation.SetOriginGame(v)
+	return _c
+}
+
+// SetNillableOriginGame sets the "origin_game" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableOriginGame(v *int) *PokemonCreate {
+	if v != nil {
+		_c.SetOriginGame(*v)
+	}
+	return _c
+}
+
+// SetMergedIntoID sets the "merged_into_id" field.
+func (_c *PokemonCreate) SetMergedIntoID(v int) *PokemonCreate {
+	_c.mutation.SetMergedIntoID(v)
+	return _c
+}
+
+// SetNillableMergedIntoID sets the "merged_into_id" field if the given value is not nil.
+func (_c *PokemonCreate) SetNillableMergedIntoID(v *int) *PokemonCreate {
+	if v != nil {
+		_c.SetMergedIntoID(*v)
+	}
+	return _c
+}
+
+// AddBundleSlotIDs adds the "bundle_slots" edge to the BundleSlot entity by IDs.
+func (_c *PokemonCreate) AddBundleSlotIDs(ids ...int) *PokemonCreate {
+	_c.mutation.AddBundleSlotIDs(ids...)
 	return _c
 }
 
-// AddBundles adds the "bundles" edges to the Bundle entity.
-func (_c *PokemonCreate) AddBundles(v ...*Bundle) *PokemonCreate {
+// AddBundleSlots adds the "bundle_slots" edges to the BundleSlot entity.
+func (_c *PokemonCreate) AddBundleSlots(v ...*BundleSlot) *PokemonCreate {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _c.AddBundleIDs(ids...)
+	return _c.AddBundleSlotIDs(ids...)
+}
+
+// SetMergedInto sets the "merged_into" edge to the Pokemon entity.
+func (_c *PokemonCreate) SetMergedInto(v *Pokemon) *PokemonCreate {
+	return _c.SetMergedIntoID(v.ID)
+}
+
+// AddDuplicateIDs adds the "duplicates" edge to the Pokemon entity by IDs.
+func (_c *PokemonCreate) AddDuplicateIDs(ids ...int) *PokemonCreate {
+	_c.mutation.AddDuplicateIDs(ids...)
+	return _c
+}
+
+// AddDuplicates adds the "duplicates" edges to the Pokemon entity.
+func (_c *PokemonCreate) AddDuplicates(v ...*Pokemon) *PokemonCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDuplicateIDs(ids...)
 }
 
 // Mutation returns the PokemonMutation object of the builder.
@@ -115,6 +435,10 @@ func (_c *PokemonCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *PokemonCreate) defaults() {
+	if _, ok := _c.mutation.Visibility(); !ok {
+		v := pokemon.DefaultVisibility
+		_c.mutation.SetVisibility(v)
+	}
 	if _, ok := _c.mutation.DownloadCount(); !ok {
 		v := pokemon.DefaultDownloadCount
 		_c.mutation.SetDownloadCount(v)
@@ -123,6 +447,14 @@ func (_c *PokemonCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *PokemonCreate) check() error {
+	if _, ok := _c.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "Pokemon.visibility"`)}
+	}
+	if v, ok := _c.mutation.Visibility(); ok {
+		if err := pokemon.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Pokemon.visibility": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.UploadDatetime(); !ok {
 		return &ValidationError{Name: "upload_datetime", err: errors.New(`ent: missing required field "Pokemon.upload_datetime"`)}
 	}
@@ -138,9 +470,6 @@ func (_c *PokemonCreate) check() error {
 	if _, ok := _c.mutation.Legal(); !ok {
 		return &ValidationError{Name: "legal", err: errors.New(`ent: missing required field "Pokemon.legal"`)}
 	}
-	if _, ok := _c.mutation.Base64(); !ok {
-		return &ValidationError{Name: "base_64", err: errors.New(`ent: missing required field "Pokemon.base_64"`)}
-	}
 	return nil
 }
 
@@ -167,6 +496,18 @@ func (_c *PokemonCreate) createSpec() (*Pokemon, *sqlgraph.CreateSpec) {
 		_node = &Pokemon{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(pokemon.Table, sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt))
 	)
+	if value, ok := _c.mutation.Visibility(); ok {
+		_spec.SetField(pokemon.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
+	if value, ok := _c.mutation.VisibilityChangedAt(); ok {
+		_spec.SetField(pokemon.FieldVisibilityChangedAt, field.TypeTime, value)
+		_node.VisibilityChangedAt = &value
+	}
+	if value, ok := _c.mutation.VisibilityReason(); ok {
+		_spec.SetField(pokemon.FieldVisibilityReason, field.TypeString, value)
+		_node.VisibilityReason = &value
+	}
 	if value, ok := _c.mutation.UploadDatetime(); ok {
 		_spec.SetField(pokemon.FieldUploadDatetime, field.TypeTime, value)
 		_node.UploadDatetime = value
@@ -187,19 +528,124 @@ func (_c *PokemonCreate) createSpec() (*Pokemon, *sqlgraph.CreateSpec) {
 		_spec.SetField(pokemon.FieldLegal, field.TypeBool, value)
 		_node.Legal = value
 	}
+	if value, ok := _c.mutation.Data(); ok {
+		_spec.SetField(pokemon.FieldData, field.TypeBytes, value)
+		_node.Data = value
+	}
 	if value, ok := _c.mutation.Base64(); ok {
 		_spec.SetField(pokemon.FieldBase64, field.TypeString, value)
 		_node.Base64 = value
 	}
-	if nodes := _c.mutation.BundlesIDs(); len(nodes) > 0 {
+	if value, ok := _c.mutation.ContentHash(); ok {
+		_spec.SetField(pokemon.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = &value
+	}
+	if value, ok := _c.mutation.LegalityReport(); ok {
+		_spec.SetField(pokemon.FieldLegalityReport, field.TypeJSON, value)
+		_node.LegalityReport = value
+	}
+	if value, ok := _c.mutation.EngineVersion(); ok {
+		_spec.SetField(pokemon.FieldEngineVersion, field.TypeString, value)
+		_node.EngineVersion = value
+	}
+	if value, ok := _c.mutation.LegalityCheckedAt(); ok {
+		_spec.SetField(pokemon.FieldLegalityCheckedAt, field.TypeTime, value)
+		_node.LegalityCheckedAt = &value
+	}
+	if value, ok := _c.mutation.Species(); ok {
+		_spec.SetField(pokemon.FieldSpecies, field.TypeInt, value)
+		_node.Species = &value
+	}
+	if value, ok := _c.mutation.Form(); ok {
+		_spec.SetField(pokemon.FieldForm, field.TypeInt, value)
+		_node.Form = &value
+	}
+	if value, ok := _c.mutation.Level(); ok {
+		_spec.SetField(pokemon.FieldLevel, field.TypeInt, value)
+		_node.Level = &value
+	}
+	if value, ok := _c.mutation.Shiny(); ok {
+		_spec.SetField(pokemon.FieldShiny, field.TypeBool, value)
+		_node.Shiny = &value
+	}
+	if value, ok := _c.mutation.Nature(); ok {
+		_spec.SetField(pokemon.FieldNature, field.TypeInt, value)
+		_node.Nature = &value
+	}
+	if value, ok := _c.mutation.Gender(); ok {
+		_spec.SetField(pokemon.FieldGender, field.TypeInt, value)
+		_node.Gender = &value
+	}
+	if value, ok := _c.mutation.OtName(); ok {
+		_spec.SetField(pokemon.FieldOtName, field.TypeString, value)
+		_node.OtName = &value
+	}
+	if value, ok := _c.mutation.Tid(); ok {
+		_spec.SetField(pokemon.FieldTid, field.TypeInt, value)
+		_node.Tid = &value
+	}
+	if value, ok := _c.mutation.Sid(); ok {
+		_spec.SetField(pokemon.FieldSid, field.TypeInt, value)
+		_node.Sid = &value
+	}
+	if value, ok := _c.mutation.Ball(); ok {
+		_spec.SetField(pokemon.FieldBall, field.TypeInt, value)
+		_node.Ball = &value
+	}
+	if value, ok := _c.mutation.HeldItem(); ok {
+		_spec.SetField(pokemon.FieldHeldItem, field.TypeInt, value)
+		_node.HeldItem = &value
+	}
+	if value, ok := _c.mutation.Language(); ok {
+		_spec.SetField(pokemon.FieldLanguage, field.TypeInt, value)
+		_node.Language = &value
+	}
+	if value, ok := _c.mutation.OriginGame(); ok {
+		_spec.SetField(pokemon.FieldOriginGame, field.TypeInt, value)
+		_node.OriginGame = &value
+	}
+	if nodes := _c.mutation.BundleSlotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pokemon.BundleSlotsTable,
+			Columns: []string{pokemon.BundleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bundleslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MergedIntoIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   pokemon.BundlesTable,
-			Columns: pokemon.BundlesPrimaryKey,
+			Table:   pokemon.MergedIntoTable,
+			Columns: []string{pokemon.MergedIntoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MergedIntoID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DuplicatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pokemon.DuplicatesTable,
+			Columns: []string{pokemon.DuplicatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(bundle.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
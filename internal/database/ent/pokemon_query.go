@@ -12,7 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
-	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/predicate"
 )
@@ -20,11 +20,13 @@ import (
 // PokemonQuery is the builder for querying Pokemon entities.
 type PokemonQuery struct {
 	config
-	ctx         *QueryContext
-	order       []pokemon.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Pokemon
-	withBundles *BundleQuery
+	ctx             *QueryContext
+	order           []pokemon.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Pokemon
+	withBundleSlots *BundleSlotQuery
+	withMergedInto  *PokemonQuery
+	withDuplicates  *PokemonQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -61,9 +63,9 @@ func (_q *PokemonQuery) Order(o ...pokemon.OrderOption) *PokemonQuery {
 	return _q
 }
 
-// QueryBundles chains the current query on the "bundles" edge.
-func (_q *PokemonQuery) QueryBundles() *BundleQuery {
-	query := (&BundleClient{config: _q.config}).Query()
+// QueryBundleSlots chains the current query on the "bundle_slots" edge.
+func (_q *PokemonQuery) QueryBundleSlots() *BundleSlotQuery {
+	query := (&BundleSlotClient{config: _q.config}).Query()
 	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
 		if err := _q.prepareQuery(ctx); err != nil {
 			return nil, err
@@ -74,8 +76,52 @@ func (_q *PokemonQuery) QueryBundles() *BundleQuery {
 		}
 		step := sqlgraph.NewStep(
 			sqlgraph.From(pokemon.Table, pokemon.FieldID, selector),
-			sqlgraph.To(bundle.Table, bundle.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, pokemon.BundlesTable, pokemon.BundlesPrimaryKey...),
+			sqlgraph.To(bundleslot.Table, bundleslot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, pokemon.BundleSlotsTable, pokemon.BundleSlotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMergedInto chains the current query on the "merged_into" edge.
+func (_q *PokemonQuery) QueryMergedInto() *PokemonQuery {
+	query := (&PokemonClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pokemon.Table, pokemon.FieldID, selector),
+			sqlgraph.To(pokemon.Table, pokemon.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pokemon.MergedIntoTable, pokemon.MergedIntoColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDuplicates chains the current query on the "duplicates" edge.
+func (_q *PokemonQuery) QueryDuplicates() *PokemonQuery {
+	query := (&PokemonClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pokemon.Table, pokemon.FieldID, selector),
+			sqlgraph.To(pokemon.Table, pokemon.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, pokemon.DuplicatesTable, pokemon.DuplicatesColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
@@ -270,26 +316,50 @@ func (_q *PokemonQuery) Clone() *PokemonQuery {
 		return nil
 	}
 	return &PokemonQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]pokemon.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.Pokemon{}, _q.predicates...),
-		withBundles: _q.withBundles.Clone(),
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]pokemon.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.Pokemon{}, _q.predicates...),
+		withBundleSlots: _q.withBundleSlots.Clone(),
+		withMergedInto:  _q.withMergedInto.Clone(),
+		withDuplicates:  _q.withDuplicates.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
 	}
 }
 
-// WithBundles tells the query-builder to eager-load the nodes that are connected to
-// the "bundles" edge. The optional arguments are used to configure the query builder of the edge.
-func (_q *PokemonQuery) WithBundles(opts ...func(*BundleQuery)) *PokemonQuery {
-	query := (&BundleClient{config: _q.config}).Query()
+// WithBundleSlots tells the query-builder to eager-load the nodes that are connected to
+// the "bundle_slots" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PokemonQuery) WithBundleSlots(opts ...func(*BundleSlotQuery)) *PokemonQuery {
+	query := (&BundleSlotClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBundleSlots = query
+	return _q
+}
+
+// WithMergedInto tells the query-builder to eager-load the nodes that are connected to
+// the "merged_into" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PokemonQuery) WithMergedInto(opts ...func(*PokemonQuery)) *PokemonQuery {
+	query := (&PokemonClient{config: _q.config}).Query()
 	for _, opt := range opts {
 		opt(query)
 	}
-	_q.withBundles = query
+	_q.withMergedInto = query
+	return _q
+}
+
+// WithDuplicates tells the query-builder to eager-load the nodes that are connected to
+// the "duplicates" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PokemonQuery) WithDuplicates(opts ...func(*PokemonQuery)) *PokemonQuery {
+	query := (&PokemonClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDuplicates = query
 	return _q
 }
 
@@ -299,12 +369,12 @@ func (_q *PokemonQuery) WithBundles(opts ...func(*BundleQuery)) *PokemonQuery {
 // Example:
 //
 //	var v []struct {
-//		UploadDatetime time.Time `json:"upload_datetime,omitempty"`
+//		Visibility pokemon.Visibility `json:"visibility,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Pokemon.Query().
-//		GroupBy(pokemon.FieldUploadDatetime).
+//		GroupBy(pokemon.FieldVisibility).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *PokemonQuery) GroupBy(field string, fields ...string) *PokemonGroupBy {
@@ -322,11 +392,11 @@ func (_q *PokemonQuery) GroupBy(field string, fields ...string) *PokemonGroupBy
 // Example:
 //
 //	var v []struct {
-//		UploadDatetime time.Time `json:"upload_datetime,omitempty"`
+//		Visibility pokemon.Visibility `json:"visibility,omitempty"`
 //	}
 //
 //	client.Pokemon.Query().
-//		Select(pokemon.FieldUploadDatetime).
+//		Select(pokemon.FieldVisibility).
 //		Scan(ctx, &v)
 func (_q *PokemonQuery) Select(fields ...string) *PokemonSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -371,8 +441,10 @@ func (_q *PokemonQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Poke
 	var (
 		nodes       = []*Pokemon{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
-			_q.withBundles != nil,
+		loadedTypes = [3]bool{
+			_q.withBundleSlots != nil,
+			_q.withMergedInto != nil,
+			_q.withDuplicates != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -393,74 +465,121 @@ func (_q *PokemonQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Poke
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
-	if query := _q.withBundles; query != nil {
-		if err := _q.loadBundles(ctx, query, nodes,
-			func(n *Pokemon) { n.Edges.Bundles = []*Bundle{} },
-			func(n *Pokemon, e *Bundle) { n.Edges.Bundles = append(n.Edges.Bundles, e) }); err != nil {
+	if query := _q.withBundleSlots; query != nil {
+		if err := _q.loadBundleSlots(ctx, query, nodes,
+			func(n *Pokemon) { n.Edges.BundleSlots = []*BundleSlot{} },
+			func(n *Pokemon, e *BundleSlot) { n.Edges.BundleSlots = append(n.Edges.BundleSlots, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMergedInto; query != nil {
+		if err := _q.loadMergedInto(ctx, query, nodes, nil,
+			func(n *Pokemon, e *Pokemon) { n.Edges.MergedInto = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDuplicates; query != nil {
+		if err := _q.loadDuplicates(ctx, query, nodes,
+			func(n *Pokemon) { n.Edges.Duplicates = []*Pokemon{} },
+			func(n *Pokemon, e *Pokemon) { n.Edges.Duplicates = append(n.Edges.Duplicates, e) }); err != nil {
 			return nil, err
 		}
 	}
 	return nodes, nil
 }
 
-func (_q *PokemonQuery) loadBundles(ctx context.Context, query *BundleQuery, nodes []*Pokemon, init func(*Pokemon), assign func(*Pokemon, *Bundle)) error {
-	edgeIDs := make([]driver.Value, len(nodes))
-	byID := make(map[int]*Pokemon)
-	nids := make(map[int]map[*Pokemon]struct{})
-	for i, node := range nodes {
-		edgeIDs[i] = node.ID
-		byID[node.ID] = node
+func (_q *PokemonQuery) loadBundleSlots(ctx context.Context, query *BundleSlotQuery, nodes []*Pokemon, init func(*Pokemon), assign func(*Pokemon, *BundleSlot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Pokemon)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
 		if init != nil {
-			init(node)
+			init(nodes[i])
 		}
 	}
-	query.Where(func(s *sql.Selector) {
-		joinT := sql.Table(pokemon.BundlesTable)
-		s.Join(joinT).On(s.C(bundle.FieldID), joinT.C(pokemon.BundlesPrimaryKey[0]))
-		s.Where(sql.InValues(joinT.C(pokemon.BundlesPrimaryKey[1]), edgeIDs...))
-		columns := s.SelectedColumns()
-		s.Select(joinT.C(pokemon.BundlesPrimaryKey[1]))
-		s.AppendSelect(columns...)
-		s.SetDistinct(false)
-	})
-	if err := query.prepareQuery(ctx); err != nil {
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(bundleslot.FieldPokemonID)
+	}
+	query.Where(predicate.BundleSlot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(pokemon.BundleSlotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
 		return err
 	}
-	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
-		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
-			assign := spec.Assign
-			values := spec.ScanValues
-			spec.ScanValues = func(columns []string) ([]any, error) {
-				values, err := values(columns[1:])
-				if err != nil {
-					return nil, err
-				}
-				return append([]any{new(sql.NullInt64)}, values...), nil
-			}
-			spec.Assign = func(columns []string, values []any) error {
-				outValue := int(values[0].(*sql.NullInt64).Int64)
-				inValue := int(values[1].(*sql.NullInt64).Int64)
-				if nids[inValue] == nil {
-					nids[inValue] = map[*Pokemon]struct{}{byID[outValue]: {}}
-					return assign(columns[1:], values[1:])
-				}
-				nids[inValue][byID[outValue]] = struct{}{}
-				return nil
-			}
-		})
-	})
-	neighbors, err := withInterceptors[[]*Bundle](ctx, query, qr, query.inters)
+	for _, n := range neighbors {
+		fk := n.PokemonID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "pokemon_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *PokemonQuery) loadMergedInto(ctx context.Context, query *PokemonQuery, nodes []*Pokemon, init func(*Pokemon), assign func(*Pokemon, *Pokemon)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Pokemon)
+	for i := range nodes {
+		if nodes[i].MergedIntoID == nil {
+			continue
+		}
+		fk := *nodes[i].MergedIntoID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(pokemon.IDIn(ids...))
+	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
 	}
 	for _, n := range neighbors {
-		nodes, ok := nids[n.ID]
+		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected "bundles" node returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "merged_into_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *PokemonQuery) loadDuplicates(ctx context.Context, query *PokemonQuery, nodes []*Pokemon, init func(*Pokemon), assign func(*Pokemon, *Pokemon)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Pokemon)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(pokemon.FieldMergedIntoID)
+	}
+	query.Where(predicate.Pokemon(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(pokemon.DuplicatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MergedIntoID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "merged_into_id" is nil for node %v`, n.ID)
 		}
-		for kn := range nodes {
-			assign(kn, n)
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "merged_into_id" returned %v for node %v`, *fk, n.ID)
 		}
+		assign(node, n)
 	}
 	return nil
 }
@@ -490,6 +609,9 @@ func (_q *PokemonQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withMergedInto != nil {
+			_spec.Node.AddColumnOnce(pokemon.FieldMergedIntoID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -10,8 +10,9 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
-	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/predicate"
 )
@@ -29,6 +30,60 @@ func (_u *PokemonUpdate) Where(ps ...predicate.Pokemon) *PokemonUpdate {
 	return _u
 }
 
+// SetVisibility sets the "visibility" field.
+func (_u *PokemonUpdate) SetVisibility(v pokemon.Visibility) *PokemonUpdate {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *PokemonUpdate) SetNillableVisibility(v *pokemon.Visibility) *PokemonUpdate {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetVisibilityChangedAt sets the "visibility_changed_at" field.
+func (_u *PokemonUpdate) SetVisibilityChangedAt(v time.Time) *PokemonUpdate {
+	_u.mutation.SetVisibilityChangedAt(v)
+	return _u
+}
+
+// SetNillableVisibilityChangedAt sets the "visibility_changed_at" field if the given value is not nil.
+func (_u *PokemonUpdate) SetNillableVisibilityChangedAt(v *time.Time) *PokemonUpdate {
+	if v != nil {
+		_u.SetVisibilityChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearVisibilityChangedAt clears the value of the "visibility_changed_at" field.
+func (_u *PokemonUpdate) ClearVisibilityChangedAt() *PokemonUpdate {
+	_u.mutation.ClearVisibilityChangedAt()
+	return _u
+}
+
+// SetVisibilityReason sets the "visibility_reason" field.
+func (_u *PokemonUpdate) SetVisibilityReason(v string) *PokemonUpdate {
+	_u.mutation.SetVisibilityReason(v)
+	return _u
+}
+
+// SetNillableVisibilityReason sets the "visibility_reason" field if the given value is not nil.
+func (_u *PokemonUpdate) SetNillableVisibilityReason(v *string) *PokemonUpdate {
+	if v != nil {
+		_u.SetVisibilityReason(*v)
+	}
+	return _u
+}
+
+// ClearVisibilityReason clears the value of the "visibility_reason" field.
+func (_u *PokemonUpdate) ClearVisibilityReason() *PokemonUpdate {
+	_u.mutation.ClearVisibilityReason()
+	return _u
+}
+
 // SetUploadDatetime sets the "upload_datetime" field.
 func (_u *PokemonUpdate) SetUploadDatetime(v time.Time) *PokemonUpdate {
 	_u.mutation.SetUploadDatetime(v)
@@ -106,6 +161,18 @@ func (_u *PokemonUpdate) SetNillableLegal(v *bool) *PokemonUpdate {
 	return _u
 }
 
+// SetData sets the "data" field.
+func (_u *PokemonUpdate) SetData(v []byte) *PokemonUpdate {
+	_u.mutation.SetData(v)
+	return _u
+}
+
+// ClearData clears the value of the "data" field.
+func (_u *PokemonUpdate) ClearData() *PokemonUpdate {
+	_u.mutation.ClearData()
+	return _u
+}
+
 // SetBase64 sets the "base_64" field.
 func (_u *PokemonUpdate) SetBase64(v string) *PokemonUpdate {
 	_u.mutation.SetBase64(v)
@@ -120,349 +187,1655 @@ func (_u *PokemonUpdate) SetNillableBase64(v *string) *PokemonUpdate {
 	return _u
 }
 
-// AddBundleIDs adds the "bundles" edge to the Bundle entity by IDs.
-func (_u *PokemonUpdate) AddBundleIDs(ids ...int) *PokemonUpdate {
-	_u.mutation.AddBundleIDs(ids...)
+// ClearBase64 clears the value of the "base_64" field.
+func (_u *PokemonUpdate) ClearBase64() *PokemonUpdate {
+	_u.mutation.ClearBase64()
 	return _u
 }
 
-// AddBundles adds the "bundles" edges to the Bundle entity.
-func (_u *PokemonUpdate) AddBundles(v ...*Bundle) *PokemonUpdate {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetContentHash sets the "content_hash" field.
+func (_u *PokemonUpdate) SetContentHash(v string) *PokemonUpdate {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *PokemonUpdate) SetNillableContentHash(v *string) *PokemonUpdate {
+	if v != nil {
+		_u.SetContentHash(*v)
 	}
-	return _u.AddBundleIDs(ids...)
+	return _u
 }
 
-// Mutation returns the PokemonMutation object of the builder.
-func (_u *PokemonUpdate) Mutation() *PokemonMutation {
-	return _u.mutation
+// ClearContentHash clears the value of the "content_hash" field.
+func (_u *PokemonUpdate) ClearContentHash() *PokemonUpdate {
+	_u.mutation.ClearContentHash()
+	return _u
 }
 
-// ClearBundles clears all "bundles" edges to the Bundle entity.
-func (_u *PokemonUpdate) ClearBundles() *PokemonUpdate {
-	_u.mutation.ClearBundles()
+// SetLegalityReport sets the "legality_report" field.
+func (_u *PokemonUpdate) SetLegalityReport(v []string) *PokemonUpdate {
+	_u.mutation.SetLegalityReport(v)
 	return _u
 }
 
-// RemoveBundleIDs removes the "bundles" edge to Bundle entities by IDs.
-func (_u *PokemonUpdate) RemoveBundleIDs(ids ...int) *PokemonUpdate {
-	_u.mutation.RemoveBundleIDs(ids...)
+// AppendLegalityReport appends value to the "legality_report" field.
+func (_u *PokemonUpdate) AppendLegalityReport(v []string) *PokemonUpdate {
+	_u.mutation.AppendLegalityReport(v)
 	return _u
 }
 
-// RemoveBundles removes "bundles" edges to Bundle entities.
-func (_u *PokemonUpdate) RemoveBundles(v ...*Bundle) *PokemonUpdate {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
-	}
-	return _u.RemoveBundleIDs(ids...)
+// ClearLegalityReport clears the value of the "legality_report" field.
+func (_u *PokemonUpdate) ClearLegalityReport() *PokemonUpdate {
+	_u.mutation.ClearLegalityReport()
+	return _u
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *PokemonUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// SetEngineVersion sets the "engine_version" field.
+func (_u *PokemonUpdate) SetEngineVersion(v string) *PokemonUpdate {
+	_u.mutation.SetEngineVersion(v)
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *PokemonUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// SetNillableEngineVersion sets the "engine_version" field if the given value is not nil.
+func (_u *PokemonUpdate) SetNillableEngineVersion(v *string) *PokemonUpdate {
+	if v != nil {
+		_u.SetEngineVersion(*v)
 	}
-	return affected
+	return _u
 }
 
-// Exec executes the query.
-func (_u *PokemonUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// ClearEngineVersion clears the value of the "engine_version" field.
+func (_u *PokemonUpdate) ClearEngineVersion() *PokemonUpdate {
+	_u.mutation.ClearEngineVersion()
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *PokemonUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
-	}
+// SetLegalityCheckedAt sets the "legality_checked_at" field.
+func (_u *PokemonUpdate) SetLegalityCheckedAt(v time.Time) *PokemonUpdate {
+	_u.mutation.SetLegalityCheckedAt(v)
+	return _u
 }
 
-func (_u *PokemonUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	_spec := sqlgraph.NewUpdateSpec(pokemon.Table, pokemon.Columns, sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt))
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
-	}
-	if value, ok := _u.mutation.UploadDatetime(); ok {
-		_spec.SetField(pokemon.FieldUploadDatetime, field.TypeTime, value)
-	}
-	if value, ok := _u.mutation.DownloadCode(); ok {
-		_spec.SetField(pokemon.FieldDownloadCode, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.DownloadCount(); ok {
-		_spec.SetField(pokemon.FieldDownloadCount, field.TypeInt, value)
-	}
-	if value, ok := _u.mutation.AddedDownloadCount(); ok {
-		_spec.AddField(pokemon.FieldDownloadCount, field.TypeInt, value)
-	}
-	if value, ok := _u.mutation.Generation(); ok {
-		_spec.SetField(pokemon.FieldGeneration, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.Legal(); ok {
-		_spec.SetField(pokemon.FieldLegal, field.TypeBool, value)
-	}
-	if value, ok := _u.mutation.Base64(); ok {
-		_spec.SetField(pokemon.FieldBase64, field.TypeString, value)
-	}
-	if _u.mutation.BundlesCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   pokemon.BundlesTable,
-			Columns: pokemon.BundlesPrimaryKey,
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(bundle.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.RemovedBundlesIDs(); len(nodes) > 0 && !_u.mutation.BundlesCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   pokemon.BundlesTable,
-			Columns: pokemon.BundlesPrimaryKey,
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(bundle.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
-	}
-	if nodes := _u.mutation.BundlesIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   pokemon.BundlesTable,
-			Columns: pokemon.BundlesPrimaryKey,
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(bundle.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
-	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{pokemon.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+// SetNillableLegalityCheckedAt sets the "legality_checked_at" field if the given value is not nil.
+func (_u *PokemonUpdate) SetNillableLegalityCheckedAt(v *time.Time) *PokemonUpdate {
+	if v != nil {
+		_u.SetLegalityCheckedAt(*v)
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u
 }
 
-// PokemonUpdateOne is the builder for updating a single Pokemon entity.
-type PokemonUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *PokemonMutation
+// ClearLegalityCheckedAt clears the value of the "legality_checked_at" field.
+func (_u *PokemonUpdate) ClearLegalityCheckedAt() *PokemonUpdate {
+	_u.mutation.ClearLegalityCheckedAt()
+	return _u
 }
 
-// SetUploadDatetime sets the "upload_datetime" field.
-func (_u *PokemonUpdateOne) SetUploadDatetime(v time.Time) *PokemonUpdateOne {
-	_u.mutation.SetUploadDatetime(v)
+// SetSpecies sets the "species" field.
+func (_u *PokemonUpdate) SetSpecies(v int) *PokemonUpdate {
+	_u.mutation.ResetSpecies()
+	_u.mutation.SetSpecies(v)
 	return _u
 }
 
-// SetNillableUploadDatetime sets the "upload_datetime" field if the given value is not nil.
-func (_u *PokemonUpdateOne) SetNillableUploadDatetime(v *time.Time) *PokemonUpdateOne {
+// SetNillableSpecies sets the "species" field if the given value is not nil.
+func (_u *PokemonUpdate) SetNillableSpecies(v *int) *PokemonUpdate {
 	if v != nil {
-		_u.SetUploadDatetime(*v)
+		_u.SetSpecies(*v)
 	}
 	return _u
 }
 
-// SetDownloadCode sets the "download_code" field.
-func (_u *PokemonUpdateOne) SetDownloadCode(v string) *PokemonUpdateOne {
-	_u.mutation.SetDownloadCode(v)
+// AddSpecies adds value to the "species" field.
+func (_u *PokemonUpdate) AddSpecies(v int) *PokemonUpdate {
+	_u.mutation.AddSpecies(v)
 	return _u
 }
 
-// SetNillableDownloadCode sets the "download_code" field if the given value is not nil.
-func (_u *PokemonUpdateOne) SetNillableDownloadCode(v *string) *PokemonUpdateOne {
-	if v != nil {
-		_u.SetDownloadCode(*v)
-	}
+// ClearSpecies clears the value of the "species" field.
+func (_u *PokemonUpdate) ClearSpecies() *PokemonUpdate {
+	_u.mutation.ClearSpecies()
 	return _u
 }
 
-// SetDownloadCount sets the "download_count" field.
-func (_u *PokemonUpdateOne) SetDownloadCount(v int) *PokemonUpdateOne {
-	_u.mutation.ResetDownloadCount()
-	_u.mutation.SetDownloadCount(v)
+// SetForm sets the "form" field.
+func (_u *PokemonUpdate) SetForm(v int) *PokemonUpdate {
+	_u.mutation.ResetForm()
+	_u.mutation.SetForm(v)
 	return _u
 }
 
-// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
-func (_u *PokemonUpdateOne) SetNillableDownloadCount(v *int) *PokemonUpdateOne {
+// SetNillableForm sets the "form" field if the given value is not nil.
+func (_u *PokemonUpdate) SetNillableForm(v *int) *PokemonUpdate {
 	if v != nil {
-		_u.SetDownloadCount(*v)
+		_u.SetForm(*v)
 	}
 	return _u
 }
 
-// AddDownloadCount adds value to the "download_count" field.
-func (_u *PokemonUpdateOne) AddDownloadCount(v int) *PokemonUpdateOne {
-	_u.mutation.AddDownloadCount(v)
+// AddForm adds value to the "form" field.
+func (_u *PokemonUpdate) AddForm(v int) *PokemonUpdate {
+	_u.mutation.AddForm(v)
 	return _u
 }
 
-// SetGeneration sets the "generation" field.
-func (_u *PokemonUpdateOne) SetGeneration(v string) *PokemonUpdateOne {
-	_u.mutation.SetGeneration(v)
+// ClearForm clears the value of the "form" field.
+func (_u *PokemonUpdate) ClearForm() *PokemonUpdate {
+	_u.mutation.ClearForm()
 	return _u
 }
 
-// SetNillableGeneration sets the "generation" field if the given value is not nil.
-func (_u *PokemonUpdateOne) SetNillableGeneration(v *string) *PokemonUpdateOne {
+// SetLevel sets the "level" field.
+func (_u *PokemonUpdate) SetLevel(v int) *PokemonUpdate {
+	_u.mutation.ResetLevel()
+	_u.mutation.SetLevel(v)
+	return _u
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_u *PokemonUpdate) SetNillableLevel(v *int) *PokemonUpdate {
 	if v != nil {
-		_u.SetGeneration(*v)
+		_u.SetLevel(*v)
 	}
 	return _u
 }
 
-// SetLegal sets the "legal" field.
-func (_u *PokemonUpdateOne) SetLegal(v bool) *PokemonUpdateOne {
-	_u.mutation.SetLegal(v)
+// AddLevel adds value to the "level" field.
+func (_u *PokemonUpdate) AddLevel(v int) *PokemonUpdate {
+	_u.mutation.AddLevel(v)
 	return _u
 }
 
-// SetNillableLegal sets the "legal" field if the given value is not nil.
-func (_u *PokemonUpdateOne) SetNillableLegal(v *bool) *PokemonUpdateOne {
-	if v != nil {
-		_u.SetLegal(*v)
-	}
+// ClearLevel clears the value of the "level" field.
+func (_u *PokemonUpdate) ClearLevel() *PokemonUpdate {
+	_u.mutation.ClearLevel()
 	return _u
 }
 
-// SetBase64 sets the "base_64" field.
-func (_u *PokemonUpdateOne) SetBase64(v string) *PokemonUpdateOne {
-	_u.mutation.SetBase64(v)
+// SetShiny sets the "shiny" field.
+func (_u *PokemonUpdate) SetShiny(v bool) *PokemonUpdate {
+	_u.mutation.SetShiny(v)
 	return _u
 }
 
-// SetNillableBase64 sets the "base_64" field if the given value is not nil.
-func (_u *PokemonUpdateOne) SetNillableBase64(v *string) *PokemonUpdateOne {
+// SetNillableShiny sets the "shiny" field if the given value is not nil.
+func (_u *PokemonUpdate) SetNillableShiny(v *bool) *PokemonUpdate {
 	if v != nil {
-		_u.SetBase64(*v)
+		_u.SetShiny(*v)
 	}
 	return _u
 }
 
-// AddBundleIDs adds the "bundles" edge to the Bundle entity by IDs.
-func (_u *PokemonUpdateOne) AddBundleIDs(ids ...int) *PokemonUpdateOne {
-	_u.mutation.AddBundleIDs(ids...)
+// ClearShiny clears the value of the "shiny" field.
+func (_u *PokemonUpdate) ClearShiny() *PokemonUpdate {
+	_u.mutation.ClearShiny()
 	return _u
 }
 
-// AddBundles adds the "bundles" edges to the Bundle entity.
-func (_u *PokemonUpdateOne) AddBundles(v ...*Bundle) *PokemonUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNature sets the "nature" field.
+func (_u *PokemonUpdate) SetNature(v int) *PokemonUpdate {
+	_u.mutation.ResetNature()
+	_u.mutation.SetNature(v)
+	return _u
+}
+
+// SetNillableNature sets the "nature" field if the given value is not nil.
+func (_u *PokemonUpdate) SetNillableNature(v *int) *PokemonUpdate {
+	if v != nil {
+		_u.SetNature(*v)
 	}
-	return _u.AddBundleIDs(ids...)
+	return _u
 }
 
-// Mutation returns the PokemonMutation object of the builder.
-func (_u *PokemonUpdateOne) Mutation() *PokemonMutation {
-	return _u.mutation
+// AddNature adds value to the "nature" field.
+func (_u *PokemonUpdate) AddNature(v int) *PokemonUpdate {
+	_u.mutation.AddNature(v)
+	return _u
 }
 
-// ClearBundles clears all "bundles" edges to the Bundle entity.
-func (_u *PokemonUpdateOne) ClearBundles() *PokemonUpdateOne {
-	_u.mutation.ClearBundles()
+// ClearNature clears the value of the "nature" field.
+func (_u *PokemonUpdate) ClearNature() *PokemonUpdate {
+	_u.mutation.ClearNature()
 	return _u
 }
 
-// RemoveBundleIDs removes the "bundles" edge to Bundle entities by IDs.
-func (_u *PokemonUpdateOne) RemoveBundleIDs(ids ...int) *PokemonUpdateOne {
-	_u.mutation.RemoveBundleIDs(ids...)
+// SetGender sets the "gender" field.
+func (_u *PokemonUpdate) SetGender(v int) *PokemonUpdate {
+	_u.mutation.ResetGender()
+	_u.mutation.SetGender(v)
 	return _u
 }
 
-// RemoveBundles removes "bundles" edges to Bundle entities.
-func (_u *PokemonUpdateOne) RemoveBundles(v ...*Bundle) *PokemonUpdateOne {
-	ids := make([]int, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+// SetNillableGender sets the "gender" field if the given value is not nil.
+func (_u *PokemonUpdate) SetNillableGender(v *int) *PokemonUpdate {
+	if v != nil {
+		_u.SetGender(*v)
 	}
-	return _u.RemoveBundleIDs(ids...)
+	return _u
 }
 
-// Where appends a list predicates to the PokemonUpdate builder.
-func (_u *PokemonUpdateOne) Where(ps ...predicate.Pokemon) *PokemonUpdateOne {
-	_u.mutation.Where(ps...)
+// AddGender adds value to the "gender" field.
+func (_u *PokemonUpdate) AddGender(v int) *PokemonUpdate {
+	_u.mutation.AddGender(v)
 	return _u
 }
 
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (_u *PokemonUpdateOne) Select(field string, fields ...string) *PokemonUpdateOne {
-	_u.fields = append([]string{field}, fields...)
+// ClearGender clears the value of the "gender" field.
+func (_u *PokemonUpdate) ClearGender() *PokemonUpdate {
+	_u.mutation.ClearGender()
 	return _u
 }
 
-// Save executes the query and returns the updated Pokemon entity.
-func (_u *PokemonUpdateOne) Save(ctx context.Context) (*Pokemon, error) {
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// SetOtName sets the "ot_name" field.
+func (_u *PokemonUpdate) SetOtName(v string) *PokemonUpdate {
+	_u.mutation.SetOtName(v)
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *PokemonUpdateOne) SaveX(ctx context.Context) *Pokemon {
-	node, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// SetNillableOtName sets the "ot_name" field if the given value is not nil.
+func (_u *PokemonUpdate) SetNillableOtName(v *string) *PokemonUpdate {
+	if v != nil {
+		_u.SetOtName(*v)
 	}
-	return node
+	return _u
 }
 
-// Exec executes the query on the entity.
-func (_u *PokemonUpdateOne) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// ClearOtName clears the value of the "ot_name" field.
+func (_u *PokemonUpdate) ClearOtName() *PokemonUpdate {
+	_u.mutation.ClearOtName()
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *PokemonUpdateOne) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
-	}
+// SetTid sets the "tid" field.
+func (_u *PokemonUpdate) SetTid(v int) *PokemonUpdate {
+	_u.mutation.ResetTid()
+	_u.mutation.SetTid(v)
+	return _u
 }
 
-func (_u *PokemonUpdateOne) sqlSave(ctx context.Context) (_node *Pokemon, err error) {
-	_spec := sqlgraph.NewUpdateSpec(pokemon.Table, pokemon.Columns, sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt))
-	id, ok := _u.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Pokemon.id" for update`)}
+// SetNillableTid sets the "tid" field if the given value is not nil.
+func (_u *PokemonUpdate) SetNillableTid(v *int) *PokemonUpdate {
+	if v != nil {
+		_u.SetTid(*v)
 	}
-	_spec.Node.ID.Value = id
-	if fields := _u.fields; len(fields) > 0 {
+	return _u
+}
+
+// AddTid adds value to the "tid" field.
+func (_u *PokemonUpdate) AddTid(v int) *PokemonUpdate {
+	_u.mutation.AddTid(v)
+	return _u
+}
+
+// ClearTid clears the value of the "tid" field.
+func (_u *PokemonUpdate) ClearTid() *PokemonUpdate {
+	_u.mutation.ClearTid()
+	return _u
+}
+
+// SetSid sets the "sid" field.
+func (_u *PokemonUpdate) SetSid(v int) *PokemonUpdate {
+	_u.mutation.ResetSid()
+	_u.mutation.SetSid(v)
+	return _u
+}
+
+// SetNillableSid sets the "sid" field if the given value is not nil.
+func (_u *PokemonUpdate) SetNillableSid(v *int) *PokemonUpdate {
+	if v != nil {
+		_u.SetSid(*v)
+	}
+	return _u
+}
+
+// AddSid adds value to the "sid" field.
+func (_u *PokemonUpdate) AddSid(v int) *PokemonUpdate {
+	_u.mutation.AddSid(v)
+	return _u
+}
+
+// ClearSid clears the value of the "sid" field.
+func (_u *PokemonUpdate) ClearSid() *PokemonUpdate {
+	_u.mutation.ClearSid()
+	return _u
+}
+
+// SetBall sets the "ball" field.
+func (_u *PokemonUpdate) SetBall(v int) *PokemonUpdate {
+	_u.mutation.ResetBall()
+	_u.mutation.SetBall(v)
+	return _u
+}
+
+// SetNillableBall sets the "ball" field if the given value is not nil.
+func (_u *PokemonUpdate) SetNillableBall(v *int) *PokemonUpdate {
+	if v != nil {
+		_u.SetBall(*v)
+	}
+	return _u
+}
+
+// AddBall adds value to the "ball" field.
+func (_u *PokemonUpdate) AddBall(v int) *PokemonUpdate {
+	_u.mutation.AddBall(v)
+	return _u
+}
+
+// ClearBall clears the value of the "ball" field.
+func (_u *PokemonUpdate) ClearBall() *PokemonUpdate {
+	_u.mutation.ClearBall()
+	return _u
+}
+
+// SetHeldItem sets the "held_item" field.
+func (_u *PokemonUpdate) SetHeldItem(v int) *PokemonUpdate {
+	_u.mutation.ResetHeldItem()
+	_u.mutation.SetHeldItem(v)
+	return _u
+}
+
+// SetNillableHeldItem sets the "held_item" field if the given value is not nil.
+func (_u *PokemonUpdate) SetNillableHeldItem(v *int) *PokemonUpdate {
+	if v != nil {
+		_u.SetHeldItem(*v)
+	}
+	return _u
+}
+
+// AddHeldItem adds value to the "held_item" field.
+func (_u *PokemonUpdate) AddHeldItem(v int) *PokemonUpdate {
+	_u.mutation.AddHeldItem(v)
+	return _u
+}
+
+// ClearHeldItem clears the value of the "held_item" field.
+func (_u *PokemonUpdate) ClearHeldItem() *PokemonUpdate {
+	_u.mutation.ClearHeldItem()
+	return _u
+}
+
+// SetLanguage sets the "language" field.
+func (_u *PokemonUpdate) SetLanguage(v int) *PokemonUpdate {
+	_u.mutation.ResetLanguage()
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *PokemonUpdate) SetNillableLanguage(v *int) *PokemonUpdate {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// AddLanguage adds value to the "language" field.
+func (_u *PokemonUpdate) AddLanguage(v int) *PokemonUpdate {
+	_u.mutation.AddLanguage(v)
+	return _u
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (_u *PokemonUpdate) ClearLanguage() *PokemonUpdate {
+	_u.mutation.ClearLanguage()
+	return _u
+}
+
+// SetOriginGame sets the "origin_game" field.
+func (_u *PokemonUpdate) SetOriginGame(v int) *PokemonUpdate {
+	_u.mutation.ResetOriginGame()
+	_u.mutation.SetOriginGame(v)
+	return _u
+}
+
+// SetNillableOriginGame sets the "origin_game" field if the given value is not nil.
+func (_u *PokemonUpdate) SetNillableOriginGame(v *int) *PokemonUpdate {
+	if v != nil {
+		_u.SetOriginGame(*v)
+	}
+	return _u
+}
+
+// AddOriginGame adds value to the "origin_game" field.
+func (_u *PokemonUpdate) AddOriginGame(v int) *PokemonUpdate {
+	_u.mutation.AddOriginGame(v)
+	return _u
+}
+
+// ClearOriginGame clears the value of the "origin_game" field.
+func (_u *PokemonUpdate) ClearOriginGame() *PokemonUpdate {
+	_u.mutation.ClearOriginGame()
+	return _u
+}
+
+// SetMergedIntoID sets the "merged_into_id" field.
+func (_u *PokemonUpdate) SetMergedIntoID(v int) *PokemonUpdate {
+	_u.mutation.SetMergedIntoID(v)
+	return _u
+}
+
+// SetNillableMergedIntoID sets the "merged_into_id" field if the given value is not nil.
+func (_u *PokemonUpdate) SetNillableMergedIntoID(v *int) *PokemonUpdate {
+	if v != nil {
+		_u.SetMergedIntoID(*v)
+	}
+	return _u
+}
+
+// ClearMergedIntoID clears the value of the "merged_into_id" field.
+func (_u *PokemonUpdate) ClearMergedIntoID() *PokemonUpdate {
+	_u.mutation.ClearMergedIntoID()
+	return _u
+}
+
+// AddBundleSlotIDs adds the "bundle_slots" edge to the BundleSlot entity by IDs.
+func (_u *PokemonUpdate) AddBundleSlotIDs(ids ...int) *PokemonUpdate {
+	_u.mutation.AddBundleSlotIDs(ids...)
+	return _u
+}
+
+// AddBundleSlots adds the "bundle_slots" edges to the BundleSlot entity.
+func (_u *PokemonUpdate) AddBundleSlots(v ...*BundleSlot) *PokemonUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBundleSlotIDs(ids...)
+}
+
+// SetMergedInto sets the "merged_into" edge to the Pokemon entity.
+func (_u *PokemonUpdate) SetMergedInto(v *Pokemon) *PokemonUpdate {
+	return _u.SetMergedIntoID(v.ID)
+}
+
+// AddDuplicateIDs adds the "duplicates" edge to the Pokemon entity by IDs.
+func (_u *PokemonUpdate) AddDuplicateIDs(ids ...int) *PokemonUpdate {
+	_u.mutation.AddDuplicateIDs(ids...)
+	return _u
+}
+
+// AddDuplicates adds the "duplicates" edges to the Pokemon entity.
+func (_u *PokemonUpdate) AddDuplicates(v ...*Pokemon) *PokemonUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDuplicateIDs(ids...)
+}
+
+// Mutation returns the PokemonMutation object of the builder.
+func (_u *PokemonUpdate) Mutation() *PokemonMutation {
+	return _u.mutation
+}
+
+// ClearBundleSlots clears all "bundle_slots" edges to the BundleSlot entity.
+func (_u *PokemonUpdate) ClearBundleSlots() *PokemonUpdate {
+	_u.mutation.ClearBundleSlots()
+	return _u
+}
+
+// RemoveBundleSlotIDs removes the "bundle_slots" edge to BundleSlot entities by IDs.
+func (_u *PokemonUpdate) RemoveBundleSlotIDs(ids ...int) *PokemonUpdate {
+	_u.mutation.RemoveBundleSlotIDs(ids...)
+	return _u
+}
+
+// RemoveBundleSlots removes "bundle_slots" edges to BundleSlot entities.
+func (_u *PokemonUpdate) RemoveBundleSlots(v ...*BundleSlot) *PokemonUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBundleSlotIDs(ids...)
+}
+
+// ClearMergedInto clears the "merged_into" edge to the Pokemon entity.
+func (_u *PokemonUpdate) ClearMergedInto() *PokemonUpdate {
+	_u.mutation.ClearMergedInto()
+	return _u
+}
+
+// ClearDuplicates clears all "duplicates" edges to the Pokemon entity.
+func (_u *PokemonUpdate) ClearDuplicates() *PokemonUpdate {
+	_u.mutation.ClearDuplicates()
+	return _u
+}
+
+// RemoveDuplicateIDs removes the "duplicates" edge to Pokemon entities by IDs.
+func (_u *PokemonUpdate) RemoveDuplicateIDs(ids ...int) *PokemonUpdate {
+	_u.mutation.RemoveDuplicateIDs(ids...)
+	return _u
+}
+
+// RemoveDuplicates removes "duplicates" edges to Pokemon entities.
+func (_u *PokemonUpdate) RemoveDuplicates(v ...*Pokemon) *PokemonUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDuplicateIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PokemonUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PokemonUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PokemonUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PokemonUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PokemonUpdate) check() error {
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := pokemon.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Pokemon.visibility": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PokemonUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pokemon.Table, pokemon.Columns, sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(pokemon.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.VisibilityChangedAt(); ok {
+		_spec.SetField(pokemon.FieldVisibilityChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VisibilityChangedAtCleared() {
+		_spec.ClearField(pokemon.FieldVisibilityChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VisibilityReason(); ok {
+		_spec.SetField(pokemon.FieldVisibilityReason, field.TypeString, value)
+	}
+	if _u.mutation.VisibilityReasonCleared() {
+		_spec.ClearField(pokemon.FieldVisibilityReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.UploadDatetime(); ok {
+		_spec.SetField(pokemon.FieldUploadDatetime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DownloadCode(); ok {
+		_spec.SetField(pokemon.FieldDownloadCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DownloadCount(); ok {
+		_spec.SetField(pokemon.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDownloadCount(); ok {
+		_spec.AddField(pokemon.FieldDownloadCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Generation(); ok {
+		_spec.SetField(pokemon.FieldGeneration, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Legal(); ok {
+		_spec.SetField(pokemon.FieldLegal, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Data(); ok {
+		_spec.SetField(pokemon.FieldData, field.TypeBytes, value)
+	}
+	if _u.mutation.DataCleared() {
+		_spec.ClearField(pokemon.FieldData, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.Base64(); ok {
+		_spec.SetField(pokemon.FieldBase64, field.TypeString, value)
+	}
+	if _u.mutation.Base64Cleared() {
+		_spec.ClearField(pokemon.FieldBase64, field.TypeString)
+	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(pokemon.FieldContentHash, field.TypeString, value)
+	}
+	if _u.mutation.ContentHashCleared() {
+		_spec.ClearField(pokemon.FieldContentHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.LegalityReport(); ok {
+		_spec.SetField(pokemon.FieldLegalityReport, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLegalityReport(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pokemon.FieldLegalityReport, value)
+		})
+	}
+	if _u.mutation.LegalityReportCleared() {
+		_spec.ClearField(pokemon.FieldLegalityReport, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.EngineVersion(); ok {
+		_spec.SetField(pokemon.FieldEngineVersion, field.TypeString, value)
+	}
+	if _u.mutation.EngineVersionCleared() {
+		_spec.ClearField(pokemon.FieldEngineVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.LegalityCheckedAt(); ok {
+		_spec.SetField(pokemon.FieldLegalityCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LegalityCheckedAtCleared() {
+		_spec.ClearField(pokemon.FieldLegalityCheckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Species(); ok {
+		_spec.SetField(pokemon.FieldSpecies, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSpecies(); ok {
+		_spec.AddField(pokemon.FieldSpecies, field.TypeInt, value)
+	}
+	if _u.mutation.SpeciesCleared() {
+		_spec.ClearField(pokemon.FieldSpecies, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Form(); ok {
+		_spec.SetField(pokemon.FieldForm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedForm(); ok {
+		_spec.AddField(pokemon.FieldForm, field.TypeInt, value)
+	}
+	if _u.mutation.FormCleared() {
+		_spec.ClearField(pokemon.FieldForm, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Level(); ok {
+		_spec.SetField(pokemon.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLevel(); ok {
+		_spec.AddField(pokemon.FieldLevel, field.TypeInt, value)
+	}
+	if _u.mutation.LevelCleared() {
+		_spec.ClearField(pokemon.FieldLevel, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Shiny(); ok {
+		_spec.SetField(pokemon.FieldShiny, field.TypeBool, value)
+	}
+	if _u.mutation.ShinyCleared() {
+		_spec.ClearField(pokemon.FieldShiny, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Nature(); ok {
+		_spec.SetField(pokemon.FieldNature, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNature(); ok {
+		_spec.AddField(pokemon.FieldNature, field.TypeInt, value)
+	}
+	if _u.mutation.NatureCleared() {
+		_spec.ClearField(pokemon.FieldNature, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Gender(); ok {
+		_spec.SetField(pokemon.FieldGender, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGender(); ok {
+		_spec.AddField(pokemon.FieldGender, field.TypeInt, value)
+	}
+	if _u.mutation.GenderCleared() {
+		_spec.ClearField(pokemon.FieldGender, field.TypeInt)
+	}
+	if value, ok := _u.mutation.OtName(); ok {
+		_spec.SetField(pokemon.FieldOtName, field.TypeString, value)
+	}
+	if _u.mutation.OtNameCleared() {
+		_spec.ClearField(pokemon.FieldOtName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Tid(); ok {
+		_spec.SetField(pokemon.FieldTid, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTid(); ok {
+		_spec.AddField(pokemon.FieldTid, field.TypeInt, value)
+	}
+	if _u.mutation.TidCleared() {
+		_spec.ClearField(pokemon.FieldTid, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Sid(); ok {
+		_spec.SetField(pokemon.FieldSid, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSid(); ok {
+		_spec.AddField(pokemon.FieldSid, field.TypeInt, value)
+	}
+	if _u.mutation.SidCleared() {
+		_spec.ClearField(pokemon.FieldSid, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Ball(); ok {
+		_spec.SetField(pokemon.FieldBall, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBall(); ok {
+		_spec.AddField(pokemon.FieldBall, field.TypeInt, value)
+	}
+	if _u.mutation.BallCleared() {
+		_spec.ClearField(pokemon.FieldBall, field.TypeInt)
+	}
+	if value, ok := _u.mutation.HeldItem(); ok {
+		_spec.SetField(pokemon.FieldHeldItem, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHeldItem(); ok {
+		_spec.AddField(pokemon.FieldHeldItem, field.TypeInt, value)
+	}
+	if _u.mutation.HeldItemCleared() {
+		_spec.ClearField(pokemon.FieldHeldItem, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(pokemon.FieldLanguage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLanguage(); ok {
+		_spec.AddField(pokemon.FieldLanguage, field.TypeInt, value)
+	}
+	if _u.mutation.LanguageCleared() {
+		_spec.ClearField(pokemon.FieldLanguage, field.TypeInt)
+	}
+	if value, ok := _u.mutation.OriginGame(); ok {
+		_spec.SetField(pokemon.FieldOriginGame, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOriginGame(); ok {
+		_spec.AddField(pokemon.FieldOriginGame, field.TypeInt, value)
+	}
+	if _u.mutation.OriginGameCleared() {
+		_spec.ClearField(pokemon.FieldOriginGame, field.TypeInt)
+	}
+	if _u.mutation.BundleSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pokemon.BundleSlotsTable,
+			Columns: []string{pokemon.BundleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bundleslot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBundleSlotsIDs(); len(nodes) > 0 && !_u.mutation.BundleSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pokemon.BundleSlotsTable,
+			Columns: []string{pokemon.BundleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bundleslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BundleSlotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pokemon.BundleSlotsTable,
+			Columns: []string{pokemon.BundleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bundleslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MergedIntoCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pokemon.MergedIntoTable,
+			Columns: []string{pokemon.MergedIntoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MergedIntoIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pokemon.MergedIntoTable,
+			Columns: []string{pokemon.MergedIntoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DuplicatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pokemon.DuplicatesTable,
+			Columns: []string{pokemon.DuplicatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDuplicatesIDs(); len(nodes) > 0 && !_u.mutation.DuplicatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pokemon.DuplicatesTable,
+			Columns: []string{pokemon.DuplicatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DuplicatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pokemon.DuplicatesTable,
+			Columns: []string{pokemon.DuplicatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pokemon.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PokemonUpdateOne is the builder for updating a single Pokemon entity.
+type PokemonUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PokemonMutation
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *PokemonUpdateOne) SetVisibility(v pokemon.Visibility) *PokemonUpdateOne {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableVisibility(v *pokemon.Visibility) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetVisibilityChangedAt sets the "visibility_changed_at" field.
+func (_u *PokemonUpdateOne) SetVisibilityChangedAt(v time.Time) *PokemonUpdateOne {
+	_u.mutation.SetVisibilityChangedAt(v)
+	return _u
+}
+
+// SetNillableVisibilityChangedAt sets the "visibility_changed_at" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableVisibilityChangedAt(v *time.Time) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetVisibilityChangedAt(*v)
+	}
+	return _u
+}
+
+// ClearVisibilityChangedAt clears the value of the "visibility_changed_at" field.
+func (_u *PokemonUpdateOne) ClearVisibilityChangedAt() *PokemonUpdateOne {
+	_u.mutation.ClearVisibilityChangedAt()
+	return _u
+}
+
+// SetVisibilityReason sets the "visibility_reason" field.
+func (_u *PokemonUpdateOne) SetVisibilityReason(v string) *PokemonUpdateOne {
+	_u.mutation.SetVisibilityReason(v)
+	return _u
+}
+
+// SetNillableVisibilityReason sets the "visibility_reason" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableVisibilityReason(v *string) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetVisibilityReason(*v)
+	}
+	return _u
+}
+
+// ClearVisibilityReason clears the value of the "visibility_reason" field.
+func (_u *PokemonUpdateOne) ClearVisibilityReason() *PokemonUpdateOne {
+	_u.mutation.ClearVisibilityReason()
+	return _u
+}
+
+// SetUploadDatetime sets the "upload_datetime" field.
+func (_u *PokemonUpdateOne) SetUploadDatetime(v time.Time) *PokemonUpdateOne {
+	_u.mutation.SetUploadDatetime(v)
+	return _u
+}
+
+// SetNillableUploadDatetime sets the "upload_datetime" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableUploadDatetime(v *time.Time) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetUploadDatetime(*v)
+	}
+	return _u
+}
+
+// SetDownloadCode sets the "download_code" field.
+func (_u *PokemonUpdateOne) SetDownloadCode(v string) *PokemonUpdateOne {
+	_u.mutation.SetDownloadCode(v)
+	return _u
+}
+
+// SetNillableDownloadCode sets the "download_code" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableDownloadCode(v *string) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetDownloadCode(*v)
+	}
+	return _u
+}
+
+// SetDownloadCount sets the "download_count" field.
+func (_u *PokemonUpdateOne) SetDownloadCount(v int) *PokemonUpdateOne {
+	_u.mutation.ResetDownloadCount()
+	_u.mutation.SetDownloadCount(v)
+	return _u
+}
+
+// SetNillableDownloadCount sets the "download_count" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableDownloadCount(v *int) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetDownloadCount(*v)
+	}
+	return _u
+}
+
+// AddDownloadCount adds value to the "download_count" field.
+func (_u *PokemonUpdateOne) AddDownloadCount(v int) *PokemonUpdateOne {
+	_u.mutation.AddDownloadCount(v)
+	return _u
+}
+
+// SetGeneration sets the "generation" field.
+func (_u *PokemonUpdateOne) SetGeneration(v string) *PokemonUpdateOne {
+	_u.mutation.SetGeneration(v)
+	return _u
+}
+
+// SetNillableGeneration sets the "generation" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableGeneration(v *string) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetGeneration(*v)
+	}
+	return _u
+}
+
+// SetLegal sets the "legal" field.
+func (_u *PokemonUpdateOne) SetLegal(v bool) *PokemonUpdateOne {
+	_u.mutation.SetLegal(v)
+	return _u
+}
+
+// SetNillableLegal sets the "legal" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableLegal(v *bool) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetLegal(*v)
+	}
+	return _u
+}
+
+// SetData sets the "data" field.
+func (_u *PokemonUpdateOne) SetData(v []byte) *PokemonUpdateOne {
+	_u.mutation.SetData(v)
+	return _u
+}
+
+// ClearData clears the value of the "data" field.
+func (_u *PokemonUpdateOne) ClearData() *PokemonUpdateOne {
+	_u.mutation.ClearData()
+	return _u
+}
+
+// SetBase64 sets the "base_64" field.
+func (_u *PokemonUpdateOne) SetBase64(v string) *PokemonUpdateOne {
+	_u.mutation.SetBase64(v)
+	return _u
+}
+
+// SetNillableBase64 sets the "base_64" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableBase64(v *string) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetBase64(*v)
+	}
+	return _u
+}
+
+// ClearBase64 clears the value of the "base_64" field.
+func (_u *PokemonUpdateOne) ClearBase64() *PokemonUpdateOne {
+	_u.mutation.ClearBase64()
+	return _u
+}
+
+// SetContentHash sets the "content_hash" field.
+func (_u *PokemonUpdateOne) SetContentHash(v string) *PokemonUpdateOne {
+	_u.mutation.SetContentHash(v)
+	return _u
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableContentHash(v *string) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetContentHash(*v)
+	}
+	return _u
+}
+
+// ClearContentHash clears the value of the "content_hash" field.
+func (_u *PokemonUpdateOne) ClearContentHash() *PokemonUpdateOne {
+	_u.mutation.ClearContentHash()
+	return _u
+}
+
+// SetLegalityReport sets the "legality_report" field.
+func (_u *PokemonUpdateOne) SetLegalityReport(v []string) *PokemonUpdateOne {
+	_u.mutation.SetLegalityReport(v)
+	return _u
+}
+
+// AppendLegalityReport appends value to the "legality_report" field.
+func (_u *PokemonUpdateOne) AppendLegalityReport(v []string) *PokemonUpdateOne {
+	_u.mutation.AppendLegalityReport(v)
+	return _u
+}
+
+// ClearLegalityReport clears the value of the "legality_report" field.
+func (_u *PokemonUpdateOne) ClearLegalityReport() *PokemonUpdateOne {
+	_u.mutation.ClearLegalityReport()
+	return _u
+}
+
+// SetEngineVersion sets the "engine_version" field.
+func (_u *PokemonUpdateOne) SetEngineVersion(v string) *PokemonUpdateOne {
+	_u.mutation.SetEngineVersion(v)
+	return _u
+}
+
+// SetNillableEngineVersion sets the "engine_version" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableEngineVersion(v *string) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetEngineVersion(*v)
+	}
+	return _u
+}
+
+// ClearEngineVersion clears the value of the "engine_version" field.
+func (_u *PokemonUpdateOne) ClearEngineVersion() *PokemonUpdateOne {
+	_u.mutation.ClearEngineVersion()
+	return _u
+}
+
+// SetLegalityCheckedAt sets the "legality_checked_at" field.
+func (_u *PokemonUpdateOne) SetLegalityCheckedAt(v time.Time) *PokemonUpdateOne {
+	_u.mutation.SetLegalityCheckedAt(v)
+	return _u
+}
+
+// SetNillableLegalityCheckedAt sets the "legality_checked_at" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableLegalityCheckedAt(v *time.Time) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetLegalityCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearLegalityCheckedAt clears the value of the "legality_checked_at" field.
+func (_u *PokemonUpdateOne) ClearLegalityCheckedAt() *PokemonUpdateOne {
+	_u.mutation.ClearLegalityCheckedAt()
+	return _u
+}
+
+// SetSpecies sets the "species" field.
+func (_u *PokemonUpdateOne) SetSpecies(v int) *PokemonUpdateOne {
+	_u.mutation.ResetSpecies()
+	_u.mutation.SetSpecies(v)
+	return _u
+}
+
+// SetNillableSpecies sets the "species" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableSpecies(v *int) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetSpecies(*v)
+	}
+	return _u
+}
+
+// AddSpecies adds value to the "species" field.
+func (_u *PokemonUpdateOne) AddSpecies(v int) *PokemonUpdateOne {
+	_u.mutation.AddSpecies(v)
+	return _u
+}
+
+// ClearSpecies clears the value of the "species" field.
+func (_u *PokemonUpdateOne) ClearSpecies() *PokemonUpdateOne {
+	_u.mutation.ClearSpecies()
+	return _u
+}
+
+// SetForm sets the "form" field.
+func (_u *PokemonUpdateOne) SetForm(v int) *PokemonUpdateOne {
+	_u.mutation.ResetForm()
+	_u.mutation.SetForm(v)
+	return _u
+}
+
+// SetNillableForm sets the "form" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableForm(v *int) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetForm(*v)
+	}
+	return _u
+}
+
+// AddForm adds value to the "form" field.
+func (_u *PokemonUpdateOne) AddForm(v int) *PokemonUpdateOne {
+	_u.mutation.AddForm(v)
+	return _u
+}
+
+// ClearForm clears the value of the "form" field.
+func (_u *PokemonUpdateOne) ClearForm() *PokemonUpdateOne {
+	_u.mutation.ClearForm()
+	return _u
+}
+
+// SetLevel sets the "level" field.
+func (_u *PokemonUpdateOne) SetLevel(v int) *PokemonUpdateOne {
+	_u.mutation.ResetLevel()
+	_u.mutation.SetLevel(v)
+	return _u
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableLevel(v *int) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetLevel(*v)
+	}
+	return _u
+}
+
+// AddLevel adds value to the "level" field.
+func (_u *PokemonUpdateOne) AddLevel(v int) *PokemonUpdateOne {
+	_u.mutation.AddLevel(v)
+	return _u
+}
+
+// ClearLevel clears the value of the "level" field.
+func (_u *PokemonUpdateOne) ClearLevel() *PokemonUpdateOne {
+	_u.mutation.ClearLevel()
+	return _u
+}
+
+// SetShiny sets the "shiny" field.
+func (_u *PokemonUpdateOne) SetShiny(v bool) *PokemonUpdateOne {
+	_u.mutation.SetShiny(v)
+	return _u
+}
+
+// SetNillableShiny sets the "shiny" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableShiny(v *bool) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetShiny(*v)
+	}
+	return _u
+}
+
+// ClearShiny clears the value of the "shiny" field.
+func (_u *PokemonUpdateOne) ClearShiny() *PokemonUpdateOne {
+	_u.mutation.ClearShiny()
+	return _u
+}
+
+// SetNature sets the "nature" field.
+func (_u *PokemonUpdateOne) SetNature(v int) *PokemonUpdateOne {
+	_u.mutation.ResetNature()
+	_u.mutation.SetNature(v)
+	return _u
+}
+
+// SetNillableNature sets the "nature" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableNature(v *int) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetNature(*v)
+	}
+	return _u
+}
+
+// AddNature adds value to the "nature" field.
+func (_u *PokemonUpdateOne) AddNature(v int) *PokemonUpdateOne {
+	_u.mutation.AddNature(v)
+	return _u
+}
+
+// ClearNature clears the value of the "nature" field.
+func (_u *PokemonUpdateOne) ClearNature() *PokemonUpdateOne {
+	_u.mutation.ClearNature()
+	return _u
+}
+
+// SetGender sets the "gender" field.
+func (_u *PokemonUpdateOne) SetGender(v int) *PokemonUpdateOne {
+	_u.mutation.ResetGender()
+	_u.mutation.SetGender(v)
+	return _u
+}
+
+// SetNillableGender sets the "gender" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableGender(v *int) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetGender(*v)
+	}
+	return _u
+}
+
+// AddGender adds value to the "gender" field.
+func (_u *PokemonUpdateOne) AddGender(v int) *PokemonUpdateOne {
+	_u.mutation.AddGender(v)
+	return _u
+}
+
+// ClearGender clears the value of the "gender" field.
+func (_u *PokemonUpdateOne) ClearGender() *PokemonUpdateOne {
+	_u.mutation.ClearGender()
+	return _u
+}
+
+// SetOtName sets the "ot_name" field.
+func (_u *PokemonUpdateOne) SetOtName(v string) *PokemonUpdateOne {
+	_u.mutation.SetOtName(v)
+	return _u
+}
+
+// SetNillableOtName sets the "ot_name" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableOtName(v *string) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetOtName(*v)
+	}
+	return _u
+}
+
+// ClearOtName clears the value of the "ot_name" field.
+func (_u *PokemonUpdateOne) ClearOtName() *PokemonUpdateOne {
+	_u.mutation.ClearOtName()
+	return _u
+}
+
+// SetTid sets the "tid" field.
+func (_u *PokemonUpdateOne) SetTid(v int) *PokemonUpdateOne {
+	_u.mutation.ResetTid()
+	_u.mutation.SetTid(v)
+	return _u
+}
+
+// SetNillableTid sets the "tid" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableTid(v *int) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetTid(*v)
+	}
+	return _u
+}
+
+// AddTid adds value to the "tid" field.
+func (_u *PokemonUpdateOne) AddTid(v int) *PokemonUpdateOne {
+	_u.mutation.AddTid(v)
+	return _u
+}
+
+// ClearTid clears the value of the "tid" field.
+func (_u *PokemonUpdateOne) ClearTid() *PokemonUpdateOne {
+	_u.mutation.ClearTid()
+	return _u
+}
+
+// SetSid sets the "sid" field.
+func (_u *PokemonUpdateOne) SetSid(v int) *PokemonUpdateOne {
+	_u.mutation.ResetSid()
+	_u.mutation.SetSid(v)
+	return _u
+}
+
+// SetNillableSid sets the "sid" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableSid(v *int) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetSid(*v)
+	}
+	return _u
+}
+
+// AddSid adds value to the "sid" field.
+func (_u *PokemonUpdateOne) AddSid(v int) *PokemonUpdateOne {
+	_u.mutation.AddSid(v)
+	return _u
+}
+
+// ClearSid clears the value of the "sid" field.
+func (_u *PokemonUpdateOne) ClearSid() *PokemonUpdateOne {
+	_u.mutation.ClearSid()
+	return _u
+}
+
+// SetBall sets the "ball" field.
+func (_u *PokemonUpdateOne) SetBall(v int) *PokemonUpdateOne {
+	_u.mutation.ResetBall()
+	_u.mutation.SetBall(v)
+	return _u
+}
+
+// SetNillableBall sets the "ball" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableBall(v *int) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetBall(*v)
+	}
+	return _u
+}
+
+// AddBall adds value to the "ball" field.
+func (_u *PokemonUpdateOne) AddBall(v int) *PokemonUpdateOne {
+	_u.mutation.AddBall(v)
+	return _u
+}
+
+// ClearBall clears the value of the "ball" field.
+func (_u *PokemonUpdateOne) ClearBall() *PokemonUpdateOne {
+	_u.mutation.ClearBall()
+	return _u
+}
+
+// SetHeldItem sets the "held_item" field.
+func (_u *PokemonUpdateOne) SetHeldItem(v int) *PokemonUpdateOne {
+	_u.mutation.ResetHeldItem()
+	_u.mutation.SetHeldItem(v)
+	return _u
+}
+
+// SetNillableHeldItem sets the "held_item" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableHeldItem(v *int) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetHeldItem(*v)
+	}
+	return _u
+}
+
+// AddHeldItem adds value to the "held_item" field.
+func (_u *PokemonUpdateOne) AddHeldItem(v int) *PokemonUpdateOne {
+	_u.mutation.AddHeldItem(v)
+	return _u
+}
+
+// ClearHeldItem clears the value of the "held_item" field.
+func (_u *PokemonUpdateOne) ClearHeldItem() *PokemonUpdateOne {
+	_u.mutation.ClearHeldItem()
+	return _u
+}
+
+// SetLanguage sets the "language" field.
+func (_u *PokemonUpdateOne) SetLanguage(v int) *PokemonUpdateOne {
+	_u.mutation.ResetLanguage()
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableLanguage(v *int) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// AddLanguage adds value to the "language" field.
+func (_u *PokemonUpdateOne) AddLanguage(v int) *PokemonUpdateOne {
+	_u.mutation.AddLanguage(v)
+	return _u
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (_u *PokemonUpdateOne) ClearLanguage() *PokemonUpdateOne {
+	_u.mutation.ClearLanguage()
+	return _u
+}
+
+// SetOriginGame sets the "origin_game" field.
+func (_u *PokemonUpdateOne) SetOriginGame(v int) *PokemonUpdateOne {
+	_u.mutation.ResetOriginGame()
+	_u.mutation.SetOriginGame(v)
+	return _u
+}
+
+// SetNillableOriginGame sets the "origin_game" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableOriginGame(v *int) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetOriginGame(*v)
+	}
+	return _u
+}
+
+// AddOriginGame adds value to the "origin_game" field.
+func (_u *PokemonUpdateOne) AddOriginGame(v int) *PokemonUpdateOne {
+	_u.mutation.AddOriginGame(v)
+	return _u
+}
+
+// ClearOriginGame clears the value of the "origin_game" field.
+func (_u *PokemonUpdateOne) ClearOriginGame() *PokemonUpdateOne {
+	_u.mutation.ClearOriginGame()
+	return _u
+}
+
+// SetMergedIntoID sets the "merged_into_id" field.
+func (_u *PokemonUpdateOne) SetMergedIntoID(v int) *PokemonUpdateOne {
+	_u.mutation.SetMergedIntoID(v)
+	return _u
+}
+
+// SetNillableMergedIntoID sets the "merged_into_id" field if the given value is not nil.
+func (_u *PokemonUpdateOne) SetNillableMergedIntoID(v *int) *PokemonUpdateOne {
+	if v != nil {
+		_u.SetMergedIntoID(*v)
+	}
+	return _u
+}
+
+// ClearMergedIntoID clears the value of the "merged_into_id" field.
+func (_u *PokemonUpdateOne) ClearMergedIntoID() *PokemonUpdateOne {
+	_u.mutation.ClearMergedIntoID()
+	return _u
+}
+
+// AddBundleSlotIDs adds the "bundle_slots" edge to the BundleSlot entity by IDs.
+func (_u *PokemonUpdateOne) AddBundleSlotIDs(ids ...int) *PokemonUpdateOne {
+	_u.mutation.AddBundleSlotIDs(ids...)
+	return _u
+}
+
+// AddBundleSlots adds the "bundle_slots" edges to the BundleSlot entity.
+func (_u *PokemonUpdateOne) AddBundleSlots(v ...*BundleSlot) *PokemonUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBundleSlotIDs(ids...)
+}
+
+// SetMergedInto sets the "merged_into" edge to the Pokemon entity.
+func (_u *PokemonUpdateOne) SetMergedInto(v *Pokemon) *PokemonUpdateOne {
+	return _u.SetMergedIntoID(v.ID)
+}
+
+// AddDuplicateIDs adds the "duplicates" edge to the Pokemon entity by IDs.
+func (_u *PokemonUpdateOne) AddDuplicateIDs(ids ...int) *PokemonUpdateOne {
+	_u.mutation.AddDuplicateIDs(ids...)
+	return _u
+}
+
+// AddDuplicates adds the "duplicates" edges to the Pokemon entity.
+func (_u *PokemonUpdateOne) AddDuplicates(v ...*Pokemon) *PokemonUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDuplicateIDs(ids...)
+}
+
+// Mutation returns the PokemonMutation object of the builder.
+func (_u *PokemonUpdateOne) Mutation() *PokemonMutation {
+	return _u.mutation
+}
+
+// ClearBundleSlots clears all "bundle_slots" edges to the BundleSlot entity.
+func (_u *PokemonUpdateOne) ClearBundleSlots() *PokemonUpdateOne {
+	_u.mutation.ClearBundleSlots()
+	return _u
+}
+
+// RemoveBundleSlotIDs removes the "bundle_slots" edge to BundleSlot entities by IDs.
+func (_u *PokemonUpdateOne) RemoveBundleSlotIDs(ids ...int) *PokemonUpdateOne {
+	_u.mutation.RemoveBundleSlotIDs(ids...)
+	return _u
+}
+
+// RemoveBundleSlots removes "bundle_slots" edges to BundleSlot entities.
+func (_u *PokemonUpdateOne) RemoveBundleSlots(v ...*BundleSlot) *PokemonUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBundleSlotIDs(ids...)
+}
+
+// ClearMergedInto clears the "merged_into" edge to the Pokemon entity.
+func (_u *PokemonUpdateOne) ClearMergedInto() *PokemonUpdateOne {
+	_u.mutation.ClearMergedInto()
+	return _u
+}
+
+// ClearDuplicates clears all "duplicates" edges to the Pokemon entity.
+func (_u *PokemonUpdateOne) ClearDuplicates() *PokemonUpdateOne {
+	_u.mutation.ClearDuplicates()
+	return _u
+}
+
+// RemoveDuplicateIDs removes the "duplicates" edge to Pokemon entities by IDs.
+func (_u *PokemonUpdateOne) RemoveDuplicateIDs(ids ...int) *PokemonUpdateOne {
+	_u.mutation.RemoveDuplicateIDs(ids...)
+	return _u
+}
+
+// RemoveDuplicates removes "duplicates" edges to Pokemon entities.
+func (_u *PokemonUpdateOne) RemoveDuplicates(v ...*Pokemon) *PokemonUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDuplicateIDs(ids...)
+}
+
+// Where appends a list predicates to the PokemonUpdate builder.
+func (_u *PokemonUpdateOne) Where(ps ...predicate.Pokemon) *PokemonUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PokemonUpdateOne) Select(field string, fields ...string) *PokemonUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Pokemon entity.
+func (_u *PokemonUpdateOne) Save(ctx context.Context) (*Pokemon, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PokemonUpdateOne) SaveX(ctx context.Context) *Pokemon {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PokemonUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PokemonUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PokemonUpdateOne) check() error {
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := pokemon.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Pokemon.visibility": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PokemonUpdateOne) sqlSave(ctx context.Context) (_node *Pokemon, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pokemon.Table, pokemon.Columns, sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Pokemon.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
 		_spec.Node.Columns = make([]string, 0, len(fields))
 		_spec.Node.Columns = append(_spec.Node.Columns, pokemon.FieldID)
 		for _, f := range fields {
@@ -481,6 +1854,21 @@ func (_u *PokemonUpdateOne) sqlSave(ctx context.Context) (_node *Pokemon, err er
 			}
 		}
 	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(pokemon.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.VisibilityChangedAt(); ok {
+		_spec.SetField(pokemon.FieldVisibilityChangedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VisibilityChangedAtCleared() {
+		_spec.ClearField(pokemon.FieldVisibilityChangedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.VisibilityReason(); ok {
+		_spec.SetField(pokemon.FieldVisibilityReason, field.TypeString, value)
+	}
+	if _u.mutation.VisibilityReasonCleared() {
+		_spec.ClearField(pokemon.FieldVisibilityReason, field.TypeString)
+	}
 	if value, ok := _u.mutation.UploadDatetime(); ok {
 		_spec.SetField(pokemon.FieldUploadDatetime, field.TypeTime, value)
 	}
@@ -499,31 +1887,254 @@ func (_u *PokemonUpdateOne) sqlSave(ctx context.Context) (_node *Pokemon, err er
 	if value, ok := _u.mutation.Legal(); ok {
 		_spec.SetField(pokemon.FieldLegal, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Data(); ok {
+		_spec.SetField(pokemon.FieldData, field.TypeBytes, value)
+	}
+	if _u.mutation.DataCleared() {
+		_spec.ClearField(pokemon.FieldData, field.TypeBytes)
+	}
 	if value, ok := _u.mutation.Base64(); ok {
 		_spec.SetField(pokemon.FieldBase64, field.TypeString, value)
 	}
-	if _u.mutation.BundlesCleared() {
+	if _u.mutation.Base64Cleared() {
+		_spec.ClearField(pokemon.FieldBase64, field.TypeString)
+	}
+	if value, ok := _u.mutation.ContentHash(); ok {
+		_spec.SetField(pokemon.FieldContentHash, field.TypeString, value)
+	}
+	if _u.mutation.ContentHashCleared() {
+		_spec.ClearField(pokemon.FieldContentHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.LegalityReport(); ok {
+		_spec.SetField(pokemon.FieldLegalityReport, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLegalityReport(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pokemon.FieldLegalityReport, value)
+		})
+	}
+	if _u.mutation.LegalityReportCleared() {
+		_spec.ClearField(pokemon.FieldLegalityReport, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.EngineVersion(); ok {
+		_spec.SetField(pokemon.FieldEngineVersion, field.TypeString, value)
+	}
+	if _u.mutation.EngineVersionCleared() {
+		_spec.ClearField(pokemon.FieldEngineVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.LegalityCheckedAt(); ok {
+		_spec.SetField(pokemon.FieldLegalityCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LegalityCheckedAtCleared() {
+		_spec.ClearField(pokemon.FieldLegalityCheckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Species(); ok {
+		_spec.SetField(pokemon.FieldSpecies, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSpecies(); ok {
+		_spec.AddField(pokemon.FieldSpecies, field.TypeInt, value)
+	}
+	if _u.mutation.SpeciesCleared() {
+		_spec.ClearField(pokemon.FieldSpecies, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Form(); ok {
+		_spec.SetField(pokemon.FieldForm, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedForm(); ok {
+		_spec.AddField(pokemon.FieldForm, field.TypeInt, value)
+	}
+	if _u.mutation.FormCleared() {
+		_spec.ClearField(pokemon.FieldForm, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Level(); ok {
+		_spec.SetField(pokemon.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLevel(); ok {
+		_spec.AddField(pokemon.FieldLevel, field.TypeInt, value)
+	}
+	if _u.mutation.LevelCleared() {
+		_spec.ClearField(pokemon.FieldLevel, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Shiny(); ok {
+		_spec.SetField(pokemon.FieldShiny, field.TypeBool, value)
+	}
+	if _u.mutation.ShinyCleared() {
+		_spec.ClearField(pokemon.FieldShiny, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Nature(); ok {
+		_spec.SetField(pokemon.FieldNature, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNature(); ok {
+		_spec.AddField(pokemon.FieldNature, field.TypeInt, value)
+	}
+	if _u.mutation.NatureCleared() {
+		_spec.ClearField(pokemon.FieldNature, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Gender(); ok {
+		_spec.SetField(pokemon.FieldGender, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGender(); ok {
+		_spec.AddField(pokemon.FieldGender, field.TypeInt, value)
+	}
+	if _u.mutation.GenderCleared() {
+		_spec.ClearField(pokemon.FieldGender, field.TypeInt)
+	}
+	if value, ok := _u.mutation.OtName(); ok {
+		_spec.SetField(pokemon.FieldOtName, field.TypeString, value)
+	}
+	if _u.mutation.OtNameCleared() {
+		_spec.ClearField(pokemon.FieldOtName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Tid(); ok {
+		_spec.SetField(pokemon.FieldTid, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTid(); ok {
+		_spec.AddField(pokemon.FieldTid, field.TypeInt, value)
+	}
+	if _u.mutation.TidCleared() {
+		_spec.ClearField(pokemon.FieldTid, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Sid(); ok {
+		_spec.SetField(pokemon.FieldSid, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSid(); ok {
+		_spec.AddField(pokemon.FieldSid, field.TypeInt, value)
+	}
+	if _u.mutation.SidCleared() {
+		_spec.ClearField(pokemon.FieldSid, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Ball(); ok {
+		_spec.SetField(pokemon.FieldBall, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBall(); ok {
+		_spec.AddField(pokemon.FieldBall, field.TypeInt, value)
+	}
+	if _u.mutation.BallCleared() {
+		_spec.ClearField(pokemon.FieldBall, field.TypeInt)
+	}
+	if value, ok := _u.mutation.HeldItem(); ok {
+		_spec.SetField(pokemon.FieldHeldItem, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHeldItem(); ok {
+		_spec.AddField(pokemon.FieldHeldItem, field.TypeInt, value)
+	}
+	if _u.mutation.HeldItemCleared() {
+		_spec.ClearField(pokemon.FieldHeldItem, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(pokemon.FieldLanguage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLanguage(); ok {
+		_spec.AddField(pokemon.FieldLanguage, field.TypeInt, value)
+	}
+	if _u.mutation.LanguageCleared() {
+		_spec.ClearField(pokemon.FieldLanguage, field.TypeInt)
+	}
+	if value, ok := _u.mutation.OriginGame(); ok {
+		_spec.SetField(pokemon.FieldOriginGame, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOriginGame(); ok {
+		_spec.AddField(pokemon.FieldOriginGame, field.TypeInt, value)
+	}
+	if _u.mutation.OriginGameCleared() {
+		_spec.ClearField(pokemon.FieldOriginGame, field.TypeInt)
+	}
+	if _u.mutation.BundleSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pokemon.BundleSlotsTable,
+			Columns: []string{pokemon.BundleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bundleslot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBundleSlotsIDs(); len(nodes) > 0 && !_u.mutation.BundleSlotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pokemon.BundleSlotsTable,
+			Columns: []string{pokemon.BundleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bundleslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BundleSlotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pokemon.BundleSlotsTable,
+			Columns: []string{pokemon.BundleSlotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bundleslot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MergedIntoCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   pokemon.BundlesTable,
-			Columns: pokemon.BundlesPrimaryKey,
+			Table:   pokemon.MergedIntoTable,
+			Columns: []string{pokemon.MergedIntoColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(bundle.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedBundlesIDs(); len(nodes) > 0 && !_u.mutation.BundlesCleared() {
+	if nodes := _u.mutation.MergedIntoIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   pokemon.BundlesTable,
-			Columns: pokemon.BundlesPrimaryKey,
+			Table:   pokemon.MergedIntoTable,
+			Columns: []string{pokemon.MergedIntoColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DuplicatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pokemon.DuplicatesTable,
+			Columns: []string{pokemon.DuplicatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(bundle.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDuplicatesIDs(); len(nodes) > 0 && !_u.mutation.DuplicatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pokemon.DuplicatesTable,
+			Columns: []string{pokemon.DuplicatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -531,15 +2142,15 @@ func (_u *PokemonUpdateOne) sqlSave(ctx context.Context) (_node *Pokemon, err er
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.BundlesIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.DuplicatesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2M,
-			Inverse: true,
-			Table:   pokemon.BundlesTable,
-			Columns: pokemon.BundlesPrimaryKey,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   pokemon.DuplicatesTable,
+			Columns: []string{pokemon.DuplicatesColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(bundle.FieldID, field.TypeInt),
+				IDSpec: sqlgraph.NewFieldSpec(pokemon.FieldID, field.TypeInt),
 			},
 		}
 		for _, k := range nodes {
@@ -9,5 +9,14 @@ import (
 // Bundle is the predicate function for bundle builders.
 type Bundle func(*sql.Selector)
 
+// BundleSlot is the predicate function for bundleslot builders.
+type BundleSlot func(*sql.Selector)
+
+// LegalityCache is the predicate function for legalitycache builders.
+type LegalityCache func(*sql.Selector)
+
 // Pokemon is the predicate function for pokemon builders.
 type Pokemon func(*sql.Selector)
+
+// RecheckJob is the predicate function for recheckjob builders.
+type RecheckJob func(*sql.Selector)
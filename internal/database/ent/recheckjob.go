@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/recheckjob"
+)
+
+// RecheckJob is the model entity for the RecheckJob schema.
+type RecheckJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// EngineVersion holds the value of the "engine_version" field.
+	EngineVersion string `json:"engine_version,omitempty"`
+	// Status holds the value of the "status" field.
+	Status recheckjob.Status `json:"status,omitempty"`
+	// Total holds the value of the "total" field.
+	Total int `json:"total,omitempty"`
+	// Checked holds the value of the "checked" field.
+	Checked int `json:"checked,omitempty"`
+	// Failed holds the value of the "failed" field.
+	Failed int `json:"failed,omitempty"`
+	// LastPokemonID holds the value of the "last_pokemon_id" field.
+	LastPokemonID int `json:"last_pokemon_id,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RecheckJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case recheckjob.FieldID, recheckjob.FieldTotal, recheckjob.FieldChecked, recheckjob.FieldFailed, recheckjob.FieldLastPokemonID:
+			values[i] = new(sql.NullInt64)
+		case recheckjob.FieldEngineVersion, recheckjob.FieldStatus:
+			values[i] = new(sql.NullString)
+		case recheckjob.FieldStartedAt, recheckjob.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RecheckJob fields.
+func (_m *RecheckJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case recheckjob.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case recheckjob.FieldEngineVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field engine_version", values[i])
+			} else if value.Valid {
+				_m.EngineVersion = value.String
+			}
+		case recheckjob.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = recheckjob.Status(value.String)
+			}
+		case recheckjob.FieldTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[i])
+			} else if value.Valid {
+				_m.Total = int(value.Int64)
+			}
+		case recheckjob.FieldChecked:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field checked", values[i])
+			} else if value.Valid {
+				_m.Checked = int(value.Int64)
+			}
+		case recheckjob.FieldFailed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed", values[i])
+			} else if value.Valid {
+				_m.Failed = int(value.Int64)
+			}
+		case recheckjob.FieldLastPokemonID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_pokemon_id", values[i])
+			} else if value.Valid {
+				_m.LastPokemonID = int(value.Int64)
+			}
+		case recheckjob.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Time
+			}
+		case recheckjob.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = new(time.Time)
+				*_m.FinishedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RecheckJob.
+// This includes values selected through modifiers, order, etc.
+func (_m *RecheckJob) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RecheckJob.
+// Note that you need to call RecheckJob.Unwrap() before calling this method if this RecheckJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RecheckJob) Update() *RecheckJobUpdateOne {
+	return NewRecheckJobClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RecheckJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RecheckJob) Unwrap() *RecheckJob {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RecheckJob is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RecheckJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("RecheckJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("engine_version=")
+	builder.WriteString(_m.EngineVersion)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Total))
+	builder.WriteString(", ")
+	builder.WriteString("checked=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Checked))
+	builder.WriteString(", ")
+	builder.WriteString("failed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Failed))
+	builder.WriteString(", ")
+	builder.WriteString("last_pokemon_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LastPokemonID))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RecheckJobs is a parsable slice of RecheckJob.
+type RecheckJobs []*RecheckJob
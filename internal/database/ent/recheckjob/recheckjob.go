@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package recheckjob
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the recheckjob type in the database.
+	Label = "recheck_job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEngineVersion holds the string denoting the engine_version field in the database.
+	FieldEngineVersion = "engine_version"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldTotal holds the string denoting the total field in the database.
+	FieldTotal = "total"
+	// FieldChecked holds the string denoting the checked field in the database.
+	FieldChecked = "checked"
+	// FieldFailed holds the string denoting the failed field in the database.
+	FieldFailed = "failed"
+	// FieldLastPokemonID holds the string denoting the last_pokemon_id field in the database.
+	FieldLastPokemonID = "last_pokemon_id"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// Table holds the table name of the recheckjob in the database.
+	Table = "recheck_jobs"
+)
+
+// Columns holds all SQL columns for recheckjob fields.
+var Columns = []string{
+	FieldID,
+	FieldEngineVersion,
+	FieldStatus,
+	FieldTotal,
+	FieldChecked,
+	FieldFailed,
+	FieldLastPokemonID,
+	FieldStartedAt,
+	FieldFinishedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTotal holds the default value on creation for the "total" field.
+	DefaultTotal int
+	// DefaultChecked holds the default value on creation for the "checked" field.
+	DefaultChecked int
+	// DefaultFailed holds the default value on creation for the "failed" field.
+	DefaultFailed int
+	// DefaultLastPokemonID holds the default value on creation for the "last_pokemon_id" field.
+	DefaultLastPokemonID int
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// Status values.
+const (
+	StatusRunning Status = "running"
+	StatusDone    Status = "done"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusRunning, StatusDone:
+		return nil
+	default:
+		return fmt.Errorf("recheckjob: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the RecheckJob queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEngineVersion orders the results by the engine_version field.
+func ByEngineVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEngineVersion, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByTotal orders the results by the total field.
+func ByTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotal, opts...).ToFunc()
+}
+
+// ByChecked orders the results by the checked field.
+func ByChecked(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChecked, opts...).ToFunc()
+}
+
+// ByFailed orders the results by the failed field.
+func ByFailed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailed, opts...).ToFunc()
+}
+
+// ByLastPokemonID orders the results by the last_pokemon_id field.
+func ByLastPokemonID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastPokemonID, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
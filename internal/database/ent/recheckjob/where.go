@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package recheckjob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldLTE(FieldID, id))
+}
+
+// EngineVersion applies equality check predicate on the "engine_version" field. It's identical to EngineVersionEQ.
+func EngineVersion(v string) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldEQ(FieldEngineVersion, v))
+}
+
+// Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
+func Total(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldEQ(FieldTotal, v))
+}
+
+// Checked applies equality check predicate on the "checked" field. It's identical to CheckedEQ.
+func Checked(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldEQ(FieldChecked, v))
+}
+
+// Failed applies equality check predicate on the "failed" field. It's identical to FailedEQ.
+func Failed(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldEQ(FieldFailed, v))
+}
+
+// LastPokemonID applies equality check predicate on the "last_pokemon_id" field. It's identical to LastPokemonIDEQ.
+func LastPokemonID(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldEQ(FieldLastPokemonID, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// EngineVersionEQ applies the EQ predicate on the "engine_version" field.
+func EngineVersionEQ(v string) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldEQ(FieldEngineVersion, v))
+}
+
+// EngineVersionNEQ applies the NEQ predicate on the "engine_version" field.
+func EngineVersionNEQ(v string) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldNEQ(FieldEngineVersion, v))
+}
+
+// EngineVersionIn applies the In predicate on the "engine_version" field.
+func EngineVersionIn(vs ...string) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldIn(FieldEngineVersion, vs...))
+}
+
+// EngineVersionNotIn applies the NotIn predicate on the "engine_version" field.
+func EngineVersionNotIn(vs ...string) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldNotIn(FieldEngineVersion, vs...))
+}
+
+// EngineVersionGT applies the GT predicate on the "engine_version" field.
+func EngineVersionGT(v string) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldGT(FieldEngineVersion, v))
+}
+
+// EngineVersionGTE applies the GTE predicate on the "engine_version" field.
+func EngineVersionGTE(v string) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldGTE(FieldEngineVersion, v))
+}
+
+// EngineVersionLT applies the LT predicate on the "engine_version" field.
+func EngineVersionLT(v string) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldLT(FieldEngineVersion, v))
+}
+
+// EngineVersionLTE applies the LTE predicate on the "engine_version" field.
+func EngineVersionLTE(v string) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldLTE(FieldEngineVersion, v))
+}
+
+// EngineVersionContains applies the Contains predicate on the "engine_version" field.
+func EngineVersionContains(v string) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldContains(FieldEngineVersion, v))
+}
+
+// EngineVersionHasPrefix applies the HasPrefix predicate on the "engine_version" field.
+func EngineVersionHasPrefix(v string) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldHasPrefix(FieldEngineVersion, v))
+}
+
+// EngineVersionHasSuffix applies the HasSuffix predicate on the "engine_version" field.
+func EngineVersionHasSuffix(v string) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldHasSuffix(FieldEngineVersion, v))
+}
+
+// EngineVersionEqualFold applies the EqualFold predicate on the "engine_version" field.
+func EngineVersionEqualFold(v string) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldEqualFold(FieldEngineVersion, v))
+}
+
+// EngineVersionContainsFold applies the ContainsFold predicate on the "engine_version" field.
+func EngineVersionContainsFold(v string) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldContainsFold(FieldEngineVersion, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldEQ(FieldTotal, v))
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldNEQ(FieldTotal, v))
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldIn(FieldTotal, vs...))
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldNotIn(FieldTotal, vs...))
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldGT(FieldTotal, v))
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldGTE(FieldTotal, v))
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldLT(FieldTotal, v))
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldLTE(FieldTotal, v))
+}
+
+// CheckedEQ applies the EQ predicate on the "checked" field.
+func CheckedEQ(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldEQ(FieldChecked, v))
+}
+
+// CheckedNEQ applies the NEQ predicate on the "checked" field.
+func CheckedNEQ(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldNEQ(FieldChecked, v))
+}
+
+// CheckedIn applies the In predicate on the "checked" field.
+func CheckedIn(vs ...int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldIn(FieldChecked, vs...))
+}
+
+// CheckedNotIn applies the NotIn predicate on the "checked" field.
+func CheckedNotIn(vs ...int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldNotIn(FieldChecked, vs...))
+}
+
+// CheckedGT applies the GT predicate on the "checked" field.
+func CheckedGT(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldGT(FieldChecked, v))
+}
+
+// CheckedGTE applies the GTE predicate on the "checked" field.
+func CheckedGTE(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldGTE(FieldChecked, v))
+}
+
+// CheckedLT applies the LT predicate on the "checked" field.
+func CheckedLT(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldLT(FieldChecked, v))
+}
+
+// CheckedLTE applies the LTE predicate on the "checked" field.
+func CheckedLTE(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldLTE(FieldChecked, v))
+}
+
+// FailedEQ applies the EQ predicate on the "failed" field.
+func FailedEQ(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldEQ(FieldFailed, v))
+}
+
+// FailedNEQ applies the NEQ predicate on the "failed" field.
+func FailedNEQ(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldNEQ(FieldFailed, v))
+}
+
+// FailedIn applies the In predicate on the "failed" field.
+func FailedIn(vs ...int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldIn(FieldFailed, vs...))
+}
+
+// FailedNotIn applies the NotIn predicate on the "failed" field.
+func FailedNotIn(vs ...int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldNotIn(FieldFailed, vs...))
+}
+
+// FailedGT applies the GT predicate on the "failed" field.
+func FailedGT(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldGT(FieldFailed, v))
+}
+
+// FailedGTE applies the GTE predicate on the "failed" field.
+func FailedGTE(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldGTE(FieldFailed, v))
+}
+
+// FailedLT applies the LT predicate on the "failed" field.
+func FailedLT(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldLT(FieldFailed, v))
+}
+
+// FailedLTE applies the LTE predicate on the "failed" field.
+func FailedLTE(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldLTE(FieldFailed, v))
+}
+
+// LastPokemonIDEQ applies the EQ predicate on the "last_pokemon_id" field.
+func LastPokemonIDEQ(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldEQ(FieldLastPokemonID, v))
+}
+
+// LastPokemonIDNEQ applies the NEQ predicate on the "last_pokemon_id" field.
+func LastPokemonIDNEQ(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldNEQ(FieldLastPokemonID, v))
+}
+
+// LastPokemonIDIn applies the In predicate on the "last_pokemon_id" field.
+func LastPokemonIDIn(vs ...int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldIn(FieldLastPokemonID, vs...))
+}
+
+// LastPokemonIDNotIn applies the NotIn predicate on the "last_pokemon_id" field.
+func LastPokemonIDNotIn(vs ...int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldNotIn(FieldLastPokemonID, vs...))
+}
+
+// LastPokemonIDGT applies the GT predicate on the "last_pokemon_id" field.
+func LastPokemonIDGT(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldGT(FieldLastPokemonID, v))
+}
+
+// LastPokemonIDGTE applies the GTE predicate on the "last_pokemon_id" field.
+func LastPokemonIDGTE(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldGTE(FieldLastPokemonID, v))
+}
+
+// LastPokemonIDLT applies the LT predicate on the "last_pokemon_id" field.
+func LastPokemonIDLT(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldLT(FieldLastPokemonID, v))
+}
+
+// LastPokemonIDLTE applies the LTE predicate on the "last_pokemon_id" field.
+func LastPokemonIDLTE(v int) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldLTE(FieldLastPokemonID, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.RecheckJob {
+	return predicate.RecheckJob(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RecheckJob) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RecheckJob) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RecheckJob) predicate.RecheckJob {
+	return predicate.RecheckJob(sql.NotPredicates(p))
+}
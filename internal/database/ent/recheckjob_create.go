@@ -0,0 +1,339 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/recheckjob"
+)
+
+// RecheckJobCreate is the builder for creating a RecheckJob entity.
+type RecheckJobCreate struct {
+	config
+	mutation *RecheckJobMutation
+	hooks    []Hook
+}
+
+// SetEngineVersion sets the "engine_version" field.
+func (_c *RecheckJobCreate) SetEngineVersion(v string) *RecheckJobCreate {
+	_c.mutation.SetEngineVersion(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *RecheckJobCreate) SetStatus(v recheckjob.Status) *RecheckJobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetTotal sets the "total" field.
+func (_c *RecheckJobCreate) SetTotal(v int) *RecheckJobCreate {
+	_c.mutation.SetTotal(v)
+	return _c
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_c *RecheckJobCreate) SetNillableTotal(v *int) *RecheckJobCreate {
+	if v != nil {
+		_c.SetTotal(*v)
+	}
+	return _c
+}
+
+// SetChecked sets the "checked" field.
+func (_c *RecheckJobCreate) SetChecked(v int) *RecheckJobCreate {
+	_c.mutation.SetChecked(v)
+	return _c
+}
+
+// SetNillableChecked sets the "checked" field if the given value is not nil.
+func (_c *RecheckJobCreate) SetNillableChecked(v *int) *RecheckJobCreate {
+	if v != nil {
+		_c.SetChecked(*v)
+	}
+	return _c
+}
+
+// SetFailed sets the "failed" field.
+func (_c *RecheckJobCreate) SetFailed(v int) *RecheckJobCreate {
+	_c.mutation.SetFailed(v)
+	return _c
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (_c *RecheckJobCreate) SetNillableFailed(v *int) *RecheckJobCreate {
+	if v != nil {
+		_c.SetFailed(*v)
+	}
+	return _c
+}
+
+// SetLastPokemonID sets the "last_pokemon_id" field.
+func (_c *RecheckJobCreate) SetLastPokemonID(v int) *RecheckJobCreate {
+	_c.mutation.SetLastPokemonID(v)
+	return _c
+}
+
+// SetNillableLastPokemonID sets the "last_pokemon_id" field if the given value is not nil.
+func (_c *RecheckJobCreate) SetNillableLastPokemonID(v *int) *RecheckJobCreate {
+	if v != nil {
+		_c.SetLastPokemonID(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *RecheckJobCreate) SetStartedAt(v time.Time) *RecheckJobCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *RecheckJobCreate) SetFinishedAt(v time.Time) *RecheckJobCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *RecheckJobCreate) SetNillableFinishedAt(v *time.Time) *RecheckJobCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RecheckJobMutation object of the builder.
+func (_c *RecheckJobCreate) Mutation() *RecheckJobMutation {
+	return _c.mutation
+}
+
+// Save creates the RecheckJob in the database.
+func (_c *RecheckJobCreate) Save(ctx context.Context) (*RecheckJob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RecheckJobCreate) SaveX(ctx context.Context) *RecheckJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RecheckJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RecheckJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RecheckJobCreate) defaults() {
+	if _, ok := _c.mutation.Total(); !ok {
+		v := recheckjob.DefaultTotal
+		_c.mutation.SetTotal(v)
+	}
+	if _, ok := _c.mutation.Checked(); !ok {
+		v := recheckjob.DefaultChecked
+		_c.mutation.SetChecked(v)
+	}
+	if _, ok := _c.mutation.Failed(); !ok {
+		v := recheckjob.DefaultFailed
+		_c.mutation.SetFailed(v)
+	}
+	if _, ok := _c.mutation.LastPokemonID(); !ok {
+		v := recheckjob.DefaultLastPokemonID
+		_c.mutation.SetLastPokemonID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RecheckJobCreate) check() error {
+	if _, ok := _c.mutation.EngineVersion(); !ok {
+		return &ValidationError{Name: "engine_version", err: errors.New(`ent: missing required field "RecheckJob.engine_version"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "RecheckJob.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := recheckjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RecheckJob.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "RecheckJob.total"`)}
+	}
+	if _, ok := _c.mutation.Checked(); !ok {
+		return &ValidationError{Name: "checked", err: errors.New(`ent: missing required field "RecheckJob.checked"`)}
+	}
+	if _, ok := _c.mutation.Failed(); !ok {
+		return &ValidationError{Name: "failed", err: errors.New(`ent: missing required field "RecheckJob.failed"`)}
+	}
+	if _, ok := _c.mutation.LastPokemonID(); !ok {
+		return &ValidationError{Name: "last_pokemon_id", err: errors.New(`ent: missing required field "RecheckJob.last_pokemon_id"`)}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "RecheckJob.started_at"`)}
+	}
+	return nil
+}
+
+func (_c *RecheckJobCreate) sqlSave(ctx context.Context) (*RecheckJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RecheckJobCreate) createSpec() (*RecheckJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RecheckJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(recheckjob.Table, sqlgraph.NewFieldSpec(recheckjob.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.EngineVersion(); ok {
+		_spec.SetField(recheckjob.FieldEngineVersion, field.TypeString, value)
+		_node.EngineVersion = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(recheckjob.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Total(); ok {
+		_spec.SetField(recheckjob.FieldTotal, field.TypeInt, value)
+		_node.Total = value
+	}
+	if value, ok := _c.mutation.Checked(); ok {
+		_spec.SetField(recheckjob.FieldChecked, field.TypeInt, value)
+		_node.Checked = value
+	}
+	if value, ok := _c.mutation.Failed(); ok {
+		_spec.SetField(recheckjob.FieldFailed, field.TypeInt, value)
+		_node.Failed = value
+	}
+	if value, ok := _c.mutation.LastPokemonID(); ok {
+		_spec.SetField(recheckjob.FieldLastPokemonID, field.TypeInt, value)
+		_node.LastPokemonID = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(recheckjob.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(recheckjob.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	return _node, _spec
+}
+
+// RecheckJobCreateBulk is the builder for creating many RecheckJob entities in bulk.
+type RecheckJobCreateBulk struct {
+	config
+	err      error
+	builders []*RecheckJobCreate
+}
+
+// Save creates the RecheckJob entities in the database.
+func (_c *RecheckJobCreateBulk) Save(ctx context.Context) ([]*RecheckJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RecheckJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RecheckJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RecheckJobCreateBulk) SaveX(ctx context.Context) []*RecheckJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RecheckJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RecheckJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,572 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/predicate"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/recheckjob"
+)
+
+// RecheckJobUpdate is the builder for updating RecheckJob entities.
+type RecheckJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RecheckJobMutation
+}
+
+// Where appends a list predicates to the RecheckJobUpdate builder.
+func (_u *RecheckJobUpdate) Where(ps ...predicate.RecheckJob) *RecheckJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEngineVersion sets the "engine_version" field.
+func (_u *RecheckJobUpdate) SetEngineVersion(v string) *RecheckJobUpdate {
+	_u.mutation.SetEngineVersion(v)
+	return _u
+}
+
+// SetNillableEngineVersion sets the "engine_version" field if the given value is not nil.
+func (_u *RecheckJobUpdate) SetNillableEngineVersion(v *string) *RecheckJobUpdate {
+	if v != nil {
+		_u.SetEngineVersion(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *RecheckJobUpdate) SetStatus(v recheckjob.Status) *RecheckJobUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *RecheckJobUpdate) SetNillableStatus(v *recheckjob.Status) *RecheckJobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *RecheckJobUpdate) SetTotal(v int) *RecheckJobUpdate {
+	_u.mutation.ResetTotal()
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *RecheckJobUpdate) SetNillableTotal(v *int) *RecheckJobUpdate {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// AddTotal adds value to the "total" field.
+func (_u *RecheckJobUpdate) AddTotal(v int) *RecheckJobUpdate {
+	_u.mutation.AddTotal(v)
+	return _u
+}
+
+// SetChecked sets the "checked" field.
+func (_u *RecheckJobUpdate) SetChecked(v int) *RecheckJobUpdate {
+	_u.mutation.ResetChecked()
+	_u.mutation.SetChecked(v)
+	return _u
+}
+
+// SetNillableChecked sets the "checked" field if the given value is not nil.
+func (_u *RecheckJobUpdate) SetNillableChecked(v *int) *RecheckJobUpdate {
+	if v != nil {
+		_u.SetChecked(*v)
+	}
+	return _u
+}
+
+// AddChecked adds value to the "checked" field.
+func (_u *RecheckJobUpdate) AddChecked(v int) *RecheckJobUpdate {
+	_u.mutation.AddChecked(v)
+	return _u
+}
+
+// SetFailed sets the "failed" field.
+func (_u *RecheckJobUpdate) SetFailed(v int) *RecheckJobUpdate {
+	_u.mutation.ResetFailed()
+	_u.mutation.SetFailed(v)
+	return _u
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (_u *RecheckJobUpdate) SetNillableFailed(v *int) *RecheckJobUpdate {
+	if v != nil {
+		_u.SetFailed(*v)
+	}
+	return _u
+}
+
+// AddFailed adds value to the "failed" field.
+func (_u *RecheckJobUpdate) AddFailed(v int) *RecheckJobUpdate {
+	_u.mutation.AddFailed(v)
+	return _u
+}
+
+// SetLastPokemonID sets the "last_pokemon_id" field.
+func (_u *RecheckJobUpdate) SetLastPokemonID(v int) *RecheckJobUpdate {
+	_u.mutation.ResetLastPokemonID()
+	_u.mutation.SetLastPokemonID(v)
+	return _u
+}
+
+// SetNillableLastPokemonID sets the "last_pokemon_id" field if the given value is not nil.
+func (_u *RecheckJobUpdate) SetNillableLastPokemonID(v *int) *RecheckJobUpdate {
+	if v != nil {
+		_u.SetLastPokemonID(*v)
+	}
+	return _u
+}
+
+// AddLastPokemonID adds value to the "last_pokemon_id" field.
+func (_u *RecheckJobUpdate) AddLastPokemonID(v int) *RecheckJobUpdate {
+	_u.mutation.AddLastPokemonID(v)
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *RecheckJobUpdate) SetStartedAt(v time.Time) *RecheckJobUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *RecheckJobUpdate) SetNillableStartedAt(v *time.Time) *RecheckJobUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *RecheckJobUpdate) SetFinishedAt(v time.Time) *RecheckJobUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *RecheckJobUpdate) SetNillableFinishedAt(v *time.Time) *RecheckJobUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *RecheckJobUpdate) ClearFinishedAt() *RecheckJobUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the RecheckJobMutation object of the builder.
+func (_u *RecheckJobUpdate) Mutation() *RecheckJobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RecheckJobUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RecheckJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RecheckJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RecheckJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RecheckJobUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := recheckjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RecheckJob.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RecheckJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(recheckjob.Table, recheckjob.Columns, sqlgraph.NewFieldSpec(recheckjob.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EngineVersion(); ok {
+		_spec.SetField(recheckjob.FieldEngineVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(recheckjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(recheckjob.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotal(); ok {
+		_spec.AddField(recheckjob.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Checked(); ok {
+		_spec.SetField(recheckjob.FieldChecked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChecked(); ok {
+		_spec.AddField(recheckjob.FieldChecked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Failed(); ok {
+		_spec.SetField(recheckjob.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailed(); ok {
+		_spec.AddField(recheckjob.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastPokemonID(); ok {
+		_spec.SetField(recheckjob.FieldLastPokemonID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastPokemonID(); ok {
+		_spec.AddField(recheckjob.FieldLastPokemonID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(recheckjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(recheckjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(recheckjob.FieldFinishedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recheckjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RecheckJobUpdateOne is the builder for updating a single RecheckJob entity.
+type RecheckJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RecheckJobMutation
+}
+
+// SetEngineVersion sets the "engine_version" field.
+func (_u *RecheckJobUpdateOne) SetEngineVersion(v string) *RecheckJobUpdateOne {
+	_u.mutation.SetEngineVersion(v)
+	return _u
+}
+
+// SetNillableEngineVersion sets the "engine_version" field if the given value is not nil.
+func (_u *RecheckJobUpdateOne) SetNillableEngineVersion(v *string) *RecheckJobUpdateOne {
+	if v != nil {
+		_u.SetEngineVersion(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *RecheckJobUpdateOne) SetStatus(v recheckjob.Status) *RecheckJobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *RecheckJobUpdateOne) SetNillableStatus(v *recheckjob.Status) *RecheckJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *RecheckJobUpdateOne) SetTotal(v int) *RecheckJobUpdateOne {
+	_u.mutation.ResetTotal()
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *RecheckJobUpdateOne) SetNillableTotal(v *int) *RecheckJobUpdateOne {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// AddTotal adds value to the "total" field.
+func (_u *RecheckJobUpdateOne) AddTotal(v int) *RecheckJobUpdateOne {
+	_u.mutation.AddTotal(v)
+	return _u
+}
+
+// SetChecked sets the "checked" field.
+func (_u *RecheckJobUpdateOne) SetChecked(v int) *RecheckJobUpdateOne {
+	_u.mutation.ResetChecked()
+	_u.mutation.SetChecked(v)
+	return _u
+}
+
+// SetNillableChecked sets the "checked" field if the given value is not nil.
+func (_u *RecheckJobUpdateOne) SetNillableChecked(v *int) *RecheckJobUpdateOne {
+	if v != nil {
+		_u.SetChecked(*v)
+	}
+	return _u
+}
+
+// AddChecked adds value to the "checked" field.
+func (_u *RecheckJobUpdateOne) AddChecked(v int) *RecheckJobUpdateOne {
+	_u.mutation.AddChecked(v)
+	return _u
+}
+
+// SetFailed sets the "failed" field.
+func (_u *RecheckJobUpdateOne) SetFailed(v int) *RecheckJobUpdateOne {
+	_u.mutation.ResetFailed()
+	_u.mutation.SetFailed(v)
+	return _u
+}
+
+// SetNillableFailed sets the "failed" field if the given value is not nil.
+func (_u *RecheckJobUpdateOne) SetNillableFailed(v *int) *RecheckJobUpdateOne {
+	if v != nil {
+		_u.SetFailed(*v)
+	}
+	return _u
+}
+
+// AddFailed adds value to the "failed" field.
+func (_u *RecheckJobUpdateOne) AddFailed(v int) *RecheckJobUpdateOne {
+	_u.mutation.AddFailed(v)
+	return _u
+}
+
+// SetLastPokemonID sets the "last_pokemon_id" field.
+func (_u *RecheckJobUpdateOne) SetLastPokemonID(v int) *RecheckJobUpdateOne {
+	_u.mutation.ResetLastPokemonID()
+	_u.mutation.SetLastPokemonID(v)
+	return _u
+}
+
+// SetNillableLastPokemonID sets the "last_pokemon_id" field if the given value is not nil.
+func (_u *RecheckJobUpdateOne) SetNillableLastPokemonID(v *int) *RecheckJobUpdateOne {
+	if v != nil {
+		_u.SetLastPokemonID(*v)
+	}
+	return _u
+}
+
+// AddLastPokemonID adds value to the "last_pokemon_id" field.
+func (_u *RecheckJobUpdateOne) AddLastPokemonID(v int) *RecheckJobUpdateOne {
+	_u.mutation.AddLastPokemonID(v)
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *RecheckJobUpdateOne) SetStartedAt(v time.Time) *RecheckJobUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *RecheckJobUpdateOne) SetNillableStartedAt(v *time.Time) *RecheckJobUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *RecheckJobUpdateOne) SetFinishedAt(v time.Time) *RecheckJobUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *RecheckJobUpdateOne) SetNillableFinishedAt(v *time.Time) *RecheckJobUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *RecheckJobUpdateOne) ClearFinishedAt() *RecheckJobUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// Mutation returns the RecheckJobMutation object of the builder.
+func (_u *RecheckJobUpdateOne) Mutation() *RecheckJobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RecheckJobUpdate builder.
+func (_u *RecheckJobUpdateOne) Where(ps ...predicate.RecheckJob) *RecheckJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RecheckJobUpdateOne) Select(field string, fields ...string) *RecheckJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RecheckJob entity.
+func (_u *RecheckJobUpdateOne) Save(ctx context.Context) (*RecheckJob, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RecheckJobUpdateOne) SaveX(ctx context.Context) *RecheckJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RecheckJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RecheckJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RecheckJobUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := recheckjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RecheckJob.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RecheckJobUpdateOne) sqlSave(ctx context.Context) (_node *RecheckJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(recheckjob.Table, recheckjob.Columns, sqlgraph.NewFieldSpec(recheckjob.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RecheckJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, recheckjob.FieldID)
+		for _, f := range fields {
+			if !recheckjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != recheckjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EngineVersion(); ok {
+		_spec.SetField(recheckjob.FieldEngineVersion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(recheckjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(recheckjob.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotal(); ok {
+		_spec.AddField(recheckjob.FieldTotal, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Checked(); ok {
+		_spec.SetField(recheckjob.FieldChecked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChecked(); ok {
+		_spec.AddField(recheckjob.FieldChecked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Failed(); ok {
+		_spec.SetField(recheckjob.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailed(); ok {
+		_spec.AddField(recheckjob.FieldFailed, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastPokemonID(); ok {
+		_spec.SetField(recheckjob.FieldLastPokemonID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastPokemonID(); ok {
+		_spec.AddField(recheckjob.FieldLastPokemonID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(recheckjob.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(recheckjob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(recheckjob.FieldFinishedAt, field.TypeTime)
+	}
+	_node = &RecheckJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{recheckjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
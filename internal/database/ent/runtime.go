@@ -4,7 +4,9 @@ package ent
 
 import (
 	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/recheckjob"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/schema"
 )
 
@@ -12,16 +14,46 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	bundleMixin := schema.Bundle{}.Mixin()
+	bundleMixinFields0 := bundleMixin[0].Fields()
+	_ = bundleMixinFields0
 	bundleFields := schema.Bundle{}.Fields()
 	_ = bundleFields
 	// bundleDescDownloadCount is the schema descriptor for download_count field.
 	bundleDescDownloadCount := bundleFields[2].Descriptor()
 	// bundle.DefaultDownloadCount holds the default value on creation for the download_count field.
 	bundle.DefaultDownloadCount = bundleDescDownloadCount.Default.(int)
+	bundleslotFields := schema.BundleSlot{}.Fields()
+	_ = bundleslotFields
+	// bundleslotDescPosition is the schema descriptor for position field.
+	bundleslotDescPosition := bundleslotFields[2].Descriptor()
+	// bundleslot.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	bundleslot.PositionValidator = bundleslotDescPosition.Validators[0].(func(int) error)
+	pokemonMixin := schema.Pokemon{}.Mixin()
+	pokemonMixinFields0 := pokemonMixin[0].Fields()
+	_ = pokemonMixinFields0
 	pokemonFields := schema.Pokemon{}.Fields()
 	_ = pokemonFields
 	// pokemonDescDownloadCount is the schema descriptor for download_count field.
 	pokemonDescDownloadCount := pokemonFields[2].Descriptor()
 	// pokemon.DefaultDownloadCount holds the default value on creation for the download_count field.
 	pokemon.DefaultDownloadCount = pokemonDescDownloadCount.Default.(int)
+	recheckjobFields := schema.RecheckJob{}.Fields()
+	_ = recheckjobFields
+	// recheckjobDescTotal is the schema descriptor for total field.
+	recheckjobDescTotal := recheckjobFields[2].Descriptor()
+	// recheckjob.DefaultTotal holds the default value on creation for the total field.
+	recheckjob.DefaultTotal = recheckjobDescTotal.Default.(int)
+	// recheckjobDescChecked is the schema descriptor for checked field.
+	recheckjobDescChecked := recheckjobFields[3].Descriptor()
+	// recheckjob.DefaultChecked holds the default value on creation for the checked field.
+	recheckjob.DefaultChecked = recheckjobDescChecked.Default.(int)
+	// recheckjobDescFailed is the schema descriptor for failed field.
+	recheckjobDescFailed := recheckjobFields[4].Descriptor()
+	// recheckjob.DefaultFailed holds the default value on creation for the failed field.
+	recheckjob.DefaultFailed = recheckjobDescFailed.Default.(int)
+	// recheckjobDescLastPokemonID is the schema descriptor for last_pokemon_id field.
+	recheckjobDescLastPokemonID := recheckjobFields[5].Descriptor()
+	// recheckjob.DefaultLastPokemonID holds the default value on creation for the last_pokemon_id field.
+	recheckjob.DefaultLastPokemonID = recheckjobDescLastPokemonID.Default.(int)
 }
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -10,19 +11,31 @@ type Bundle struct {
 	ent.Schema
 }
 
+func (Bundle) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Visibility{},
+	}
+}
+
 func (Bundle) Fields() []ent.Field {
 	return []ent.Field{
 		field.Time("upload_datetime"),
 		field.String("download_code").Unique(),
 		field.Int("download_count").Default(0),
 		field.Bool("legal"),
-		field.String("min_gen"),
-		field.String("max_gen"),
+		// Stored as numbers so they compare properly, see generation.Generation.Number.
+		field.Float("min_gen"),
+		field.Float("max_gen"),
+		// Set by the uploader to describe the bundle, nil when they didn't.
+		field.String("title").Optional().Nillable(),
+		field.Text("description").Optional().Nillable(),
+		field.Text("notes").Optional().Nillable(),
 	}
 }
 
 func (Bundle) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("pokemons", Pokemon.Type),
+		// Deleting a bundle takes its slots with it, like it used to with its members.
+		edge.To("slots", BundleSlot.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BundleSlot puts a pokemon in a bundle at the slot it was uploaded in. The same pokemon can take up
+// more than one slot of a bundle.
+type BundleSlot struct {
+	ent.Schema
+}
+
+func (BundleSlot) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("bundle_id"),
+		field.Int("pokemon_id"),
+		// Position is the slot in the bundle, starting at 0.
+		field.Int("position").Min(0),
+		// Generation is what the pokemon was sent as for this slot.
+		field.String("generation"),
+	}
+}
+
+func (BundleSlot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("bundle", Bundle.Type).Ref("slots").Field("bundle_id").Unique().Required(),
+		edge.From("pokemon", Pokemon.Type).Ref("bundle_slots").Field("pokemon_id").Unique().Required(),
+	}
+}
+
+func (BundleSlot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("bundle_id", "position").Unique(),
+		index.Fields("pokemon_id"),
+	}
+}
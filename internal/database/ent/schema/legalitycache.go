@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// LegalityCache stores legality check results so the same Pokémon doesn't have to be checked twice
+// by the same engine version.
+type LegalityCache struct {
+	ent.Schema
+}
+
+func (LegalityCache) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("hash"),
+		field.String("generation"),
+		field.String("engine_version"),
+		field.Bool("legal"),
+		field.Strings("report"),
+		field.Time("created_at"),
+	}
+}
+
+func (LegalityCache) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("hash", "generation", "engine_version").Unique(),
+		index.Fields("engine_version"),
+	}
+}
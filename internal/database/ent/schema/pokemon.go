@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -10,6 +11,12 @@ type Pokemon struct {
 	ent.Schema
 }
 
+func (Pokemon) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		Visibility{},
+	}
+}
+
 func (Pokemon) Fields() []ent.Field {
 	return []ent.Field{
 		field.Time("upload_datetime"),
@@ -17,12 +24,42 @@ func (Pokemon) Fields() []ent.Field {
 		field.Int("download_count").Default(0),
 		field.String("generation"),
 		field.Bool("legal"),
-		field.String("base_64"),
+		// The canonical form of the pokemon, see pkm.Canonical. Only nil for rows stored before it existed,
+		// which still have their data in base_64 until they're converted on startup.
+		field.Bytes("data").Optional(),
+		field.String("base_64").Optional(),
+		// SHA-256 of the data, used to find duplicates. Only nil for rows that haven't been backfilled or
+		// were already duplicated before the hash existed.
+		field.String("content_hash").Optional().Nillable().Unique(),
+		field.Strings("legality_report").Optional(),
+		field.String("engine_version").Optional(),
+		field.Time("legality_checked_at").Optional().Nillable(),
+		// Decoded from the raw data, nil when the format doesn't store it or the row hasn't been backfilled yet.
+		field.Int("species").Optional().Nillable(),
+		field.Int("form").Optional().Nillable(),
+		field.Int("level").Optional().Nillable(),
+		field.Bool("shiny").Optional().Nillable(),
+		field.Int("nature").Optional().Nillable(),
+		field.Int("gender").Optional().Nillable(),
+		field.String("ot_name").Optional().Nillable(),
+		field.Int("tid").Optional().Nillable(),
+		field.Int("sid").Optional().Nillable(),
+		field.Int("ball").Optional().Nillable(),
+		field.Int("held_item").Optional().Nillable(),
+		field.Int("language").Optional().Nillable(),
+		field.Int("origin_game").Optional().Nillable(),
+		// The pokemon this one was merged into when it turned out to be a duplicate, its download code
+		// keeps working through it.
+		field.Int("merged_into_id").Optional().Nillable(),
 	}
 }
 
 func (Pokemon) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.From("bundles", Bundle.Type).Ref("pokemons"),
+		edge.To("bundle_slots", BundleSlot.Type).Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("duplicates", Pokemon.Type).
+			From("merged_into").
+			Field("merged_into_id").
+			Unique(),
 	}
 }
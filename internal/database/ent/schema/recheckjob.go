@@ -0,0 +1,25 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// RecheckJob tracks re-running the legality checks on every stored Pokémon after the engine
+// has been upgraded, so the job can pick up where it left off after a restart.
+type RecheckJob struct {
+	ent.Schema
+}
+
+func (RecheckJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("engine_version").Unique(),
+		field.Enum("status").Values("running", "done"),
+		field.Int("total").Default(0),
+		field.Int("checked").Default(0),
+		field.Int("failed").Default(0),
+		field.Int("last_pokemon_id").Default(0),
+		field.Time("started_at"),
+		field.Time("finished_at").Optional().Nillable(),
+	}
+}
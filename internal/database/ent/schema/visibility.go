@@ -0,0 +1,29 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+)
+
+// Visibility lets pokemon and bundles be taken down without losing them. Anything that isn't visible is
+// left out of search and download until it's restored.
+type Visibility struct {
+	mixin.Schema
+}
+
+func (Visibility) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("visibility").Values("visible", "hidden", "deleted").Default("visible"),
+		// When and why the visibility last changed, nil when it never has.
+		field.Time("visibility_changed_at").Optional().Nillable(),
+		field.String("visibility_reason").Optional().Nillable(),
+	}
+}
+
+func (Visibility) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("visibility"),
+	}
+}
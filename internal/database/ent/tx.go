@@ -14,8 +14,14 @@ type Tx struct {
 	config
 	// Bundle is the client for interacting with the Bundle builders.
 	Bundle *BundleClient
+	// BundleSlot is the client for interacting with the BundleSlot builders.
+	BundleSlot *BundleSlotClient
+	// LegalityCache is the client for interacting with the LegalityCache builders.
+	LegalityCache *LegalityCacheClient
 	// Pokemon is the client for interacting with the Pokemon builders.
 	Pokemon *PokemonClient
+	// RecheckJob is the client for interacting with the RecheckJob builders.
+	RecheckJob *RecheckJobClient
 
 	// lazily loaded.
 	client     *Client
@@ -148,7 +154,10 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Bundle = NewBundleClient(tx.config)
+	tx.BundleSlot = NewBundleSlotClient(tx.config)
+	tx.LegalityCache = NewLegalityCacheClient(tx.config)
 	tx.Pokemon = NewPokemonClient(tx.config)
+	tx.RecheckJob = NewRecheckJobClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
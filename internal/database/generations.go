@@ -0,0 +1,125 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/postgres"
+	atlas "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/FlagBrew/local-gpss/internal/database/ent"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/generation"
+	"github.com/apex/log"
+)
+
+// bundleGenColumns used to be strings, which is why "10" sorted before "9".
+var bundleGenColumns = []string{"min_gen", "max_gen"}
+
+// genColumnHooks convert the bundle min/max gen columns from strings to numbers. The diff hook lets
+// postgres cast the existing values and the apply hook rewrites the spin-off names (e.g. "LGPE") into
+// their numeric form before the column type changes.
+func genColumnHooks() []schema.MigrateOption {
+	var converting bool
+
+	diff := func(next schema.Differ) schema.Differ {
+		return schema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+			changes, err := next.Diff(current, desired)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, change := range changes {
+				modify, ok := change.(*atlas.ModifyTable)
+				if !ok || modify.T.Name != "bundles" {
+					continue
+				}
+
+				for _, c := range modify.Changes {
+					column, ok := c.(*atlas.ModifyColumn)
+					if !ok || !isGenColumn(column.From.Name) {
+						continue
+					}
+
+					if _, ok := column.From.Type.Type.(*atlas.StringType); !ok {
+						continue
+					}
+
+					converting = true
+					column.Extra = append(column.Extra, &postgres.ConvertUsing{
+						X: fmt.Sprintf("%q::double precision", column.From.Name),
+					})
+				}
+			}
+
+			return changes, nil
+		})
+	}
+
+	apply := func(next schema.Applier) schema.Applier {
+		return schema.ApplyFunc(func(ctx context.Context, conn dialect.ExecQuerier, plan *migrate.Plan) error {
+			if converting {
+				for _, column := range bundleGenColumns {
+					for name, gen := range generationNames() {
+						query := fmt.Sprintf("UPDATE bundles SET %s = '%s' WHERE UPPER(%s) = '%s'", column, gen.String(), column, name)
+						if err := conn.Exec(ctx, query, []any{}, nil); err != nil {
+							return err
+						}
+					}
+				}
+			}
+
+			return next.Apply(ctx, conn, plan)
+		})
+	}
+
+	return []schema.MigrateOption{schema.WithDiffHook(diff), schema.WithApplyHook(apply)}
+}
+
+func isGenColumn(name string) bool {
+	return slices.Contains(bundleGenColumns, name)
+}
+
+// generationNames returns every non-canonical name a generation may have been stored as.
+func generationNames() map[string]generation.Generation {
+	names := map[string]generation.Generation{}
+	for _, gen := range generation.All() {
+		names[gen.Console()] = gen
+	}
+
+	for _, name := range generation.Aliases() {
+		gen, _ := generation.Parse(name)
+		names[name] = gen
+	}
+
+	for name, gen := range names {
+		if name == gen.String() {
+			delete(names, name)
+		}
+	}
+
+	return names
+}
+
+// normalizePokemonGenerations rewrites generations stored under another name (older uploads kept the
+// header as-is) to the database form, so searching by generation finds them.
+func normalizePokemonGenerations(ctx context.Context, db *ent.Client) error {
+	for name, gen := range generationNames() {
+		n, err := db.Pokemon.Update().
+			Where(pokemon.GenerationEqualFold(name)).
+			SetGeneration(gen.String()).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		if n > 0 {
+			log.FromContext(ctx).WithFields(log.Fields{"from": name, "to": gen.String(), "count": n}).Info("normalized pokemon generations")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package database
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/FlagBrew/local-gpss/internal/database/ent"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/predicate"
+	"github.com/apex/log"
+)
+
+const hashBatchSize = 500
+
+// ContentHash is what duplicate pokemon are found by.
+func ContentHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// backfillContentHashes hashes the pokemon that don't have a hash yet. When the same pokemon was stored
+// twice only the first one gets the hash, the others are merged into it (see mergeDuplicate).
+func backfillContentHashes(ctx context.Context, db *ent.Client) error {
+	logger := log.FromContext(ctx)
+
+	// Merged duplicates never get a hash, they point at the pokemon they were merged into instead.
+	unhashed := []predicate.Pokemon{
+		pokemon.ContentHashIsNil(), pokemon.DataNotNil(), pokemon.MergedIntoIDIsNil(),
+		pokemon.VisibilityNEQ(pokemon.VisibilityDeleted),
+	}
+	total, err := db.Pokemon.Query().Where(unhashed...).Count(ctx)
+	if err != nil || total == 0 {
+		return err
+	}
+
+	logger.WithField("total", total).Info("hashing stored pokemon")
+
+	var hashed, duplicates int
+	lastID := 0
+	for {
+		mons, err := db.Pokemon.Query().
+			Where(append(unhashed, pokemon.IDGT(lastID))...).
+			Order(pokemon.ByID()).
+			Limit(hashBatchSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(mons) == 0 {
+			break
+		}
+
+		for _, mon := range mons {
+			lastID = mon.ID
+
+			hash := ContentHash(mon.Data)
+			err := mon.Update().SetContentHash(hash).Exec(ctx)
+			if ent.IsConstraintError(err) {
+				duplicates++
+				err = mergeDuplicate(ctx, db, mon, hash, nil)
+				if err != nil {
+					return err
+				}
+				continue
+			} else if err != nil {
+				return err
+			}
+
+			hashed++
+		}
+	}
+
+	logger.WithFields(log.Fields{"hashed": hashed, "duplicates": duplicates}).Info("finished hashing stored pokemon")
+	return nil
+}
+
+// mergeDuplicate merges a pokemon into the stored pokemon with the same hash, which it couldn't get. The
+// bundles it's in are moved over to the other one along with its downloads. A visible duplicate is deleted
+// and its download code leads to the other one from then on, one that was taken down stays that way. When
+// the other one was taken down itself, the visible bundles it's now in are hidden like SetPokemonVisibility
+// does. update is applied to the duplicate on top of that.
+func mergeDuplicate(ctx context.Context, db *ent.Client, mon *ent.Pokemon, hash string, update func(*ent.PokemonUpdateOne)) error {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	original, err := tx.Pokemon.Query().Where(pokemon.ContentHash(hash)).Only(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err = tx.BundleSlot.Update().Where(bundleslot.PokemonID(mon.ID)).SetPokemonID(original.ID).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	now := time.Now()
+	var hidden int
+	if original.Visibility != pokemon.VisibilityVisible {
+		hidden, err = tx.Bundle.Update().
+			Where(bundle.VisibilityEQ(bundle.VisibilityVisible), bundle.HasSlotsWith(bundleslot.PokemonID(original.ID))).
+			SetVisibility(bundle.VisibilityHidden).
+			SetVisibilityChangedAt(now).
+			SetVisibilityReason(fmt.Sprintf("pokemon %s was %s", original.DownloadCode, original.Visibility)).
+			Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err = tx.Pokemon.UpdateOne(original).AddDownloadCount(mon.DownloadCount).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	dup := tx.Pokemon.UpdateOne(mon).SetMergedIntoID(original.ID)
+	if mon.Visibility == pokemon.VisibilityVisible {
+		dup.SetVisibility(pokemon.VisibilityDeleted).
+			SetVisibilityChangedAt(now).
+			SetVisibilityReason("duplicate of " + original.DownloadCode)
+	}
+
+	if update != nil {
+		update(dup)
+	}
+
+	if err = dup.Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	log.FromContext(ctx).WithFields(log.Fields{
+		"code":           mon.DownloadCode,
+		"original":       original.DownloadCode,
+		"visibility":     original.Visibility,
+		"hidden_bundles": hidden,
+	}).Warn("pokemon is a duplicate of another stored pokemon, merged it into that one")
+
+	return tx.Commit()
+}
@@ -0,0 +1,260 @@
+package database
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/FlagBrew/local-gpss/internal/database/ent"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/generation"
+	"github.com/FlagBrew/local-gpss/internal/models"
+	"github.com/apex/log"
+	"github.com/apex/log/handlers/discard"
+)
+
+// testConfig returns the config of a fresh sqlite database.
+func testConfig(t *testing.T) *models.DatabaseConfig {
+	t.Helper()
+
+	return &models.DatabaseConfig{
+		DBType:           "sqlite",
+		ConnectionString: "file:" + filepath.Join(t.TempDir(), "gpss.db") + "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)",
+	}
+}
+
+func testContext() context.Context {
+	return log.NewContext(context.Background(), &log.Logger{Handler: discard.New(), Level: log.ErrorLevel})
+}
+
+// newTestDB returns a migrated sqlite database.
+func newTestDB(t *testing.T) (context.Context, *ent.Client) {
+	t.Helper()
+
+	ctx := testContext()
+	cfg := testConfig(t)
+	db := New(ctx, cfg)
+	t.Cleanup(func() { db.Close() })
+
+	ctx = ent.NewContext(ctx, db)
+	Migrate(ctx, cfg, false)
+
+	return ctx, db
+}
+
+// sample reads one of the sample pokemon of the pkm package.
+func sample(t *testing.T, name string) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("..", "pkm", "testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
+
+func createPokemon(t *testing.T, ctx context.Context, db *ent.Client, code string, update func(*ent.PokemonCreate)) *ent.Pokemon {
+	t.Helper()
+
+	create := db.Pokemon.Create().
+		SetUploadDatetime(time.Now()).
+		SetDownloadCode(code).
+		SetGeneration(generation.Gen8.String()).
+		SetLegal(true)
+	if update != nil {
+		update(create)
+	}
+
+	mon, err := create.Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return mon
+}
+
+func createBundle(t *testing.T, ctx context.Context, db *ent.Client, code string, members ...*ent.Pokemon) *ent.Bundle {
+	t.Helper()
+
+	bun, err := db.Bundle.Create().
+		SetUploadDatetime(time.Now()).
+		SetDownloadCode(code).
+		SetLegal(true).
+		SetMinGen(generation.Gen8.Number()).
+		SetMaxGen(generation.Gen8.Number()).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, mon := range members {
+		err := db.BundleSlot.Create().
+			SetBundle(bun).
+			SetPokemon(mon).
+			SetPosition(i).
+			SetGeneration(mon.Generation).
+			Exec(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return bun
+}
+
+func TestBackfillContentHashesMerges(t *testing.T) {
+	data := sample(t, "pk8_box_decrypted.pk8")
+
+	tests := []struct {
+		name     string
+		original pokemon.Visibility
+		dup      pokemon.Visibility
+		// dupVisibility is what the duplicate is left as.
+		dupVisibility pokemon.Visibility
+		bundle        bundle.Visibility
+	}{
+		{
+			name: "visible", original: pokemon.VisibilityVisible, dup: pokemon.VisibilityVisible,
+			dupVisibility: pokemon.VisibilityDeleted, bundle: bundle.VisibilityVisible,
+		},
+		{
+			// The bundles of the duplicate would be missing a member otherwise.
+			name: "hidden original", original: pokemon.VisibilityHidden, dup: pokemon.VisibilityVisible,
+			dupVisibility: pokemon.VisibilityDeleted, bundle: bundle.VisibilityHidden,
+		},
+		{
+			// A duplicate that was taken down isn't brought back through the original, its bundle was
+			// hidden along with it.
+			name: "hidden duplicate", original: pokemon.VisibilityVisible, dup: pokemon.VisibilityHidden,
+			dupVisibility: pokemon.VisibilityHidden, bundle: bundle.VisibilityHidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, db := newTestDB(t)
+
+			original := createPokemon(t, ctx, db, "0000000001", func(c *ent.PokemonCreate) {
+				c.SetData(data).SetDownloadCount(3).SetVisibility(tt.original)
+			})
+			dup := createPokemon(t, ctx, db, "0000000002", func(c *ent.PokemonCreate) {
+				c.SetData(data).SetDownloadCount(2)
+			})
+			other := createPokemon(t, ctx, db, "0000000003", func(c *ent.PokemonCreate) {
+				c.SetData(sample(t, "pk9_box_decrypted.pk9"))
+			})
+			bun := createBundle(t, ctx, db, "1000000001", dup, other)
+
+			if tt.dup != pokemon.VisibilityVisible {
+				if _, err := SetPokemonVisibility(ctx, db, dup.DownloadCode, tt.dup, ""); err != nil {
+					t.Fatal(err)
+				}
+				dup = db.Pokemon.GetX(ctx, dup.ID)
+			}
+
+			if err := backfillContentHashes(ctx, db); err != nil {
+				t.Fatal(err)
+			}
+
+			original = db.Pokemon.GetX(ctx, original.ID)
+			dup = db.Pokemon.GetX(ctx, dup.ID)
+
+			if original.ContentHash == nil || *original.ContentHash != ContentHash(data) {
+				t.Errorf("original hash = %v, want %s", original.ContentHash, ContentHash(data))
+			}
+			if original.DownloadCount != 5 {
+				t.Errorf("original downloads = %d, want 5", original.DownloadCount)
+			}
+
+			if dup.ContentHash != nil {
+				t.Errorf("duplicate hash = %s, want nil", *dup.ContentHash)
+			}
+			if dup.MergedIntoID == nil || *dup.MergedIntoID != original.ID {
+				t.Errorf("duplicate merged into %v, want %d", dup.MergedIntoID, original.ID)
+			}
+			if dup.Visibility != tt.dupVisibility {
+				t.Errorf("duplicate visibility = %s, want %s", dup.Visibility, tt.dupVisibility)
+			}
+
+			members := bun.QuerySlots().Order(bundleslot.ByPosition()).QueryPokemon().IDsX(ctx)
+			if !slices.Equal(members, []int{original.ID, other.ID}) {
+				t.Errorf("bundle members = %v, want %d and %d", members, original.ID, other.ID)
+			}
+
+			if got := db.Bundle.GetX(ctx, bun.ID).Visibility; got != tt.bundle {
+				t.Errorf("bundle visibility = %s, want %s", got, tt.bundle)
+			}
+
+			// Merged duplicates are left alone from then on.
+			if err := backfillContentHashes(ctx, db); err != nil {
+				t.Fatal(err)
+			}
+			if got := db.Pokemon.GetX(ctx, original.ID).DownloadCount; got != 5 {
+				t.Errorf("original downloads after hashing again = %d, want 5", got)
+			}
+		})
+	}
+}
+
+// TestConvertBase64Pokemon stores the same pokemon as it comes in from PKSM in its different forms, they
+// all end up as the same canonical data and are merged into the first one.
+func TestConvertBase64Pokemon(t *testing.T) {
+	ctx, db := newTestDB(t)
+
+	files := []string{
+		"pk8_box_decrypted.pk8",
+		"pk8_box_encrypted.pk8",
+		"pk8_party_decrypted.pk8",
+		"pk8_party_encrypted.pk8",
+	}
+
+	var mons []*ent.Pokemon
+	for i, file := range files {
+		mons = append(mons, createPokemon(t, ctx, db, fmt.Sprintf("%010d", i+1), func(c *ent.PokemonCreate) {
+			c.SetBase64(base64.StdEncoding.EncodeToString(sample(t, file)))
+		}))
+	}
+	unreadable := createPokemon(t, ctx, db, "0000000009", func(c *ent.PokemonCreate) {
+		c.SetBase64(base64.StdEncoding.EncodeToString([]byte("not a pokemon")))
+	})
+
+	if err := convertBase64Pokemon(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+
+	want := sample(t, "pk8_box_decrypted.pk8")
+	for i, mon := range mons {
+		mon = db.Pokemon.GetX(ctx, mon.ID)
+		if string(mon.Data) != string(want) {
+			t.Errorf("%s wasn't stored in its canonical form", files[i])
+		}
+
+		if mon.Base64 != "" {
+			t.Errorf("%s still has its base64", files[i])
+		}
+
+		if i == 0 {
+			if mon.ContentHash == nil || *mon.ContentHash != ContentHash(want) {
+				t.Errorf("%s hash = %v, want %s", files[i], mon.ContentHash, ContentHash(want))
+			}
+			continue
+		}
+
+		if mon.MergedIntoID == nil || *mon.MergedIntoID != mons[0].ID || mon.Visibility != pokemon.VisibilityDeleted {
+			t.Errorf("%s wasn't merged into %s", files[i], files[0])
+		}
+	}
+
+	// A pokemon we can't read is stored as it is.
+	if got := db.Pokemon.GetX(ctx, unreadable.ID); string(got.Data) != "not a pokemon" || got.ContentHash == nil {
+		t.Errorf("unreadable pokemon = %q with hash %v, want it stored as it is", got.Data, got.ContentHash)
+	}
+}
@@ -0,0 +1,530 @@
+package database
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/mysql"
+	"ariga.io/atlas/sql/postgres"
+	atlas "ariga.io/atlas/sql/schema"
+	"ariga.io/atlas/sql/sqlite"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/FlagBrew/local-gpss/internal/database/ent"
+	entmigrate "github.com/FlagBrew/local-gpss/internal/database/ent/migrate"
+	"github.com/FlagBrew/local-gpss/internal/models"
+	"github.com/apex/log"
+)
+
+// MigrationsDir is where the migrations are kept in the repository, one directory per database type. They're
+// generated from the ent schema with --migrate diff and embedded in the binary.
+const MigrationsDir = "internal/database/migrations"
+
+// destructiveDirective marks a migration that can lose data, it's only applied once it's been approved.
+const destructiveDirective = "-- gpss:destructive "
+
+//go:embed migrations
+var migrations embed.FS
+
+// Migration is a migration and whether it's been applied to the database yet.
+type Migration struct {
+	Version     string
+	Description string
+	// Destructive lists what the migration does that can lose data.
+	Destructive []string
+	Applied     bool
+	// Baseline is set for the migrations the database already had when it was first versioned.
+	Baseline   bool
+	ExecutedAt time.Time
+	Error      string
+}
+
+// migrationDir returns the embedded migrations for the database type.
+func migrationDir(dbType string) (migrate.Dir, error) {
+	entries, err := fs.ReadDir(migrations, "migrations/"+dbType)
+	if err != nil {
+		return nil, fmt.Errorf("no migrations for database type %q: %w", dbType, err)
+	}
+
+	dir := &migrate.MemDir{}
+	for _, entry := range entries {
+		data, err := migrations.ReadFile("migrations/" + dbType + "/" + entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		if err := dir.WriteFile(entry.Name(), data); err != nil {
+			return nil, err
+		}
+	}
+
+	return dir, nil
+}
+
+// destructiveChanges reads the destructive directives at the top of a migration.
+func destructiveChanges(f migrate.File) []string {
+	var changes []string
+	scanner := bufio.NewScanner(bytes.NewReader(f.Bytes()))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "--") {
+			break
+		}
+
+		if change, ok := strings.CutPrefix(line, destructiveDirective); ok {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
+// migrator applies the migrations over a connection of its own, sqlite only keeps some settings the
+// migrations rely on (like foreign_keys) for the connection they were made on.
+type migrator struct {
+	dbType string
+	// db is the connection pool the connection was taken from, ent uses it to version existing databases.
+	db      *entsql.Driver
+	drv     migrate.Driver
+	dir     migrate.Dir
+	revs    *revisions
+	release func()
+}
+
+func newMigrator(ctx context.Context, cfg *models.DatabaseConfig) (*migrator, error) {
+	dir, err := migrationDir(cfg.DBType)
+	if err != nil {
+		return nil, err
+	}
+
+	drv, closeDrv, err := open(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := drv.DB().Conn(ctx)
+	if err != nil {
+		closeDrv()
+		return nil, err
+	}
+
+	m := &migrator{
+		dbType:  cfg.DBType,
+		db:      drv,
+		dir:     dir,
+		revs:    &revisions{conn: conn, dialect: drv.Dialect()},
+		release: func() { conn.Close(); closeDrv() },
+	}
+
+	switch drv.Dialect() {
+	case dialect.Postgres:
+		m.drv, err = postgres.Open(conn)
+	case dialect.MySQL:
+		m.drv, err = mysql.Open(conn)
+	default:
+		m.drv, err = sqlite.Open(conn)
+	}
+
+	if err == nil {
+		err = m.revs.init(ctx)
+	}
+
+	if err != nil {
+		m.close()
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (m *migrator) close() {
+	m.release()
+}
+
+// lock keeps other instances sharing the database from migrating it at the same time. SQLite databases
+// aren't shared and atlas would lock them with a file in the temp directory, so they're left alone.
+func (m *migrator) lock(ctx context.Context) (atlas.UnlockFunc, error) {
+	locker, ok := m.drv.(atlas.Locker)
+	if !ok || m.dbType == "sqlite" {
+		return func() error { return nil }, nil
+	}
+
+	return locker.Lock(ctx, "local_gpss_migrate", time.Minute)
+}
+
+// unversioned reports whether the database was created before migrations were versioned, it has tables
+// but no revisions.
+func (m *migrator) unversioned(ctx context.Context) (bool, error) {
+	revs, err := m.revs.ReadRevisions(ctx)
+	if err != nil || len(revs) > 0 {
+		return false, err
+	}
+
+	var notClean *migrate.NotCleanError
+	if err := m.drv.CheckClean(ctx, m.revs.Ident()); errors.As(err, &notClean) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+// moveLegacyMembers is what the add_bundle_slots migration moves the members of bundles from before they
+// had slots with, for the databases that skip it by being versioned after it. Bundles didn't keep the
+// order their members were uploaded in, and members could be existing pokemon, so the slots follow the
+// order of the pokemon IDs as a best effort.
+const moveLegacyMembers = `INSERT INTO bundle_slots (bundle_id, pokemon_id, position, generation)
+SELECT m.bundle_id, m.pokemon_id, (SELECT COUNT(*) FROM bundle_pokemons AS e WHERE e.bundle_id = m.bundle_id AND e.pokemon_id < m.pokemon_id), p.generation
+FROM bundle_pokemons AS m JOIN pokemons AS p ON p.id = m.pokemon_id`
+
+// hasLegacyMembers reports whether the members of bundles are still kept the way they were before slots.
+func (m *migrator) hasLegacyMembers(ctx context.Context) (bool, error) {
+	legacy, err := m.drv.InspectSchema(ctx, "", &atlas.InspectOptions{Tables: []string{"bundle_pokemons"}})
+	if err != nil {
+		return false, err
+	}
+
+	return len(legacy.Tables) > 0, nil
+}
+
+// logLegacyMembers warns that the slot order of the bundles about to be moved over is made up, see
+// moveLegacyMembers.
+func (m *migrator) logLegacyMembers(ctx context.Context) error {
+	var bundles int
+	if err := m.revs.conn.QueryRowContext(ctx, "SELECT COUNT(DISTINCT bundle_id) FROM bundle_pokemons").Scan(&bundles); err != nil {
+		return err
+	}
+
+	if bundles > 0 {
+		log.FromContext(ctx).WithField("bundles", bundles).Warn("moving bundle members to slots, the order they were uploaded in was never stored so they're put in the order of their pokemon IDs")
+	}
+
+	return nil
+}
+
+// createUnversioned brings a database from before versioned migrations up to date the way Migrate used
+// to, except for dropping the columns and indexes the schema doesn't have anymore. The data the
+// migrations since then move around is moved here too.
+func (m *migrator) createUnversioned(ctx context.Context) error {
+	if err := entmigrate.NewSchema(m.db).Create(ctx, genColumnHooks()...); err != nil {
+		return err
+	}
+
+	legacy, err := m.hasLegacyMembers(ctx)
+	if err != nil || !legacy {
+		return err
+	}
+
+	if err := m.logLegacyMembers(ctx); err != nil {
+		return err
+	}
+
+	// The slots take the generation of their pokemon, which could still be stored under its old name.
+	if err := normalizePokemonGenerations(ctx, ent.NewClient(ent.Driver(m.db))); err != nil {
+		return err
+	}
+
+	tx, err := m.db.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, moveLegacyMembers); err == nil {
+		_, err = tx.ExecContext(ctx, "DROP TABLE bundle_pokemons")
+	}
+
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to move the bundle members to their slots: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// status lists every migration and whether it's been applied.
+func (m *migrator) status(ctx context.Context) ([]Migration, error) {
+	files, err := m.dir.Files()
+	if err != nil {
+		return nil, err
+	}
+
+	revs, err := m.revs.ReadRevisions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := map[string]*migrate.Revision{}
+	var baseline string
+	for _, rev := range revs {
+		applied[rev.Version] = rev
+		if rev.Type.Has(migrate.RevisionTypeBaseline) {
+			baseline = rev.Version
+		}
+	}
+
+	statuses := make([]Migration, 0, len(files))
+	for _, f := range files {
+		status := Migration{
+			Version:     f.Version(),
+			Description: f.Desc(),
+			Destructive: destructiveChanges(f),
+			Baseline:    f.Version() <= baseline,
+			Applied:     f.Version() <= baseline,
+		}
+
+		if rev, ok := applied[f.Version()]; ok {
+			status.Applied = rev.Applied == rev.Total && rev.Error == ""
+			status.ExecutedAt = rev.ExecutedAt
+			status.Error = rev.Error
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// up applies the pending migrations. A database from before versioned migrations is brought up to date the
+// way it used to be, without dropping anything, and marked as having every migration applied.
+func (m *migrator) up(ctx context.Context, approveDestructive bool) error {
+	logger := log.FromContext(ctx)
+
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	files, err := m.dir.Files()
+	if err != nil || len(files) == 0 {
+		return err
+	}
+
+	// A database without any revisions is either empty or unversioned, either way there's nothing the
+	// migrations applied to it can lose.
+	revs, err := m.revs.ReadRevisions(ctx)
+	if err != nil {
+		return err
+	}
+
+	var opts []migrate.ExecutorOption
+	unversioned, err := m.unversioned(ctx)
+	if err != nil {
+		return err
+	}
+
+	if unversioned {
+		latest := files[len(files)-1].Version()
+		logger.WithField("version", latest).Info("versioning existing database")
+
+		if err := m.createUnversioned(ctx); err != nil {
+			return err
+		}
+
+		opts = append(opts, migrate.WithBaselineVersion(latest))
+	}
+
+	executor, err := migrate.NewExecutor(m.drv, m.dir, m.revs, append(opts, migrate.WithLogger(migrationLogger{logger}))...)
+	if err != nil {
+		return err
+	}
+
+	pending, err := executor.Pending(ctx)
+	if errors.Is(err, migrate.ErrNoPendingFiles) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var destructive []string
+	for _, f := range pending {
+		for _, change := range destructiveChanges(f) {
+			destructive = append(destructive, f.Version()+": "+change)
+		}
+	}
+
+	if len(destructive) > 0 && len(revs) > 0 && !approveDestructive {
+		return &DestructiveError{Changes: destructive}
+	}
+
+	if legacy, err := m.hasLegacyMembers(ctx); err != nil {
+		return err
+	} else if legacy {
+		if err := m.logLegacyMembers(ctx); err != nil {
+			return err
+		}
+	}
+
+	return executor.ExecuteN(ctx, 0)
+}
+
+// DestructiveError is returned when pending migrations can lose data and they haven't been approved.
+type DestructiveError struct {
+	Changes []string
+}
+
+func (e *DestructiveError) Error() string {
+	return "pending migrations can lose data: " + strings.Join(e.Changes, ", ")
+}
+
+// migrationLogger logs the progress of the atlas executor.
+type migrationLogger struct {
+	logger log.Interface
+}
+
+func (l migrationLogger) Log(entry migrate.LogEntry) {
+	switch entry := entry.(type) {
+	case migrate.LogFile:
+		l.logger.WithField("version", entry.Version).Info("applying migration")
+	case migrate.LogError:
+		l.logger.WithError(entry.Error).WithField("statement", entry.SQL).Error("migration failed")
+	}
+}
+
+// MigrationStatus lists the migrations for the database and whether they've been applied.
+func MigrationStatus(ctx context.Context, cfg *models.DatabaseConfig) ([]Migration, error) {
+	m, err := newMigrator(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	defer m.close()
+
+	unversioned, err := m.unversioned(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if unversioned {
+		return nil, errors.New("the database predates versioned migrations, it's versioned the next time Local GPSS starts or by --migrate up")
+	}
+
+	return m.status(ctx)
+}
+
+// Diff writes a migration for the changes made to the ent schema since the last migration, named after
+// name. The existing migrations are replayed on dev, an empty database of the same type, to find out
+// what they add up to. It returns the path of the new migration, or an empty string when nothing changed.
+func Diff(ctx context.Context, dev *models.DatabaseConfig, name string) (string, error) {
+	path := filepath.Join(MigrationsDir, dev.DBType)
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		return "", err
+	}
+
+	dir, err := migrate.NewLocalDir(path)
+	if err != nil {
+		return "", err
+	}
+
+	before, err := dir.Files()
+	if err != nil {
+		return "", err
+	}
+
+	drv, closeDrv, err := open(ctx, dev)
+	if err != nil {
+		return "", err
+	}
+	defer closeDrv()
+
+	m, err := schema.NewMigrate(drv,
+		schema.WithDir(dir),
+		schema.WithMigrationMode(schema.ModeReplay),
+		schema.WithDialect(drv.Dialect()),
+		schema.WithFormatter(destructiveFormatter{}),
+		schema.WithDropColumn(true),
+		schema.WithDropIndex(true),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if err := m.NamedDiff(ctx, name, entmigrate.Tables...); err != nil {
+		return "", err
+	}
+
+	after, err := dir.Files()
+	if err != nil {
+		return "", err
+	}
+
+	for _, f := range after {
+		if !slices.ContainsFunc(before, func(b migrate.File) bool { return b.Name() == f.Name() }) {
+			return filepath.Join(path, f.Name()), nil
+		}
+	}
+
+	return "", nil
+}
+
+// destructiveFormatter formats migrations like atlas does, with a destructive directive for every change
+// that can lose data.
+type destructiveFormatter struct{}
+
+func (destructiveFormatter) Format(plan *migrate.Plan) ([]migrate.File, error) {
+	files, err := migrate.DefaultFormatter.Format(plan)
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite rebuilds a table in several steps which all come from the same change.
+	var reasons []string
+	for _, change := range plan.Changes {
+		for _, reason := range lossyChanges(change.Source) {
+			if !slices.Contains(reasons, reason) {
+				reasons = append(reasons, reason)
+			}
+		}
+	}
+
+	if len(reasons) == 0 {
+		return files, nil
+	}
+
+	var header strings.Builder
+	for _, reason := range reasons {
+		header.WriteString(destructiveDirective + reason + "\n")
+	}
+	header.WriteString("\n")
+	for i, f := range files {
+		files[i] = migrate.NewLocalFile(f.Name(), append([]byte(header.String()), f.Bytes()...))
+	}
+
+	return files, nil
+}
+
+// lossyChanges describes the parts of a schema change that can lose data: dropping tables and columns,
+// or changing the type of a column.
+func lossyChanges(change atlas.Change) []string {
+	switch change := change.(type) {
+	case *atlas.DropTable:
+		return []string{fmt.Sprintf("drops table %q", change.T.Name)}
+	case *atlas.ModifyTable:
+		var reasons []string
+		for _, c := range change.Changes {
+			switch c := c.(type) {
+			case *atlas.DropColumn:
+				reasons = append(reasons, fmt.Sprintf("drops column %q of table %q", c.C.Name, change.T.Name))
+			case *atlas.ModifyColumn:
+				if c.Change.Is(atlas.ChangeType) {
+					reasons = append(reasons, fmt.Sprintf("changes the type of column %q of table %q", c.To.Name, change.T.Name))
+				}
+			}
+		}
+		return reasons
+	}
+
+	return nil
+}
@@ -0,0 +1,10 @@
+-- Create "bundles" table
+CREATE TABLE `bundles` (`id` bigint NOT NULL AUTO_INCREMENT, `upload_datetime` timestamp NOT NULL, `download_code` varchar(255) NOT NULL, `download_count` bigint NOT NULL DEFAULT 0, `legal` bool NOT NULL, `min_gen` double NOT NULL, `max_gen` double NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `download_code` (`download_code`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "legality_caches" table
+CREATE TABLE `legality_caches` (`id` bigint NOT NULL AUTO_INCREMENT, `hash` varchar(255) NOT NULL, `generation` varchar(255) NOT NULL, `engine_version` varchar(255) NOT NULL, `legal` bool NOT NULL, `report` json NOT NULL, `created_at` timestamp NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `legalitycache_hash_generation_engine_version` (`hash`, `generation`, `engine_version`), INDEX `legalitycache_engine_version` (`engine_version`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "recheck_jobs" table
+CREATE TABLE `recheck_jobs` (`id` bigint NOT NULL AUTO_INCREMENT, `engine_version` varchar(255) NOT NULL, `status` enum('running','done') NOT NULL, `total` bigint NOT NULL DEFAULT 0, `checked` bigint NOT NULL DEFAULT 0, `failed` bigint NOT NULL DEFAULT 0, `last_pokemon_id` bigint NOT NULL DEFAULT 0, `started_at` timestamp NOT NULL, `finished_at` timestamp NULL, PRIMARY KEY (`id`), UNIQUE INDEX `engine_version` (`engine_version`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "pokemons" table
+CREATE TABLE `pokemons` (`id` bigint NOT NULL AUTO_INCREMENT, `upload_datetime` timestamp NOT NULL, `download_code` varchar(255) NOT NULL, `download_count` bigint NOT NULL DEFAULT 0, `generation` varchar(255) NOT NULL, `legal` bool NOT NULL, `data` blob NULL, `base_64` varchar(255) NULL, `content_hash` varchar(255) NULL, `legality_report` json NULL, `engine_version` varchar(255) NULL, `legality_checked_at` timestamp NULL, `species` bigint NULL, `form` bigint NULL, `level` bigint NULL, `shiny` bool NULL, `nature` bigint NULL, `gender` bigint NULL, `ot_name` varchar(255) NULL, `tid` bigint NULL, `sid` bigint NULL, `ball` bigint NULL, `held_item` bigint NULL, `language` bigint NULL, `origin_game` bigint NULL, PRIMARY KEY (`id`), UNIQUE INDEX `download_code` (`download_code`), UNIQUE INDEX `content_hash` (`content_hash`)) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Create "bundle_pokemons" table
+CREATE TABLE `bundle_pokemons` (`bundle_id` bigint NOT NULL, `pokemon_id` bigint NOT NULL, PRIMARY KEY (`bundle_id`, `pokemon_id`), CONSTRAINT `bundle_pokemons_bundle_id` FOREIGN KEY (`bundle_id`) REFERENCES `bundles` (`id`) ON DELETE CASCADE, CONSTRAINT `bundle_pokemons_pokemon_id` FOREIGN KEY (`pokemon_id`) REFERENCES `pokemons` (`id`) ON DELETE CASCADE) CHARSET utf8mb4 COLLATE utf8mb4_bin;
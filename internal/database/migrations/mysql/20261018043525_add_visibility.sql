@@ -0,0 +1,4 @@
+-- Modify "bundles" table
+ALTER TABLE `bundles` ADD COLUMN `visibility` enum('visible','hidden','deleted') NOT NULL DEFAULT 'visible', ADD COLUMN `visibility_changed_at` timestamp NULL, ADD COLUMN `visibility_reason` varchar(255) NULL, ADD INDEX `bundle_visibility` (`visibility`);
+-- Modify "pokemons" table
+ALTER TABLE `pokemons` ADD COLUMN `visibility` enum('visible','hidden','deleted') NOT NULL DEFAULT 'visible', ADD COLUMN `visibility_changed_at` timestamp NULL, ADD COLUMN `visibility_reason` varchar(255) NULL, ADD INDEX `pokemon_visibility` (`visibility`);
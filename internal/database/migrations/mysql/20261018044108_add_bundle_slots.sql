@@ -0,0 +1,8 @@
+-- gpss:destructive drops table "bundle_pokemons"
+
+-- Create "bundle_slots" table
+CREATE TABLE `bundle_slots` (`id` bigint NOT NULL AUTO_INCREMENT, `position` bigint NOT NULL, `generation` varchar(255) NOT NULL, `bundle_id` bigint NOT NULL, `pokemon_id` bigint NOT NULL, PRIMARY KEY (`id`), UNIQUE INDEX `bundleslot_bundle_id_position` (`bundle_id`, `position`), INDEX `bundleslot_pokemon_id` (`pokemon_id`), CONSTRAINT `bundle_slots_bundles_slots` FOREIGN KEY (`bundle_id`) REFERENCES `bundles` (`id`) ON DELETE CASCADE, CONSTRAINT `bundle_slots_pokemons_bundle_slots` FOREIGN KEY (`pokemon_id`) REFERENCES `pokemons` (`id`) ON DELETE CASCADE) CHARSET utf8mb4 COLLATE utf8mb4_bin;
+-- Move the members of "bundle_pokemons" to slots, the order they were uploaded in was never stored so they are put in the order of their IDs
+INSERT INTO `bundle_slots` (`bundle_id`, `pokemon_id`, `position`, `generation`) SELECT m.`bundle_id`, m.`pokemon_id`, (SELECT COUNT(*) FROM `bundle_pokemons` AS e WHERE e.`bundle_id` = m.`bundle_id` AND e.`pokemon_id` < m.`pokemon_id`), p.`generation` FROM `bundle_pokemons` AS m JOIN `pokemons` AS p ON p.`id` = m.`pokemon_id`;
+-- Drop "bundle_pokemons" table
+DROP TABLE `bundle_pokemons`;
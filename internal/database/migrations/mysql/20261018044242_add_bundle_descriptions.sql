@@ -0,0 +1,2 @@
+-- Modify "bundles" table
+ALTER TABLE `bundles` ADD COLUMN `title` varchar(255) NULL, ADD COLUMN `description` longtext NULL, ADD COLUMN `notes` longtext NULL;
@@ -0,0 +1,22 @@
+-- Create "bundles" table
+CREATE TABLE "bundles" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "upload_datetime" timestamptz NOT NULL, "download_code" character varying NOT NULL, "download_count" bigint NOT NULL DEFAULT 0, "legal" boolean NOT NULL, "min_gen" double precision NOT NULL, "max_gen" double precision NOT NULL, PRIMARY KEY ("id"));
+-- Create index "bundles_download_code_key" to table: "bundles"
+CREATE UNIQUE INDEX "bundles_download_code_key" ON "bundles" ("download_code");
+-- Create "legality_caches" table
+CREATE TABLE "legality_caches" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "hash" character varying NOT NULL, "generation" character varying NOT NULL, "engine_version" character varying NOT NULL, "legal" boolean NOT NULL, "report" jsonb NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- Create index "legalitycache_hash_generation_engine_version" to table: "legality_caches"
+CREATE UNIQUE INDEX "legalitycache_hash_generation_engine_version" ON "legality_caches" ("hash", "generation", "engine_version");
+-- Create index "legalitycache_engine_version" to table: "legality_caches"
+CREATE INDEX "legalitycache_engine_version" ON "legality_caches" ("engine_version");
+-- Create "recheck_jobs" table
+CREATE TABLE "recheck_jobs" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "engine_version" character varying NOT NULL, "status" character varying NOT NULL, "total" bigint NOT NULL DEFAULT 0, "checked" bigint NOT NULL DEFAULT 0, "failed" bigint NOT NULL DEFAULT 0, "last_pokemon_id" bigint NOT NULL DEFAULT 0, "started_at" timestamptz NOT NULL, "finished_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- Create index "recheck_jobs_engine_version_key" to table: "recheck_jobs"
+CREATE UNIQUE INDEX "recheck_jobs_engine_version_key" ON "recheck_jobs" ("engine_version");
+-- Create "pokemons" table
+CREATE TABLE "pokemons" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "upload_datetime" timestamptz NOT NULL, "download_code" character varying NOT NULL, "download_count" bigint NOT NULL DEFAULT 0, "generation" character varying NOT NULL, "legal" boolean NOT NULL, "data" bytea NULL, "base_64" character varying NULL, "content_hash" character varying NULL, "legality_report" jsonb NULL, "engine_version" character varying NULL, "legality_checked_at" timestamptz NULL, "species" bigint NULL, "form" bigint NULL, "level" bigint NULL, "shiny" boolean NULL, "nature" bigint NULL, "gender" bigint NULL, "ot_name" character varying NULL, "tid" bigint NULL, "sid" bigint NULL, "ball" bigint NULL, "held_item" bigint NULL, "language" bigint NULL, "origin_game" bigint NULL, PRIMARY KEY ("id"));
+-- Create index "pokemons_download_code_key" to table: "pokemons"
+CREATE UNIQUE INDEX "pokemons_download_code_key" ON "pokemons" ("download_code");
+-- Create index "pokemons_content_hash_key" to table: "pokemons"
+CREATE UNIQUE INDEX "pokemons_content_hash_key" ON "pokemons" ("content_hash");
+-- Create "bundle_pokemons" table
+CREATE TABLE "bundle_pokemons" ("bundle_id" bigint NOT NULL, "pokemon_id" bigint NOT NULL, PRIMARY KEY ("bundle_id", "pokemon_id"), CONSTRAINT "bundle_pokemons_bundle_id" FOREIGN KEY ("bundle_id") REFERENCES "bundles" ("id") ON DELETE CASCADE, CONSTRAINT "bundle_pokemons_pokemon_id" FOREIGN KEY ("pokemon_id") REFERENCES "pokemons" ("id") ON DELETE CASCADE);
@@ -0,0 +1,8 @@
+-- Modify "bundles" table
+ALTER TABLE "bundles" ADD COLUMN "visibility" character varying NOT NULL DEFAULT 'visible', ADD COLUMN "visibility_changed_at" timestamptz NULL, ADD COLUMN "visibility_reason" character varying NULL;
+-- Create index "bundle_visibility" to table: "bundles"
+CREATE INDEX "bundle_visibility" ON "bundles" ("visibility");
+-- Modify "pokemons" table
+ALTER TABLE "pokemons" ADD COLUMN "visibility" character varying NOT NULL DEFAULT 'visible', ADD COLUMN "visibility_changed_at" timestamptz NULL, ADD COLUMN "visibility_reason" character varying NULL;
+-- Create index "pokemon_visibility" to table: "pokemons"
+CREATE INDEX "pokemon_visibility" ON "pokemons" ("visibility");
@@ -0,0 +1,12 @@
+-- gpss:destructive drops table "bundle_pokemons"
+
+-- Create "bundle_slots" table
+CREATE TABLE "bundle_slots" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "position" bigint NOT NULL, "generation" character varying NOT NULL, "bundle_id" bigint NOT NULL, "pokemon_id" bigint NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "bundle_slots_bundles_slots" FOREIGN KEY ("bundle_id") REFERENCES "bundles" ("id") ON DELETE CASCADE, CONSTRAINT "bundle_slots_pokemons_bundle_slots" FOREIGN KEY ("pokemon_id") REFERENCES "pokemons" ("id") ON DELETE CASCADE);
+-- Create index "bundleslot_bundle_id_position" to table: "bundle_slots"
+CREATE UNIQUE INDEX "bundleslot_bundle_id_position" ON "bundle_slots" ("bundle_id", "position");
+-- Create index "bundleslot_pokemon_id" to table: "bundle_slots"
+CREATE INDEX "bundleslot_pokemon_id" ON "bundle_slots" ("pokemon_id");
+-- Move the members of "bundle_pokemons" to slots, the order they were uploaded in was never stored so they are put in the order of their IDs
+INSERT INTO "bundle_slots" ("bundle_id", "pokemon_id", "position", "generation") SELECT m."bundle_id", m."pokemon_id", (SELECT COUNT(*) FROM "bundle_pokemons" AS e WHERE e."bundle_id" = m."bundle_id" AND e."pokemon_id" < m."pokemon_id"), p."generation" FROM "bundle_pokemons" AS m JOIN "pokemons" AS p ON p."id" = m."pokemon_id";
+-- Drop "bundle_pokemons" table
+DROP TABLE "bundle_pokemons";
@@ -0,0 +1,2 @@
+-- Modify "bundles" table
+ALTER TABLE "bundles" ADD COLUMN "title" character varying NULL, ADD COLUMN "description" text NULL, ADD COLUMN "notes" text NULL;
@@ -0,0 +1,2 @@
+-- Modify "pokemons" table
+ALTER TABLE "pokemons" ADD COLUMN "merged_into_id" bigint NULL, ADD CONSTRAINT "pokemons_pokemons_duplicates" FOREIGN KEY ("merged_into_id") REFERENCES "pokemons" ("id") ON DELETE SET NULL;
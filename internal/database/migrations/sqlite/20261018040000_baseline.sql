@@ -0,0 +1,22 @@
+-- Create "bundles" table
+CREATE TABLE `bundles` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `upload_datetime` datetime NOT NULL, `download_code` text NOT NULL, `download_count` integer NOT NULL DEFAULT (0), `legal` bool NOT NULL, `min_gen` real NOT NULL, `max_gen` real NOT NULL);
+-- Create index "bundles_download_code_key" to table: "bundles"
+CREATE UNIQUE INDEX `bundles_download_code_key` ON `bundles` (`download_code`);
+-- Create "legality_caches" table
+CREATE TABLE `legality_caches` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `hash` text NOT NULL, `generation` text NOT NULL, `engine_version` text NOT NULL, `legal` bool NOT NULL, `report` json NOT NULL, `created_at` datetime NOT NULL);
+-- Create index "legalitycache_hash_generation_engine_version" to table: "legality_caches"
+CREATE UNIQUE INDEX `legalitycache_hash_generation_engine_version` ON `legality_caches` (`hash`, `generation`, `engine_version`);
+-- Create index "legalitycache_engine_version" to table: "legality_caches"
+CREATE INDEX `legalitycache_engine_version` ON `legality_caches` (`engine_version`);
+-- Create "pokemons" table
+CREATE TABLE `pokemons` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `upload_datetime` datetime NOT NULL, `download_code` text NOT NULL, `download_count` integer NOT NULL DEFAULT (0), `generation` text NOT NULL, `legal` bool NOT NULL, `data` blob NULL, `base_64` text NULL, `content_hash` text NULL, `legality_report` json NULL, `engine_version` text NULL, `legality_checked_at` datetime NULL, `species` integer NULL, `form` integer NULL, `level` integer NULL, `shiny` bool NULL, `nature` integer NULL, `gender` integer NULL, `ot_name` text NULL, `tid` integer NULL, `sid` integer NULL, `ball` integer NULL, `held_item` integer NULL, `language` integer NULL, `origin_game` integer NULL);
+-- Create index "pokemons_download_code_key" to table: "pokemons"
+CREATE UNIQUE INDEX `pokemons_download_code_key` ON `pokemons` (`download_code`);
+-- Create index "pokemons_content_hash_key" to table: "pokemons"
+CREATE UNIQUE INDEX `pokemons_content_hash_key` ON `pokemons` (`content_hash`);
+-- Create "recheck_jobs" table
+CREATE TABLE `recheck_jobs` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `engine_version` text NOT NULL, `status` text NOT NULL, `total` integer NOT NULL DEFAULT (0), `checked` integer NOT NULL DEFAULT (0), `failed` integer NOT NULL DEFAULT (0), `last_pokemon_id` integer NOT NULL DEFAULT (0), `started_at` datetime NOT NULL, `finished_at` datetime NULL);
+-- Create index "recheck_jobs_engine_version_key" to table: "recheck_jobs"
+CREATE UNIQUE INDEX `recheck_jobs_engine_version_key` ON `recheck_jobs` (`engine_version`);
+-- Create "bundle_pokemons" table
+CREATE TABLE `bundle_pokemons` (`bundle_id` integer NOT NULL, `pokemon_id` integer NOT NULL, PRIMARY KEY (`bundle_id`, `pokemon_id`), CONSTRAINT `bundle_pokemons_bundle_id` FOREIGN KEY (`bundle_id`) REFERENCES `bundles` (`id`) ON DELETE CASCADE, CONSTRAINT `bundle_pokemons_pokemon_id` FOREIGN KEY (`pokemon_id`) REFERENCES `pokemons` (`id`) ON DELETE CASCADE);
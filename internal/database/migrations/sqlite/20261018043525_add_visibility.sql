@@ -0,0 +1,30 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_bundles" table
+CREATE TABLE `new_bundles` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `visibility` text NOT NULL DEFAULT ('visible'), `visibility_changed_at` datetime NULL, `visibility_reason` text NULL, `upload_datetime` datetime NOT NULL, `download_code` text NOT NULL, `download_count` integer NOT NULL DEFAULT (0), `legal` bool NOT NULL, `min_gen` real NOT NULL, `max_gen` real NOT NULL);
+-- Copy rows from old table "bundles" to new temporary table "new_bundles"
+INSERT INTO `new_bundles` (`id`, `upload_datetime`, `download_code`, `download_count`, `legal`, `min_gen`, `max_gen`) SELECT `id`, `upload_datetime`, `download_code`, `download_count`, `legal`, `min_gen`, `max_gen` FROM `bundles`;
+-- Drop "bundles" table after copying rows
+DROP TABLE `bundles`;
+-- Rename temporary table "new_bundles" to "bundles"
+ALTER TABLE `new_bundles` RENAME TO `bundles`;
+-- Create index "bundles_download_code_key" to table: "bundles"
+CREATE UNIQUE INDEX `bundles_download_code_key` ON `bundles` (`download_code`);
+-- Create index "bundle_visibility" to table: "bundles"
+CREATE INDEX `bundle_visibility` ON `bundles` (`visibility`);
+-- Create "new_pokemons" table
+CREATE TABLE `new_pokemons` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `visibility` text NOT NULL DEFAULT ('visible'), `visibility_changed_at` datetime NULL, `visibility_reason` text NULL, `upload_datetime` datetime NOT NULL, `download_code` text NOT NULL, `download_count` integer NOT NULL DEFAULT (0), `generation` text NOT NULL, `legal` bool NOT NULL, `data` blob NULL, `base_64` text NULL, `content_hash` text NULL, `legality_report` json NULL, `engine_version` text NULL, `legality_checked_at` datetime NULL, `species` integer NULL, `form` integer NULL, `level` integer NULL, `shiny` bool NULL, `nature` integer NULL, `gender` integer NULL, `ot_name` text NULL, `tid` integer NULL, `sid` integer NULL, `ball` integer NULL, `held_item` integer NULL, `language` integer NULL, `origin_game` integer NULL);
+-- Copy rows from old table "pokemons" to new temporary table "new_pokemons"
+INSERT INTO `new_pokemons` (`id`, `upload_datetime`, `download_code`, `download_count`, `generation`, `legal`, `data`, `base_64`, `content_hash`, `legality_report`, `engine_version`, `legality_checked_at`, `species`, `form`, `level`, `shiny`, `nature`, `gender`, `ot_name`, `tid`, `sid`, `ball`, `held_item`, `language`, `origin_game`) SELECT `id`, `upload_datetime`, `download_code`, `download_count`, `generation`, `legal`, `data`, `base_64`, `content_hash`, `legality_report`, `engine_version`, `legality_checked_at`, `species`, `form`, `level`, `shiny`, `nature`, `gender`, `ot_name`, `tid`, `sid`, `ball`, `held_item`, `language`, `origin_game` FROM `pokemons`;
+-- Drop "pokemons" table after copying rows
+DROP TABLE `pokemons`;
+-- Rename temporary table "new_pokemons" to "pokemons"
+ALTER TABLE `new_pokemons` RENAME TO `pokemons`;
+-- Create index "pokemons_download_code_key" to table: "pokemons"
+CREATE UNIQUE INDEX `pokemons_download_code_key` ON `pokemons` (`download_code`);
+-- Create index "pokemons_content_hash_key" to table: "pokemons"
+CREATE UNIQUE INDEX `pokemons_content_hash_key` ON `pokemons` (`content_hash`);
+-- Create index "pokemon_visibility" to table: "pokemons"
+CREATE INDEX `pokemon_visibility` ON `pokemons` (`visibility`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,16 @@
+-- gpss:destructive drops table "bundle_pokemons"
+
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "bundle_slots" table
+CREATE TABLE `bundle_slots` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `position` integer NOT NULL, `generation` text NOT NULL, `bundle_id` integer NOT NULL, `pokemon_id` integer NOT NULL, CONSTRAINT `bundle_slots_bundles_slots` FOREIGN KEY (`bundle_id`) REFERENCES `bundles` (`id`) ON DELETE CASCADE, CONSTRAINT `bundle_slots_pokemons_bundle_slots` FOREIGN KEY (`pokemon_id`) REFERENCES `pokemons` (`id`) ON DELETE CASCADE);
+-- Create index "bundleslot_bundle_id_position" to table: "bundle_slots"
+CREATE UNIQUE INDEX `bundleslot_bundle_id_position` ON `bundle_slots` (`bundle_id`, `position`);
+-- Create index "bundleslot_pokemon_id" to table: "bundle_slots"
+CREATE INDEX `bundleslot_pokemon_id` ON `bundle_slots` (`pokemon_id`);
+-- Move the members of "bundle_pokemons" to slots, the order they were uploaded in was never stored so they are put in the order of their IDs
+INSERT INTO `bundle_slots` (`bundle_id`, `pokemon_id`, `position`, `generation`) SELECT m.`bundle_id`, m.`pokemon_id`, (SELECT COUNT(*) FROM `bundle_pokemons` AS e WHERE e.`bundle_id` = m.`bundle_id` AND e.`pokemon_id` < m.`pokemon_id`), p.`generation` FROM `bundle_pokemons` AS m JOIN `pokemons` AS p ON p.`id` = m.`pokemon_id`;
+-- Drop "bundle_pokemons" table
+DROP TABLE `bundle_pokemons`;
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
@@ -0,0 +1,6 @@
+-- Add column "title" to table: "bundles"
+ALTER TABLE `bundles` ADD COLUMN `title` text NULL;
+-- Add column "description" to table: "bundles"
+ALTER TABLE `bundles` ADD COLUMN `description` text NULL;
+-- Add column "notes" to table: "bundles"
+ALTER TABLE `bundles` ADD COLUMN `notes` text NULL;
@@ -0,0 +1,205 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"ariga.io/atlas/sql/migrate"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
+	"github.com/FlagBrew/local-gpss/internal/generation"
+	"github.com/FlagBrew/local-gpss/internal/models"
+	"github.com/apex/log"
+	"github.com/apex/log/handlers/memory"
+)
+
+// newTestMigrator returns a migrator for a fresh sqlite database with the first n migrations applied.
+func newTestMigrator(t *testing.T, ctx context.Context, n int) (*models.DatabaseConfig, *migrator) {
+	t.Helper()
+
+	cfg := testConfig(t)
+	m, err := newMigrator(ctx, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(m.close)
+
+	if n > 0 {
+		executor, err := migrate.NewExecutor(m.drv, m.dir, m.revs)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := executor.ExecuteN(ctx, n); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return cfg, m
+}
+
+// seedLegacyBundle stores a bundle the way it was before bundles had slots. Its members are added out of
+// order and one of them has its generation stored under its old name.
+func seedLegacyBundle(t *testing.T, ctx context.Context, m *migrator) {
+	t.Helper()
+
+	for _, query := range []string{
+		"INSERT INTO pokemons (id, upload_datetime, download_code, generation, legal) VALUES (1, CURRENT_TIMESTAMP, '0000000001', 'BDSP', true)",
+		"INSERT INTO pokemons (id, upload_datetime, download_code, generation, legal) VALUES (2, CURRENT_TIMESTAMP, '0000000002', '8', true)",
+		"INSERT INTO pokemons (id, upload_datetime, download_code, generation, legal) VALUES (3, CURRENT_TIMESTAMP, '0000000003', '8', true)",
+		"INSERT INTO bundles (id, upload_datetime, download_code, legal, min_gen, max_gen) VALUES (1, CURRENT_TIMESTAMP, '1000000001', true, 8, 8.2)",
+		"INSERT INTO bundle_pokemons (bundle_id, pokemon_id) VALUES (1, 3)",
+		"INSERT INTO bundle_pokemons (bundle_id, pokemon_id) VALUES (1, 1)",
+	} {
+		if _, err := m.revs.conn.ExecContext(ctx, query); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// checkSlots checks the legacy bundle ended up with its members in the order of their IDs.
+func checkSlots(t *testing.T, ctx context.Context, cfg *models.DatabaseConfig, generations ...string) {
+	t.Helper()
+
+	db := New(ctx, cfg)
+	defer db.Close()
+
+	slots := db.BundleSlot.Query().Where(bundleslot.BundleID(1)).Order(bundleslot.ByPosition()).AllX(ctx)
+
+	var members []int
+	var gens []string
+	for _, slot := range slots {
+		members = append(members, slot.PokemonID)
+		gens = append(gens, slot.Generation)
+	}
+
+	if !slices.Equal(members, []int{1, 3}) {
+		t.Errorf("bundle members = %v, want [1 3]", members)
+	}
+
+	if generations != nil && !slices.Equal(gens, generations) {
+		t.Errorf("slot generations = %v, want %v", gens, generations)
+	}
+}
+
+// warnedSlotOrder reports whether the migration warned that the slot order of a bundle is made up.
+func warnedSlotOrder(logs *memory.Handler) bool {
+	return slices.ContainsFunc(logs.Entries, func(e *log.Entry) bool {
+		return e.Level == log.WarnLevel && e.Fields.Get("bundles") == 1
+	})
+}
+
+func TestMigrateEmpty(t *testing.T) {
+	ctx := testContext()
+	_, m := newTestMigrator(t, ctx, 0)
+
+	// There's nothing to lose in an empty database, so nothing needs to be approved.
+	if err := m.up(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+
+	statuses, err := m.status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, status := range statuses {
+		if !status.Applied || status.Baseline {
+			t.Errorf("migration %s = %+v, want it applied", status.Version, status)
+		}
+	}
+}
+
+func TestMigrateUnversioned(t *testing.T) {
+	logs := memory.New()
+	ctx := log.NewContext(context.Background(), &log.Logger{Handler: logs, Level: log.WarnLevel})
+	cfg, m := newTestMigrator(t, ctx, 1)
+	seedLegacyBundle(t, ctx, m)
+
+	// Forget the migration ever ran, which leaves the tables as the automatic migration created them.
+	if _, err := m.revs.conn.ExecContext(ctx, "DELETE FROM "+revisionsTable); err != nil {
+		t.Fatal(err)
+	}
+
+	if unversioned, err := m.unversioned(ctx); err != nil || !unversioned {
+		t.Fatalf("unversioned = %t, %v, want true", unversioned, err)
+	}
+
+	// Nothing is dropped by versioning a database, so nothing needs to be approved.
+	if err := m.up(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+
+	statuses, err := m.status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, status := range statuses {
+		if !status.Applied || !status.Baseline {
+			t.Errorf("migration %s = %+v, want it part of the baseline", status.Version, status)
+		}
+	}
+
+	checkSlots(t, ctx, cfg, generation.BDSP.String(), "8")
+	if !warnedSlotOrder(logs) {
+		t.Error("no warning that the slot order is made up")
+	}
+
+	legacy, err := m.drv.InspectSchema(ctx, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := legacy.Table("bundle_pokemons"); ok {
+		t.Error("bundle_pokemons wasn't dropped")
+	}
+}
+
+func TestMigrateDestructive(t *testing.T) {
+	logs := memory.New()
+	ctx := log.NewContext(context.Background(), &log.Logger{Handler: logs, Level: log.WarnLevel})
+
+	// The bundle slots migration is the third one, it drops the table the members were kept in.
+	cfg, m := newTestMigrator(t, ctx, 2)
+	seedLegacyBundle(t, ctx, m)
+
+	var destructive *DestructiveError
+	if err := m.up(ctx, false); !errors.As(err, &destructive) {
+		t.Fatalf("got %v, want a DestructiveError", err)
+	}
+
+	if len(destructive.Changes) != 1 || !strings.Contains(destructive.Changes[0], `drops table "bundle_pokemons"`) {
+		t.Errorf("changes = %q, want the drop of bundle_pokemons", destructive.Changes)
+	}
+
+	statuses, err := m.status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, status := range statuses {
+		if status.Applied != (i < 2) {
+			t.Errorf("migration %s applied = %t after being refused", status.Version, status.Applied)
+		}
+	}
+
+	if err := m.up(ctx, true); err != nil {
+		t.Fatal(err)
+	}
+
+	checkSlots(t, ctx, cfg)
+	if !warnedSlotOrder(logs) {
+		t.Error("no warning that the slot order is made up")
+	}
+
+	// The migrated database works with the current schema.
+	db := New(ctx, cfg)
+	defer db.Close()
+
+	mon := db.Pokemon.GetX(ctx, 2)
+	if mon.DownloadCode != "0000000002" {
+		t.Errorf("download code = %s, want 0000000002", mon.DownloadCode)
+	}
+}
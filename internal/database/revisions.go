@@ -0,0 +1,150 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+// revisionsTable keeps track of the applied migrations, it's managed outside of the migrations themselves.
+const revisionsTable = "schema_revisions"
+
+var revisionColumns = []string{
+	"version", "description", "type", "applied", "total", "executed_at", "execution_time",
+	"error", "error_stmt", "hash", "partial_hashes", "operator_version",
+}
+
+// revisions stores the migration revisions for the atlas executor.
+type revisions struct {
+	conn    *sql.Conn
+	dialect string
+}
+
+var _ migrate.RevisionReadWriter = (*revisions)(nil)
+
+// init creates the revisions table when it doesn't exist yet.
+func (r *revisions) init(ctx context.Context) error {
+	executedAt := "datetime"
+	switch r.dialect {
+	case dialect.Postgres:
+		executedAt = "timestamp with time zone"
+	case dialect.MySQL:
+		executedAt = "datetime(6)"
+	}
+
+	_, err := r.conn.ExecContext(ctx, fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	version varchar(255) NOT NULL PRIMARY KEY,
+	description varchar(255) NOT NULL,
+	type bigint NOT NULL,
+	applied bigint NOT NULL,
+	total bigint NOT NULL,
+	executed_at %s NOT NULL,
+	execution_time bigint NOT NULL,
+	error text NULL,
+	error_stmt text NULL,
+	hash varchar(255) NOT NULL,
+	partial_hashes text NULL,
+	operator_version varchar(255) NOT NULL
+)`, revisionsTable, executedAt))
+	return err
+}
+
+func (r *revisions) Ident() *migrate.TableIdent {
+	return &migrate.TableIdent{Name: revisionsTable}
+}
+
+func (r *revisions) ReadRevisions(ctx context.Context) ([]*migrate.Revision, error) {
+	return r.read(ctx, nil)
+}
+
+func (r *revisions) ReadRevision(ctx context.Context, version string) (*migrate.Revision, error) {
+	revs, err := r.read(ctx, entsql.EQ("version", version))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(revs) == 0 {
+		return nil, migrate.ErrRevisionNotExist
+	}
+
+	return revs[0], nil
+}
+
+func (r *revisions) read(ctx context.Context, where *entsql.Predicate) ([]*migrate.Revision, error) {
+	selector := entsql.Dialect(r.dialect).Select(revisionColumns...).From(entsql.Table(revisionsTable)).OrderBy("version")
+	if where != nil {
+		selector.Where(where)
+	}
+
+	query, args := selector.Query()
+	rows, err := r.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revs []*migrate.Revision
+	for rows.Next() {
+		var (
+			rev                            migrate.Revision
+			executionTime                  int64
+			errMsg, errStmt, partialHashes sql.NullString
+		)
+
+		err := rows.Scan(
+			&rev.Version, &rev.Description, &rev.Type, &rev.Applied, &rev.Total, &rev.ExecutedAt, &executionTime,
+			&errMsg, &errStmt, &rev.Hash, &partialHashes, &rev.OperatorVersion,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		rev.ExecutionTime = time.Duration(executionTime)
+		rev.Error, rev.ErrorStmt = errMsg.String, errStmt.String
+		if partialHashes.Valid {
+			if err := json.Unmarshal([]byte(partialHashes.String), &rev.PartialHashes); err != nil {
+				return nil, err
+			}
+		}
+
+		revs = append(revs, &rev)
+	}
+
+	return revs, rows.Err()
+}
+
+func (r *revisions) WriteRevision(ctx context.Context, rev *migrate.Revision) error {
+	var partialHashes *string
+	if len(rev.PartialHashes) > 0 {
+		data, err := json.Marshal(rev.PartialHashes)
+		if err != nil {
+			return err
+		}
+		hashes := string(data)
+		partialHashes = &hashes
+	}
+
+	query, args := entsql.Dialect(r.dialect).Insert(revisionsTable).
+		Columns(revisionColumns...).
+		Values(
+			rev.Version, rev.Description, rev.Type, rev.Applied, rev.Total, rev.ExecutedAt.UTC(), int64(rev.ExecutionTime),
+			rev.Error, rev.ErrorStmt, rev.Hash, partialHashes, rev.OperatorVersion,
+		).
+		OnConflict(entsql.ConflictColumns("version"), entsql.ResolveWithNewValues()).
+		Query()
+
+	_, err := r.conn.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (r *revisions) DeleteRevision(ctx context.Context, version string) error {
+	query, args := entsql.Dialect(r.dialect).Delete(revisionsTable).Where(entsql.EQ("version", version)).Query()
+	_, err := r.conn.ExecContext(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,82 @@
+package database
+
+import (
+	"context"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/FlagBrew/local-gpss/internal/database/ent"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/apex/log"
+)
+
+// sqliteDSN has the sqlite driver write times as "2006-01-02 15:04:05.999999999-07:00", unless the
+// connection string picks a format itself. Its default is time.Time.String, which adds the zone name and
+// the monotonic clock reading, so the stored times neither sort nor compare as text the way the times
+// do. Listings page through them with cursors, which needs both.
+func sqliteDSN(dsn string) string {
+	if strings.Contains(dsn, "_time_format=") {
+		return dsn
+	}
+
+	if strings.Contains(dsn, "?") {
+		return dsn + "&_time_format=sqlite"
+	}
+	return dsn + "?_time_format=sqlite"
+}
+
+// oldSQLiteTime matches the times written in the driver's default format, which has spaces around the
+// offset where the sqlite format has none.
+func oldSQLiteTime(field string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.Like(s.C(field), "% % %"))
+	}
+}
+
+// normalizeSQLiteTimes rewrites the upload times written before sqliteDSN set the format, reading them
+// back is all it takes to drop what the old format added.
+func normalizeSQLiteTimes(ctx context.Context, db *ent.Client) error {
+	var total int
+	for {
+		mons, err := db.Pokemon.Query().Where(oldSQLiteTime(pokemon.FieldUploadDatetime)).Limit(hashBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(mons) == 0 {
+			break
+		}
+
+		for _, mon := range mons {
+			if err := db.Pokemon.UpdateOne(mon).SetUploadDatetime(mon.UploadDatetime).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		total += len(mons)
+	}
+
+	for {
+		bundles, err := db.Bundle.Query().Where(oldSQLiteTime(bundle.FieldUploadDatetime)).Limit(hashBatchSize).All(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(bundles) == 0 {
+			break
+		}
+
+		for _, bun := range bundles {
+			if err := db.Bundle.UpdateOne(bun).SetUploadDatetime(bun.UploadDatetime).Exec(ctx); err != nil {
+				return err
+			}
+		}
+		total += len(bundles)
+	}
+
+	if total > 0 {
+		log.FromContext(ctx).WithField("count", total).Info("rewrote stored upload times")
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package database
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/FlagBrew/local-gpss/internal/database/ent"
+)
+
+func TestNormalizeSQLiteTimes(t *testing.T) {
+	ctx := testContext()
+	cfg := testConfig(t)
+	db := New(ctx, cfg)
+	defer db.Close()
+
+	ctx = ent.NewContext(ctx, db)
+	Migrate(ctx, cfg, false)
+
+	// Without a format the driver writes the times like older versions did.
+	raw, err := sql.Open("sqlite", cfg.ConnectionString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer raw.Close()
+
+	uploaded := time.Now().In(time.FixedZone("CEST", 2*60*60))
+	for _, query := range []string{
+		"INSERT INTO pokemons (upload_datetime, download_code, generation, legal) VALUES (?, '0000000001', '8', true)",
+		"INSERT INTO bundles (upload_datetime, download_code, legal, min_gen, max_gen) VALUES (?, '1000000001', true, 8, 8)",
+	} {
+		if _, err := raw.ExecContext(ctx, query, uploaded); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := normalizeSQLiteTimes(ctx, db); err != nil {
+		t.Fatal(err)
+	}
+
+	want := uploaded.Format("2006-01-02 15:04:05.999999999-07:00")
+	for _, table := range []string{"pokemons", "bundles"} {
+		var stored string
+		if err := raw.QueryRowContext(ctx, "SELECT CAST(upload_datetime AS TEXT) FROM "+table).Scan(&stored); err != nil {
+			t.Fatal(err)
+		}
+
+		if stored != want {
+			t.Errorf("%s upload time = %q, want %q", table, stored, want)
+		}
+	}
+
+	if got := db.Pokemon.Query().OnlyX(ctx).UploadDatetime; !got.Equal(uploaded) {
+		t.Errorf("upload time = %s, want %s", got, uploaded)
+	}
+
+	// New rows are written in the same format.
+	created := createPokemon(t, ctx, db, "0000000002", nil)
+	var stored string
+	if err := raw.QueryRowContext(ctx, "SELECT CAST(upload_datetime AS TEXT) FROM pokemons WHERE id = ?", created.ID).Scan(&stored); err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Count(stored, " ") != 1 {
+		t.Errorf("new upload time = %q, want it in the sqlite format", stored)
+	}
+}
@@ -0,0 +1,90 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/FlagBrew/local-gpss/internal/database/ent"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+)
+
+// ErrRemovedMembers is returned when restoring a bundle that still has pokemon which aren't visible.
+var ErrRemovedMembers = errors.New("the bundle has pokemon that aren't visible, restore them first")
+
+// SetPokemonVisibility changes the visibility of a pokemon, an empty reason clears the old one. The
+// visible bundles it's in are hidden along with it, as they'd be missing a member otherwise, the amount
+// of them is returned. Restoring the pokemon doesn't restore those bundles.
+func SetPokemonVisibility(ctx context.Context, db *ent.Client, code string, visibility pokemon.Visibility, reason string) (int, error) {
+	tx, err := db.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	mon, err := tx.Pokemon.Query().Where(pokemon.DownloadCode(code)).Only(ctx)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	now := time.Now()
+	update := mon.Update().SetVisibility(visibility).SetVisibilityChangedAt(now)
+	if reason != "" {
+		update.SetVisibilityReason(reason)
+	} else {
+		update.ClearVisibilityReason()
+	}
+
+	if err = update.Exec(ctx); err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	var hidden int
+	if visibility != pokemon.VisibilityVisible {
+		hidden, err = tx.Bundle.Update().
+			Where(bundle.VisibilityEQ(bundle.VisibilityVisible), bundle.HasSlotsWith(bundleslot.PokemonID(mon.ID))).
+			SetVisibility(bundle.VisibilityHidden).
+			SetVisibilityChangedAt(now).
+			SetVisibilityReason(fmt.Sprintf("pokemon %s was %s", code, visibility)).
+			Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			return 0, err
+		}
+	}
+
+	return hidden, tx.Commit()
+}
+
+// SetBundleVisibility changes the visibility of a bundle, an empty reason clears the old one. A bundle
+// can only be made visible again once all of its pokemon are.
+func SetBundleVisibility(ctx context.Context, db *ent.Client, code string, visibility bundle.Visibility, reason string) error {
+	bun, err := db.Bundle.Query().Where(bundle.DownloadCode(code)).Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	if visibility == bundle.VisibilityVisible {
+		removed, err := bun.QuerySlots().Where(bundleslot.HasPokemonWith(pokemon.VisibilityNEQ(pokemon.VisibilityVisible))).Exist(ctx)
+		if err != nil {
+			return err
+		}
+
+		if removed {
+			return ErrRemovedMembers
+		}
+	}
+
+	update := bun.Update().SetVisibility(visibility).SetVisibilityChangedAt(time.Now())
+	if reason != "" {
+		update.SetVisibilityReason(reason)
+	} else {
+		update.ClearVisibilityReason()
+	}
+
+	return update.Exec(ctx)
+}
@@ -0,0 +1,112 @@
+// Package generation is the one place that knows the different ways a generation is written: PKSM
+// sends LGPE, BDSP and PLA, the database (inherited from the original GPSS) stores them as 7.1, 8.2 and
+// 9.1 and GpssConsole only understands the former.
+package generation
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type Generation int
+
+const (
+	Unknown Generation = iota
+	Gen1
+	Gen2
+	Gen3
+	Gen4
+	Gen5
+	Gen6
+	Gen7
+	Gen8
+	Gen9
+	LGPE
+	BDSP
+	PLA
+)
+
+type names struct {
+	db      string
+	console string
+}
+
+var generations = map[Generation]names{
+	Gen1: {"1", "1"},
+	Gen2: {"2", "2"},
+	Gen3: {"3", "3"},
+	Gen4: {"4", "4"},
+	Gen5: {"5", "5"},
+	Gen6: {"6", "6"},
+	Gen7: {"7", "7"},
+	Gen8: {"8", "8"},
+	Gen9: {"9", "9"},
+	LGPE: {"7.1", "LGPE"},
+	BDSP: {"8.2", "BDSP"},
+	PLA:  {"9.1", "PLA"},
+}
+
+// aliases are the other names people (and older versions of PKSM/GPSS) use for the spin-off games.
+var aliases = map[string]Generation{
+	"LGP": LGPE,
+	"LGE": LGPE,
+	"7B":  LGPE,
+	"BD":  BDSP,
+	"SP":  BDSP,
+	"8B":  BDSP,
+	"LA":  PLA,
+	"8A":  PLA,
+}
+
+// All returns every known generation, ordered by Compare.
+func All() []Generation {
+	gens := slices.Collect(maps.Keys(generations))
+	slices.SortFunc(gens, Compare)
+	return gens
+}
+
+// Aliases returns the alternative names Parse accepts on top of the database and console forms.
+func Aliases() []string {
+	return slices.Collect(maps.Keys(aliases))
+}
+
+// Parse reads a generation in any of its forms, case-insensitively.
+func Parse(s string) (Generation, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	for gen, n := range generations {
+		if s == n.db || s == n.console {
+			return gen, nil
+		}
+	}
+
+	if gen, ok := aliases[s]; ok {
+		return gen, nil
+	}
+
+	return Unknown, fmt.Errorf("unknown generation: %q", s)
+}
+
+// String returns how the generation is stored in the database.
+func (g Generation) String() string {
+	return generations[g].db
+}
+
+// Console returns the generation as GpssConsole expects it.
+func (g Generation) Console() string {
+	return generations[g].console
+}
+
+// Number returns the generation as a number, so generations can be compared and stored in order.
+func (g Generation) Number() float64 {
+	n, _ := strconv.ParseFloat(g.String(), 64)
+	return n
+}
+
+// Compare orders generations by their number, for use with slices.SortFunc.
+func Compare(a, b Generation) int {
+	return cmp.Compare(a.Number(), b.Number())
+}
@@ -3,6 +3,7 @@ package gui
 import (
 	"io"
 	"os"
+	"sync"
 
 	"github.com/FlagBrew/local-gpss/internal/database/ent"
 	"github.com/FlagBrew/local-gpss/internal/models"
@@ -16,6 +17,10 @@ type Gui struct {
 	running   bool
 	logOutput io.Writer
 	db        *ent.Client
+
+	// statusMu guards consoleStatus, which is set once GpssConsole has been checked in the background.
+	statusMu      sync.Mutex
+	consoleStatus *models.ConsoleStatus
 }
 
 func New(config *models.Config, wizard bool) *Gui {
@@ -104,6 +109,18 @@ func (g *Gui) SetDb(db *ent.Client) {
 	g.db = db
 }
 
+func (g *Gui) SetConsoleStatus(status *models.ConsoleStatus) {
+	g.statusMu.Lock()
+	defer g.statusMu.Unlock()
+	g.consoleStatus = status
+}
+
+func (g *Gui) getConsoleStatus() *models.ConsoleStatus {
+	g.statusMu.Lock()
+	defer g.statusMu.Unlock()
+	return g.consoleStatus
+}
+
 func (g *Gui) IsRunning() bool {
 	return g.running
 }
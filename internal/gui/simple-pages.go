@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/FlagBrew/local-gpss/internal/database/ent/recheckjob"
 	"github.com/gdamore/tcell/v2"
 	"github.com/go-sql-driver/mysql"
 	"github.com/jackc/pgx/v5"
@@ -151,6 +152,7 @@ func (g *Gui) mainPage(p *tview.Pages) tview.Primitive {
 	followLogsText := "[green]f/F - Follow Logs: On[-:-:-:-]"
 	monCount := -1
 	bundleCount := -1
+	recheckText := ""
 	firstQuery := true
 
 	redrawFrame := func() {
@@ -163,6 +165,18 @@ func (g *Gui) mainPage(p *tview.Pages) tview.Primitive {
 			frame.AddText("DB Stats Loading, Please wait...", false, tview.AlignRight, tcell.ColorYellow)
 		}
 
+		if recheckText != "" {
+			frame.AddText(recheckText, false, tview.AlignRight, tcell.ColorOrange)
+		}
+
+		if status := g.getConsoleStatus(); status != nil {
+			if status.Error != "" {
+				frame.AddText(fmt.Sprintf("GPSS Console: %s (%s)", status.Error, status.Path), false, tview.AlignRight, tcell.ColorRed)
+			} else {
+				frame.AddText(fmt.Sprintf("GPSS Console: PKHeX %s, AutoMod %s, self-test passed", status.Capabilities.PKHeX, status.Capabilities.AutoMod), false, tview.AlignRight, tcell.ColorGreen)
+			}
+		}
+
 	}
 
 	frame.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
@@ -209,6 +223,13 @@ func (g *Gui) mainPage(p *tview.Pages) tview.Primitive {
 				bundleCount = bundles
 			}
 
+			job, err := g.db.RecheckJob.Query().Where(recheckjob.StatusEQ(recheckjob.StatusRunning)).First(context.Background())
+			if err == nil {
+				recheckText = fmt.Sprintf("Rechecking legality: %d/%d, failed: %d", job.Checked+job.Failed, job.Total, job.Failed)
+			} else {
+				recheckText = ""
+			}
+
 			firstQuery = false
 			redrawFrame()
 			if !g.running {
@@ -0,0 +1,135 @@
+package gpss
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+type valueKind int
+
+const (
+	kindInt valueKind = iota
+	kindFloat
+	kindBool
+	kindTime
+)
+
+// cursor points right after the last row of a page. It's tied to the sort it was made for, as the values
+// mean nothing under another order.
+type cursor struct {
+	Sort   string            `json:"s"`
+	Values []json.RawMessage `json:"v"`
+}
+
+// sortFingerprint identifies the order of a listing.
+func sortFingerprint(entityType string, keys []sortKey, seed int64) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s|%d", entityType, seed)
+	for _, key := range keys {
+		fmt.Fprintf(h, "|%s:%t", key.Field, key.Descending)
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+func encodeCursor[T any](fingerprint string, terms []term[T], row T) (string, error) {
+	c := cursor{Sort: fingerprint}
+	for _, t := range terms {
+		v, err := json.Marshal(t.value(row))
+		if err != nil {
+			return "", err
+		}
+		c.Values = append(c.Values, v)
+	}
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeCursor[T any](raw, fingerprint string, terms []term[T]) ([]any, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil || len(c.Values) != len(terms) {
+		return nil, errInvalidCursor
+	}
+
+	if c.Sort != fingerprint {
+		return nil, errors.New("cursor doesn't match the requested sort")
+	}
+
+	values := make([]any, len(terms))
+	for i, t := range terms {
+		var err error
+		switch t.kind {
+		case kindInt:
+			var v int64
+			err = json.Unmarshal(c.Values[i], &v)
+			values[i] = v
+		case kindFloat:
+			var v float64
+			err = json.Unmarshal(c.Values[i], &v)
+			values[i] = v
+		case kindBool:
+			var v bool
+			err = json.Unmarshal(c.Values[i], &v)
+			values[i] = v
+		case kindTime:
+			var v time.Time
+			err = json.Unmarshal(c.Values[i], &v)
+			values[i] = v
+		}
+
+		if err != nil {
+			return nil, errInvalidCursor
+		}
+	}
+
+	return values, nil
+}
+
+// after only keeps the rows that come after the cursor values in the order of the terms, e.g. for two
+// ascending terms: a > x OR (a = x AND b > y).
+func after[T any](terms []term[T], values []any) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		var or []*sql.Predicate
+		for i, t := range terms {
+			var and []*sql.Predicate
+			for j := range i {
+				and = append(and, compare(terms[j].expr(s), sql.OpEQ, values[j]))
+			}
+
+			op := sql.OpGT
+			if t.desc {
+				op = sql.OpLT
+			}
+
+			or = append(or, sql.And(append(and, compare(t.expr(s), op, values[i]))...))
+		}
+
+		s.Where(sql.Or(or...))
+	}
+}
+
+func compare(expr string, op sql.Op, value any) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		b.WriteString(expr)
+		b.WriteOp(op)
+		b.Arg(value)
+	})
+}
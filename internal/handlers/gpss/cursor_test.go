@@ -0,0 +1,222 @@
+package gpss
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/FlagBrew/local-gpss/internal/generation"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	type row struct {
+		n     int
+		f     float64
+		b     bool
+		stamp time.Time
+	}
+
+	terms := []term[row]{
+		{kind: kindInt, value: func(r row) any { return r.n }},
+		{kind: kindFloat, value: func(r row) any { return r.f }},
+		{kind: kindBool, value: func(r row) any { return r.b }},
+		{kind: kindTime, value: func(r row) any { return r.stamp }},
+	}
+
+	stamp := time.Date(2024, 2, 29, 23, 59, 59, 123456789, time.FixedZone("", -7*60*60))
+	raw, err := encodeCursor("fp", terms, row{n: 42, f: 8.2, b: true, stamp: stamp})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := decodeCursor(raw, "fp", terms)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if values[0] != int64(42) || values[1] != 8.2 || values[2] != true {
+		t.Errorf("values = %v, want [42 8.2 true]", values[:3])
+	}
+
+	if got := values[3].(time.Time); !got.Equal(stamp) {
+		t.Errorf("time = %s, want %s", got, stamp)
+	}
+}
+
+func TestDecodeCursorErrors(t *testing.T) {
+	terms := []term[int]{{kind: kindInt, value: func(n int) any { return n }}}
+
+	valid, err := encodeCursor("fp", terms, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	twoValues, err := encodeCursor("fp", append(terms, terms...), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wrongKind, err := encodeCursor("fp", []term[int]{{kind: kindBool, value: func(int) any { return true }}}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		name, raw, fingerprint string
+	}{
+		{name: "not base64", raw: "%%%", fingerprint: "fp"},
+		{name: "not json", raw: "bm90IGpzb24", fingerprint: "fp"},
+		{name: "wrong number of values", raw: twoValues, fingerprint: "fp"},
+		{name: "wrong kind of value", raw: wrongKind, fingerprint: "fp"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodeCursor(tt.raw, tt.fingerprint, terms); !errors.Is(err, errInvalidCursor) {
+				t.Errorf("got %v, want %v", err, errInvalidCursor)
+			}
+		})
+	}
+
+	if _, err := decodeCursor(valid, "other", terms); err == nil || errors.Is(err, errInvalidCursor) {
+		t.Errorf("got %v for another sort, want a sort mismatch", err)
+	}
+}
+
+// createListed stores pokemon to page through, several share an upload time and download count so the ID
+// has to break the ties. The times are stored with nanoseconds in a zone other than UTC, which has to
+// survive the trip through the cursor.
+func createListed(t *testing.T, s *testServer) {
+	t.Helper()
+
+	base := time.Date(2025, 6, 1, 12, 0, 0, 987654321, time.FixedZone("", 2*60*60))
+	for i := range 7 {
+		err := s.db.Pokemon.Create().
+			SetUploadDatetime(base.Add(time.Duration(i/3) * time.Millisecond)).
+			SetDownloadCode(fmt.Sprintf("%010d", i+1)).
+			SetDownloadCount(i % 2).
+			SetGeneration(generation.Gen8.String()).
+			SetLegal(i%3 != 0).
+			Exec(s.ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func codes(mons []gpssPokemon) []string {
+	var codes []string
+	for _, mon := range mons {
+		codes = append(codes, mon.Code)
+	}
+	return codes
+}
+
+func TestListCursor(t *testing.T) {
+	s := newTestServer(t)
+	createListed(t, s)
+
+	seed := int64(12345)
+	sorts := map[string][]sortKey{
+		"latest":                {{Field: "latest", Descending: true}},
+		"oldest":                {{Field: "latest"}},
+		"popularity":            {{Field: "popularity"}},
+		"popularity and latest": {{Field: "popularity", Descending: true}, {Field: "latest"}},
+		"legality":              {{Field: "legality", Descending: true}},
+		"random":                {{Field: "random"}},
+	}
+
+	for name, sort := range sorts {
+		t.Run(name, func(t *testing.T) {
+			payload := listRequest{Sort: sort, Seed: &seed}
+
+			var all gpssPokemonListResponse
+			s.searchQuery(t, "pokemon", url.Values{"amount": {"100"}}, payload, &all)
+			want := codes(all.Pokemon)
+			if len(want) != 7 || all.Cursor != "" {
+				t.Fatalf("listed %d pokemon with cursor %q, want 7 and no cursor", len(want), all.Cursor)
+			}
+
+			for _, amount := range []int{1, 2, 3, 7} {
+				query := url.Values{"amount": {fmt.Sprint(amount)}}
+
+				var got []string
+				for page := 0; ; page++ {
+					var reply gpssPokemonListResponse
+					s.searchQuery(t, "pokemon", query, payload, &reply)
+					got = append(got, codes(reply.Pokemon)...)
+
+					if counted := reply.Total != nil; counted != (page == 0) {
+						t.Errorf("amount %d page %d: total = %v, want it only on the first page", amount, page, reply.Total)
+					}
+
+					if reply.Cursor == "" {
+						break
+					}
+
+					if len(got) > len(want) {
+						t.Fatalf("amount %d: more pages than pokemon", amount)
+					}
+					query.Set("cursor", reply.Cursor)
+				}
+
+				if !slices.Equal(got, want) {
+					t.Errorf("amount %d: paged through %v, want %v", amount, got, want)
+				}
+			}
+		})
+	}
+}
+
+// TestListCursorLastPage checks a page that ends right at the last row has no cursor, the row fetched past
+// the limit is what tells there's more.
+func TestListCursorLastPage(t *testing.T) {
+	s := newTestServer(t)
+	createListed(t, s)
+
+	var reply gpssPokemonListResponse
+	s.searchQuery(t, "pokemon", url.Values{"amount": {"6"}}, listRequest{}, &reply)
+	if len(reply.Pokemon) != 6 || reply.Cursor == "" {
+		t.Fatalf("listed %d pokemon with cursor %q, want 6 and a cursor", len(reply.Pokemon), reply.Cursor)
+	}
+
+	var last gpssPokemonListResponse
+	s.searchQuery(t, "pokemon", url.Values{"amount": {"6"}, "cursor": {reply.Cursor}}, listRequest{}, &last)
+	if len(last.Pokemon) != 1 || last.Cursor != "" {
+		t.Errorf("listed %d pokemon with cursor %q, want 1 and no cursor", len(last.Pokemon), last.Cursor)
+	}
+
+	var exact gpssPokemonListResponse
+	s.searchQuery(t, "pokemon", url.Values{"amount": {"7"}}, listRequest{}, &exact)
+	if len(exact.Pokemon) != 7 || exact.Cursor != "" {
+		t.Errorf("listed %d pokemon with cursor %q, want 7 and no cursor", len(exact.Pokemon), exact.Cursor)
+	}
+}
+
+func TestListCursorMismatch(t *testing.T) {
+	s := newTestServer(t)
+	createListed(t, s)
+
+	var reply gpssPokemonListResponse
+	s.searchQuery(t, "pokemon", url.Values{"amount": {"2"}}, listRequest{}, &reply)
+
+	seed := int64(1)
+	for name, payload := range map[string]listRequest{
+		"other sort":      {Sort: []sortKey{{Field: "popularity"}}},
+		"other direction": {SortDirection: true},
+		"other seed":      {Seed: &seed},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if w := s.list(t, "pokemon", url.Values{"cursor": {reply.Cursor}}, payload); w.Code != http.StatusBadRequest {
+				t.Errorf("status = %d, want %d: %s", w.Code, http.StatusBadRequest, w.Body)
+			}
+		})
+	}
+
+	// A cursor for pokemon means nothing for bundles.
+	if w := s.list(t, "bundles", url.Values{"cursor": {reply.Cursor}}, listRequest{}); w.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d: %s", w.Code, http.StatusBadRequest, w.Body)
+	}
+}
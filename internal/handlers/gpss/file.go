@@ -0,0 +1,152 @@
+package gpss
+
+import (
+	"archive/zip"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/FlagBrew/local-gpss/internal/database/ent"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/generation"
+	"github.com/FlagBrew/local-gpss/internal/pkm"
+	"github.com/apex/log"
+	"github.com/go-chi/chi/v5"
+	"github.com/lrstanley/chix"
+)
+
+// pokemonFile returns the raw file of a pokemon and the name PKHeX would give it.
+func pokemonFile(mon *ent.Pokemon) ([]byte, string, error) {
+	gen, err := generation.Parse(mon.Generation)
+	if err != nil {
+		return nil, "", err
+	}
+
+	ext, err := pkm.Extension(gen)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return mon.Data, fmt.Sprintf("%s.%s", mon.DownloadCode, ext), nil
+}
+
+// downloadFile is download for clients that want the actual files instead of the base64 PKSM uses, a
+// bundle comes back as a zip of its members. Both count as a download.
+func (h *Handler) downloadFile(w http.ResponseWriter, r *http.Request) {
+	entityType := chi.URLParam(r, "type")
+	downloadCode := chi.URLParam(r, "code")
+	if downloadCode == "" {
+		chix.JSON(w, r, http.StatusBadRequest, chix.M{"error": "missing download code"})
+		return
+	}
+
+	logger := log.FromContext(r.Context()).WithField("download_code", downloadCode)
+	db := ent.FromContext(r.Context())
+	if db == nil {
+		logger.Error("db is nil")
+		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to connect to database"})
+		return
+	}
+
+	switch entityType {
+	case "pokemon":
+		result, err := db.Pokemon.Query().Where(visiblePokemon(downloadCode)).First(r.Context())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				chix.JSON(w, r, http.StatusNotFound, chix.M{"error": "pokemon not found"})
+				return
+			}
+			logger.WithError(err).Error("failed to find pokemon")
+			chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to get pokemon"})
+			return
+		}
+
+		data, name, err := pokemonFile(result)
+		if err != nil {
+			logger.WithError(err).Error("failed to get the pokemon file")
+			chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to get pokemon"})
+			return
+		}
+
+		if _, err = result.Update().AddDownloadCount(1).Save(r.Context()); err != nil {
+			logger.WithError(err).Error("failed to update download count")
+			chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to get pokemon"})
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": name}))
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(data)
+	case "bundle", "bundles":
+		result, err := db.Bundle.Query().
+			WithSlots(withSlots).
+			Where(visibleBundle(downloadCode)).
+			First(r.Context())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				chix.JSON(w, r, http.StatusNotFound, chix.M{"error": "bundle not found"})
+				return
+			}
+			logger.WithError(err).Error("failed to find bundle")
+			chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to get bundle"})
+			return
+		}
+
+		// Read every file up front, once the zip has started there's no way to report an error.
+		mons := members(result)
+		files := make([][]byte, len(mons))
+		names := make([]string, len(mons))
+		ids := make([]int, len(mons))
+		for i, mon := range mons {
+			data, name, err := pokemonFile(mon)
+			if err != nil {
+				logger.WithError(err).WithField("pokemon", mon.DownloadCode).Error("failed to get the pokemon file")
+				chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to get bundle"})
+				return
+			}
+
+			// Prefix the slot so the files keep the bundle's order when extracted.
+			files[i], names[i], ids[i] = data, fmt.Sprintf("%d-%s", i+1, name), mon.ID
+		}
+
+		if _, err = result.Update().AddDownloadCount(1).Save(r.Context()); err != nil {
+			logger.WithError(err).Error("failed to update download count")
+			chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to get bundle"})
+			return
+		}
+
+		// Like download, the pokemon in the bundle have been downloaded too.
+		if _, err = db.Pokemon.Update().Where(pokemon.IDIn(ids...)).AddDownloadCount(1).Save(r.Context()); err != nil {
+			logger.WithError(err).Error("failed to update download count for pokemon in bundle")
+			chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to get bundle"})
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": result.DownloadCode + ".zip"}))
+		w.WriteHeader(http.StatusOK)
+
+		zw := zip.NewWriter(w)
+		for i, data := range files {
+			f, err := zw.Create(names[i])
+			if err != nil {
+				logger.WithError(err).Error("failed to write bundle zip")
+				return
+			}
+
+			if _, err = f.Write(data); err != nil {
+				logger.WithError(err).Error("failed to write bundle zip")
+				return
+			}
+		}
+
+		if err := zw.Close(); err != nil {
+			logger.WithError(err).Error("failed to write bundle zip")
+		}
+	default:
+		chix.JSON(w, r, http.StatusBadRequest, chix.M{"error": "unknown entity type"})
+	}
+}
@@ -2,11 +2,12 @@ package gpss
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"math"
+	"math/rand/v2"
 	"net/http"
 	"slices"
 	"strconv"
@@ -14,28 +15,42 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/FlagBrew/local-gpss/internal/checker"
+	"github.com/FlagBrew/local-gpss/internal/console"
+	"github.com/FlagBrew/local-gpss/internal/database"
 	"github.com/FlagBrew/local-gpss/internal/database/ent"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/predicate"
+	"github.com/FlagBrew/local-gpss/internal/generation"
 	"github.com/FlagBrew/local-gpss/internal/models"
+	"github.com/FlagBrew/local-gpss/internal/pkm"
 	"github.com/FlagBrew/local-gpss/internal/utils"
 	"github.com/apex/log"
 	"github.com/go-chi/chi/v5"
 	"github.com/lrstanley/chix"
 )
 
-type Handler struct{}
+type Handler struct {
+	checker checker.LegalityChecker
+}
 
-func NewHandler() *Handler {
-	return &Handler{}
+func NewHandler(legalityChecker checker.LegalityChecker) *Handler {
+	return &Handler{checker: legalityChecker}
 }
 
 func (h *Handler) Route(r chi.Router) {
 	r.Post("/search/{type}", h.list)
 	r.Post("/upload/pokemon", h.uploadPokemon)
 	r.Post("/upload/bundle", h.uploadBundle)
+	r.Post("/random/pokemon", h.random)
 	r.Get("/download/{type}/{code}", h.download)
+	r.Get("/download/{type}/{code}/file", h.downloadFile)
+	r.Get("/pokemon/{code}", h.pokemonDetail)
+	r.Get("/bundle/{code}", h.bundleDetail)
+	r.Get("/pokemon/{code}/legality", h.pokemonLegality)
+	r.Get("/bundle/{code}/legality", h.bundleLegality)
 }
 
 func (h *Handler) list(w http.ResponseWriter, r *http.Request) {
@@ -73,39 +88,19 @@ func (h *Handler) list(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	var gens []string
-	sortField := "latest"
-
-	if payload.SortField != "" {
-		sortField = payload.SortField
-	}
-
-	orderDir := sql.OrderAsc()
+	// The cursor replaces the page when given, page is still echoed back for PKSM.
+	cursor := r.URL.Query().Get("cursor")
 
-	if payload.SortDirection {
-		orderDir = sql.OrderDesc()
-	}
+	gens := payload.generations()
+	sortKeys := payload.sortKeys()
 
-	// Handle the generations array if any are provided
-	if payload.Generations != nil {
-		for _, gen := range payload.Generations {
-			switch gen {
-			case "LGPE":
-				gens = append(gens, "7.1")
-				break
-			case "BDSP":
-				gens = append(gens, "8.2")
-				break
-			case "PLA":
-				gens = append(gens, "9.1")
-				break
-			default:
-				if _, err := strconv.Atoi(gen); err == nil {
-					gens = append(gens, gen)
-					break
-				}
-			}
-		}
+	// Hand out a seed when none was given, so the client can send it back for the next page.
+	var seed int64
+	if payload.Seed != nil {
+		seed = *payload.Seed
+	} else if slices.ContainsFunc(sortKeys, func(k sortKey) bool { return k.Field == "random" }) {
+		seed = rand.Int64N(maxSeed)
+		payload.Seed = &seed
 	}
 
 	logger := log.FromContext(r.Context())
@@ -119,47 +114,61 @@ func (h *Handler) list(w http.ResponseWriter, r *http.Request) {
 	switch entityType {
 	case "pokemon":
 		query := db.Pokemon.Query()
-		var args []predicate.Pokemon
+		args := payload.pokemonPredicates()
 
-		if len(gens) > 0 {
-			args = append(args, pokemon.GenerationIn(gens...))
-		}
-
-		if payload.LegalOnly {
-			args = append(args, pokemon.Legal(true))
+		terms, err := pokemonTerms(sortKeys, seed)
+		if err != nil {
+			chix.JSON(w, r, http.StatusBadRequest, chix.M{"error": err.Error()})
+			return
 		}
 
-		var orderField pokemon.OrderOption
+		query.Where(pokemon.And(args...)).Order(orderBy(terms))
 
-		switch sortField {
-		case "popularity":
-			orderField = pokemon.ByDownloadCount(orderDir)
-		case "latest":
-			fallthrough
-		default:
-			orderField = pokemon.ByUploadDatetime(orderDir)
+		// Clients following a cursor already got the total with the first page, so it isn't counted again.
+		var total *int
+		fingerprint := sortFingerprint("pokemon", sortKeys, seed)
+		if cursor != "" {
+			values, err := decodeCursor(cursor, fingerprint, terms)
+			if err != nil {
+				chix.JSON(w, r, http.StatusBadRequest, chix.M{"error": err.Error()})
+				return
+			}
+			query.Where(after(terms, values))
+		} else {
+			amount, err := query.Count(r.Context())
+			if err != nil {
+				logger.WithError(err).Error("failed to count the pokemon in the query")
+				chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to list pokemon"})
+				return
+			}
+			total = &amount
+			query.Offset((page - 1) * limit)
 		}
 
-		query.Where(pokemon.And(args...)).Order(orderField)
-
-		amount, err := query.Count(r.Context())
+		// Fetch one extra to know if there's a next page to point the cursor at.
+		mons, err := query.Limit(limit + 1).All(r.Context())
 		if err != nil {
-			logger.WithError(err).Error("failed to count the pokemon in the query")
+			logger.WithError(err).Error("failed to list pokemon")
 			chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to list pokemon"})
 			return
 		}
 
-		mons, err := query.Limit(limit).Offset((page - 1) * limit).All(r.Context())
-		if err != nil {
-			logger.WithError(err).Error("failed to list pokemon")
-			chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to list pokemon"})
-			return
+		var next string
+		if len(mons) > limit {
+			mons = mons[:limit]
+			if next, err = encodeCursor(fingerprint, terms, mons[limit-1]); err != nil {
+				logger.WithError(err).Error("failed to encode the cursor")
+				chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to list pokemon"})
+				return
+			}
 		}
 
 		resp := gpssPokemonListResponse{
-			Total:   amount,
+			Total:   total,
 			Page:    page,
-			Pages:   int(math.Ceil(float64(amount) / float64(limit))),
+			Pages:   pageCount(total, limit),
+			Seed:    payload.Seed,
+			Cursor:  next,
 			Pokemon: []gpssPokemon{},
 		}
 
@@ -168,7 +177,7 @@ func (h *Handler) list(w http.ResponseWriter, r *http.Request) {
 				Legal:      mon.Legal,
 				Generation: mon.Generation,
 				Code:       mon.DownloadCode,
-				Base64:     mon.Base64,
+				Base64:     pokemonBase64(mon),
 			})
 		}
 
@@ -177,91 +186,109 @@ func (h *Handler) list(w http.ResponseWriter, r *http.Request) {
 	case "bundle", "bundles":
 		query := db.Bundle.Query()
 
-		var args []predicate.Bundle
-		minGen := "1"
-		maxGen := "10"
+		args := []predicate.Bundle{bundle.VisibilityEQ(bundle.VisibilityVisible)}
 
 		if len(gens) > 0 {
-			var nums []json.Number
-			for _, gen := range gens {
-				nums = append(nums, json.Number(gen))
-			}
-
-			// now sort the list
-			slices.Sort(nums)
-
-			// Get the first and last items in the list
-			minGen = nums[0].String()
-			maxGen = nums[len(nums)-1].String()
-
-			args = append(args, bundle.MaxGenLTE(maxGen), bundle.MinGenGTE(minGen))
+			args = append(args, bundle.MaxGenLTE(gens[len(gens)-1].Number()), bundle.MinGenGTE(gens[0].Number()))
 		}
 
 		if payload.LegalOnly {
 			args = append(args, bundle.Legal(true))
 		}
 
-		var orderField bundle.OrderOption
+		args = append(args, payload.bundleFilters()...)
 
-		switch sortField {
-		case "popularity":
-			orderField = bundle.ByDownloadCount(orderDir)
-		case "latest":
-			fallthrough
-		default:
-			orderField = bundle.ByUploadDatetime(orderDir)
+		terms, err := bundleTerms(sortKeys, seed)
+		if err != nil {
+			chix.JSON(w, r, http.StatusBadRequest, chix.M{"error": err.Error()})
+			return
 		}
 
-		query.Where(bundle.And(args...)).Order(orderField)
+		query.Where(bundle.And(args...)).Order(orderBy(terms))
 
-		amount, err := query.Count(r.Context())
-		if err != nil {
-			logger.WithError(err).Error("failed to count the bundles in the query")
-			chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to list bundles"})
-			return
+		// Clients following a cursor already got the total with the first page, so it isn't counted again.
+		var total *int
+		fingerprint := sortFingerprint("bundle", sortKeys, seed)
+		if cursor != "" {
+			values, err := decodeCursor(cursor, fingerprint, terms)
+			if err != nil {
+				chix.JSON(w, r, http.StatusBadRequest, chix.M{"error": err.Error()})
+				return
+			}
+			query.Where(after(terms, values))
+		} else {
+			amount, err := query.Count(r.Context())
+			if err != nil {
+				logger.WithError(err).Error("failed to count the bundles in the query")
+				chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to list bundles"})
+				return
+			}
+			total = &amount
+			query.Offset((page - 1) * limit)
 		}
 
-		bundles, err := query.Limit(limit).Offset((page - 1) * limit).WithPokemons().All(r.Context())
+		bundles, err := query.Limit(limit + 1).WithSlots(withSlots).All(r.Context())
 		if err != nil {
 			logger.WithError(err).Error("failed to list bundles")
 			chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to list bundles"})
 			return
 		}
 
+		var next string
+		if len(bundles) > limit {
+			bundles = bundles[:limit]
+			if next, err = encodeCursor(fingerprint, terms, bundles[limit-1]); err != nil {
+				logger.WithError(err).Error("failed to encode the cursor")
+				chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to list bundles"})
+				return
+			}
+		}
+
 		resp := gpssBundleListResponse{
-			Total:   amount,
+			Total:   total,
 			Page:    page,
-			Pages:   int(math.Ceil(float64(amount) / float64(limit))),
+			Pages:   pageCount(total, limit),
+			Seed:    payload.Seed,
+			Cursor:  next,
 			Bundles: []gpssBundle{},
 		}
 
 		for _, bun := range bundles {
 			tmpBun := gpssBundle{
 				Legal:         bun.Legal,
-				MinGen:        bun.MinGen,
-				MaxGen:        bun.MaxGen,
+				MinGen:        formatGen(bun.MinGen),
+				MaxGen:        formatGen(bun.MaxGen),
 				Patreon:       false,
-				Count:         len(bun.Edges.Pokemons),
+				Count:         len(bun.Edges.Slots),
 				DownloadCode:  bun.DownloadCode,
+				Title:         bun.Title,
+				Description:   bun.Description,
+				Notes:         bun.Notes,
 				DownloadCodes: []string{},
 				Pokemons:      []gpssBundlePokemon{},
 			}
 
-			var seenGens []json.Number
-			for _, mon := range bun.Edges.Pokemons {
-				seenGens = append(seenGens, json.Number(mon.Generation))
+			var seenGens []generation.Generation
+			for _, slot := range bun.Edges.Slots {
+				mon := slot.Edges.Pokemon
+				if gen, err := generation.Parse(slot.Generation); err == nil {
+					seenGens = append(seenGens, gen)
+				}
 				tmpBun.DownloadCodes = append(tmpBun.DownloadCodes, mon.DownloadCode)
 				tmpBun.Pokemons = append(tmpBun.Pokemons, gpssBundlePokemon{
 					Legal:      mon.Legal,
-					Generation: mon.Generation,
-					Base64:     mon.Base64,
+					Generation: slot.Generation,
+					Base64:     pokemonBase64(mon),
 				})
 			}
 
-			slices.Sort(seenGens)
-			// Noticed that some of the min/max gens on bundles are wrong, so let's re-calculate it.
-			tmpBun.MinGen = seenGens[0].String()
-			tmpBun.MaxGen = seenGens[len(seenGens)-1].String()
+			// Noticed that some of the min/max gens on bundles are wrong, so let's re-calculate it. A bundle
+			// whose pokemon were deleted from the database by hand has nothing left to go by.
+			if len(seenGens) > 0 {
+				slices.SortFunc(seenGens, generation.Compare)
+				tmpBun.MinGen = seenGens[0].String()
+				tmpBun.MaxGen = seenGens[len(seenGens)-1].String()
+			}
 
 			resp.Bundles = append(resp.Bundles, tmpBun)
 		}
@@ -297,7 +324,7 @@ func (h *Handler) download(w http.ResponseWriter, r *http.Request) {
 
 	switch entityType {
 	case "pokemon":
-		result, err := db.Pokemon.Query().Where(pokemon.DownloadCode(downloadCode)).First(r.Context())
+		result, err := db.Pokemon.Query().Where(visiblePokemon(downloadCode)).First(r.Context())
 		if err != nil {
 			if ent.IsNotFound(err) {
 				chix.JSON(w, r, http.StatusNotFound, chix.M{"error": "pokemon not found"})
@@ -319,7 +346,7 @@ func (h *Handler) download(w http.ResponseWriter, r *http.Request) {
 		chix.JSON(w, r, http.StatusOK, chix.M{})
 		return
 	case "bundle", "bundles":
-		result, err := db.Bundle.Query().WithPokemons().Where(bundle.DownloadCode(downloadCode)).First(r.Context())
+		result, err := db.Bundle.Query().Where(visibleBundle(downloadCode)).First(r.Context())
 		if err != nil {
 			if ent.IsNotFound(err) {
 				chix.JSON(w, r, http.StatusNotFound, chix.M{"error": "bundle not found"})
@@ -338,23 +365,17 @@ func (h *Handler) download(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		// We also need to increment the download counts of all the pokemon
-
-		mons, err := result.QueryPokemons().All(r.Context())
+		// We also need to increment the download counts of all the pokemon, once for a pokemon in several slots.
+		_, err = db.Pokemon.Update().
+			Where(pokemon.HasBundleSlotsWith(bundleslot.BundleID(result.ID))).
+			AddDownloadCount(1).
+			Save(r.Context())
 		if err != nil {
-			logger.WithError(err).WithField("download_code", downloadCode).Error("failed to get pokemons from the bundle")
+			logger.WithError(err).WithField("download_code", downloadCode).Error("failed to update download count for pokemon in bundle")
 			chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to get bundle"})
 			return
 		}
 
-		for _, mon := range mons {
-			_, err = mon.Update().AddDownloadCount(1).Save(r.Context())
-			if err != nil {
-				logger.WithError(err).WithField("download_code", downloadCode).Error("failed to update download count for pokemon in bundle")
-				chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to get bundle"})
-			}
-		}
-
 		// Since PKSM just clones the B64 from the list endpoint, we don't actually have to return anything
 		chix.JSON(w, r, http.StatusOK, chix.M{})
 		return
@@ -364,6 +385,142 @@ func (h *Handler) download(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// pokemonDetail returns everything stored about a pokemon, unlike download it doesn't count as one.
+func (h *Handler) pokemonDetail(w http.ResponseWriter, r *http.Request) {
+	downloadCode := chi.URLParam(r, "code")
+	if downloadCode == "" {
+		chix.JSON(w, r, http.StatusBadRequest, chix.M{"error": "missing download code"})
+		return
+	}
+
+	logger := log.FromContext(r.Context())
+	db := ent.FromContext(r.Context())
+	if db == nil {
+		logger.Error("db is nil")
+		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to connect to database"})
+		return
+	}
+
+	result, err := db.Pokemon.Query().Where(visiblePokemon(downloadCode)).First(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			chix.JSON(w, r, http.StatusNotFound, chix.M{"error": "pokemon not found"})
+			return
+		}
+		logger.WithError(err).WithField("download_code", downloadCode).Error("failed to find pokemon")
+		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to get pokemon"})
+		return
+	}
+
+	chix.JSON(w, r, http.StatusOK, newPokemonDetail(result))
+}
+
+// bundleDetail returns everything stored about a bundle and its pokemon, without counting as a download.
+func (h *Handler) bundleDetail(w http.ResponseWriter, r *http.Request) {
+	downloadCode := chi.URLParam(r, "code")
+	if downloadCode == "" {
+		chix.JSON(w, r, http.StatusBadRequest, chix.M{"error": "missing download code"})
+		return
+	}
+
+	logger := log.FromContext(r.Context())
+	db := ent.FromContext(r.Context())
+	if db == nil {
+		logger.Error("db is nil")
+		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to connect to database"})
+		return
+	}
+
+	result, err := db.Bundle.Query().
+		WithSlots(withSlots).
+		Where(visibleBundle(downloadCode)).
+		First(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			chix.JSON(w, r, http.StatusNotFound, chix.M{"error": "bundle not found"})
+			return
+		}
+		logger.WithError(err).WithField("download_code", downloadCode).Error("failed to find bundle")
+		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to get bundle"})
+		return
+	}
+
+	chix.JSON(w, r, http.StatusOK, newBundleDetail(result))
+}
+
+func (h *Handler) pokemonLegality(w http.ResponseWriter, r *http.Request) {
+	downloadCode := chi.URLParam(r, "code")
+	if downloadCode == "" {
+		chix.JSON(w, r, http.StatusBadRequest, chix.M{"error": "missing download code"})
+		return
+	}
+
+	logger := log.FromContext(r.Context())
+	db := ent.FromContext(r.Context())
+	if db == nil {
+		logger.Error("db is nil")
+		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to connect to database"})
+		return
+	}
+
+	result, err := db.Pokemon.Query().Where(visiblePokemon(downloadCode)).First(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			chix.JSON(w, r, http.StatusNotFound, chix.M{"error": "pokemon not found"})
+			return
+		}
+		logger.WithError(err).WithField("download_code", downloadCode).Error("failed to find pokemon")
+		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to get pokemon legality"})
+		return
+	}
+
+	chix.JSON(w, r, http.StatusOK, newLegalityResponse(result))
+}
+
+func (h *Handler) bundleLegality(w http.ResponseWriter, r *http.Request) {
+	downloadCode := chi.URLParam(r, "code")
+	if downloadCode == "" {
+		chix.JSON(w, r, http.StatusBadRequest, chix.M{"error": "missing download code"})
+		return
+	}
+
+	logger := log.FromContext(r.Context())
+	db := ent.FromContext(r.Context())
+	if db == nil {
+		logger.Error("db is nil")
+		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to connect to database"})
+		return
+	}
+
+	result, err := db.Bundle.Query().WithSlots(withSlots).Where(visibleBundle(downloadCode)).First(r.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			chix.JSON(w, r, http.StatusNotFound, chix.M{"error": "bundle not found"})
+			return
+		}
+		logger.WithError(err).WithField("download_code", downloadCode).Error("failed to find bundle")
+		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to get bundle legality"})
+		return
+	}
+
+	resp := gpssBundleLegalityResponse{
+		Code:     result.DownloadCode,
+		Legal:    true,
+		Pokemons: []gpssLegalityResponse{},
+	}
+
+	for _, mon := range members(result) {
+		if !mon.Legal {
+			resp.Legal = false
+			resp.IllegalCount++
+		}
+
+		resp.Pokemons = append(resp.Pokemons, newLegalityResponse(mon))
+	}
+
+	chix.JSON(w, r, http.StatusOK, resp)
+}
+
 func (h *Handler) uploadPokemon(w http.ResponseWriter, r *http.Request) {
 	logger := log.FromContext(r.Context())
 	db := ent.FromContext(r.Context())
@@ -382,22 +539,19 @@ func (h *Handler) uploadPokemon(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Check to see if the base64 already exists in the database
-	pkmn, err := db.Pokemon.Query().Where(pokemon.Base64(args.Pokemon)).First(r.Context())
+	pkmn, err := h.findOrUploadPkmn(r, db, logger, *args)
 	if err != nil {
-		if !ent.IsNotFound(err) {
-			logger.WithError(err).Error("failed to check database for existing pokemon")
-			chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to upload pokemon"})
+		if errors.Is(err, errRemoved) {
+			chix.JSON(w, r, http.StatusForbidden, chix.M{"error": err.Error()})
+			return
+		}
+
+		var consoleErr *console.Error
+		if errors.As(err, &consoleErr) {
+			utils.WriteLegalityError(w, r, err)
 			return
 		}
-	} else {
-		// Return the download code
-		chix.JSON(w, r, http.StatusOK, chix.M{"code": pkmn.DownloadCode})
-		return
-	}
 
-	pkmn, err = h.uploadPkmn(r, db, logger, *args)
-	if err != nil {
 		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to upload pokemon"})
 		return
 	}
@@ -433,32 +587,42 @@ func (h *Handler) uploadBundle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	generations := strings.Split(r.Header.Get("generations"), ",")
-	if len(generations) != count {
+	generationNames := strings.Split(r.Header.Get("generations"), ",")
+	if len(generationNames) != count {
 		chix.JSON(w, r, http.StatusBadRequest, chix.M{"error": "missing generations header or invalid amount"})
 		return
 	}
 
+	generations := make([]generation.Generation, count)
+	for i, name := range generationNames {
+		gen, err := generation.Parse(name)
+		if err != nil {
+			chix.JSON(w, r, http.StatusBadRequest, chix.M{"error": fmt.Sprintf("pkmn%d: %s", i+1, err)})
+			return
+		}
+		generations[i] = gen
+	}
+
 	// Set the limit to 5 MB
 	err = r.ParseMultipartForm(5 * 1024 * 1024)
 	if err != nil {
-		logger.WithError(err).Error("failed to parse multipart form")
-		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to upload bundle"})
+		chix.JSON(w, r, http.StatusBadRequest, chix.M{"error": "failed to parse form"})
+		return
 	}
 
-	tx, err := db.Tx(r.Context())
+	desc, err := parseBundleDescription(r)
 	if err != nil {
-		logger.WithError(err).Error("failed to begin transaction")
-		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to upload bundle"})
+		chix.JSON(w, r, http.StatusBadRequest, chix.M{"error": err.Error()})
 		return
 	}
 
+	// The pokemon are checked and stored before the bundle, so the database isn't held up while the
+	// checks run. The ones already stored count towards the legality of the bundle as they are.
 	bundleLegal := true
 	var mons []*ent.Pokemon
 	for i := 0; i < count; i++ {
 		pkmn, _, err := r.FormFile(fmt.Sprintf("pkmn%d", i+1))
 		if err != nil {
-			tx.Rollback()
 			logger.WithError(err).Error("failed to get pokemon from bundle")
 			chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to upload bundle"})
 			return
@@ -466,43 +630,40 @@ func (h *Handler) uploadBundle(w http.ResponseWriter, r *http.Request) {
 
 		defer pkmn.Close()
 
-		// Base64 encode the pokemon
 		var buf bytes.Buffer
 		if _, err := io.Copy(&buf, pkmn); err != nil {
-			tx.Rollback()
 			logger.WithError(err).Error("failed to copy pokemon data")
 			chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to upload bundle"})
 			return
 		}
 
 		pkmn.Close()
-		b64Str := base64.StdEncoding.EncodeToString(buf.Bytes())
 
-		// Check to see if the mon already exists
+		up, err := newUpload(r.Context(), generations[i], buf.Bytes())
+		if err != nil {
+			logger.WithError(err).Error("failed to read pokemon data")
+			chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to upload bundle"})
+			return
+		}
 
-		mon, err := db.Pokemon.Query().Where(pokemon.Base64(b64Str)).First(r.Context())
+		mon, err := h.findOrUpload(r, db, logger, up)
 		if err != nil {
-			if !ent.IsNotFound(err) {
-				tx.Rollback()
-				logger.WithError(err).Error("failed to search for pokemon")
-				chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to upload bundle"})
+			if errors.Is(err, errRemoved) {
+				chix.JSON(w, r, http.StatusForbidden, chix.M{"error": fmt.Sprintf("pkmn%d: %s", i+1, errRemoved)})
 				return
 			}
-		} else {
-			// mon exists, we can move onto the next.
-			mons = append(mons, mon)
-			continue
-		}
 
-		args := models.GpssConsoleArgs{
-			Mode:       "legality",
-			Generation: generations[i],
-			Pokemon:    b64Str,
-		}
+			var consoleErr *console.Error
+			if errors.As(err, &consoleErr) {
+				// Let the user know which of the pokemon was the problem.
+				utils.WriteLegalityError(w, r, &console.Error{
+					Code:    consoleErr.Code,
+					Message: fmt.Sprintf("pkmn%d: %s", i+1, consoleErr.Message),
+					Err:     consoleErr.Err,
+				})
+				return
+			}
 
-		mon, err = h.uploadPkmn(r, tx.Client(), logger, args)
-		if err != nil {
-			tx.Rollback()
 			logger.WithError(err).Error("failed to upload pokemon data")
 			chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to upload bundle"})
 			return
@@ -515,22 +676,41 @@ func (h *Handler) uploadBundle(w http.ResponseWriter, r *http.Request) {
 		mons = append(mons, mon)
 	}
 
-	// Sort the generations slice
-	slices.Sort(generations)
+	// The slots keep the generations in the order they were sent, the bundle only needs the range.
+	sorted := slices.SortedFunc(slices.Values(generations), generation.Compare)
+	minGen, maxGen := sorted[0].Number(), sorted[len(sorted)-1].Number()
 
-	ids := make([]int, len(mons))
+	// Check to see if we have a bundle already, it's the same one when it has the same pokemon in the same slots
+	// and the same description.
+	same := append([]predicate.Bundle{memberCount(sql.OpEQ, count)}, desc.predicates()...)
 	for i, mon := range mons {
-		ids[i] = mon.ID
+		same = append(same, bundle.HasSlotsWith(
+			bundleslot.Position(i),
+			bundleslot.PokemonID(mon.ID),
+			bundleslot.Generation(generations[i].String()),
+		))
 	}
 
-	// Check to see if we have a bundle already
-	existingBun, err := db.Bundle.Query().WithPokemons().Where(bundle.And(bundle.HasPokemonsWith(pokemon.IDIn(ids...)), bundle.MinGen(generations[0]), bundle.MaxGen(generations[len(generations)-1]))).First(r.Context())
+	tx, err := db.Tx(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("failed to begin transaction")
+		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to upload bundle"})
+		return
+	}
+
+	existingBun, err := tx.Bundle.Query().Where(same...).First(r.Context())
 	if err != nil && !ent.IsNotFound(err) {
 		tx.Rollback()
 		logger.WithError(err).Error("failed to search for pokemon")
 		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to upload bundle"})
 		return
-	} else if existingBun != nil && len(existingBun.Edges.Pokemons) == count {
+	} else if existingBun != nil {
+		if existingBun.Visibility != bundle.VisibilityVisible {
+			tx.Rollback()
+			chix.JSON(w, r, http.StatusForbidden, chix.M{"error": "this bundle has been removed"})
+			return
+		}
+
 		tx.Commit()
 		chix.JSON(w, r, http.StatusOK, chix.M{"code": existingBun.DownloadCode})
 		return
@@ -539,12 +719,15 @@ func (h *Handler) uploadBundle(w http.ResponseWriter, r *http.Request) {
 	// Now let's create the bundle
 	downloadCode, err := utils.GenerateDownloadCode(r.Context(), "bundle")
 	if err != nil {
+		tx.Rollback()
 		logger.WithError(err).Error("failed to generate bundle download code")
 		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to upload bundle"})
 		return
 	}
-	newBundle, err := tx.Bundle.Create().SetMinGen(generations[0]).SetMaxGen(generations[len(mons)-1]).
-		SetLegal(bundleLegal).AddPokemons(mons...).SetUploadDatetime(time.Now()).SetDownloadCode(downloadCode).Save(r.Context())
+	newBundle, err := tx.Bundle.Create().SetMinGen(minGen).SetMaxGen(maxGen).
+		SetLegal(bundleLegal).SetUploadDatetime(time.Now()).SetDownloadCode(downloadCode).
+		SetNillableTitle(optional(desc.Title)).SetNillableDescription(optional(desc.Description)).SetNillableNotes(optional(desc.Notes)).
+		Save(r.Context())
 
 	if err != nil {
 		tx.Rollback()
@@ -553,33 +736,149 @@ func (h *Handler) uploadBundle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	slots := make([]*ent.BundleSlotCreate, len(mons))
+	for i, mon := range mons {
+		slots[i] = tx.BundleSlot.Create().
+			SetBundle(newBundle).
+			SetPokemon(mon).
+			SetPosition(i).
+			SetGeneration(generations[i].String())
+	}
+
+	if err = tx.BundleSlot.CreateBulk(slots...).Exec(r.Context()); err != nil {
+		tx.Rollback()
+		logger.WithError(err).Error("failed to store the bundle slots")
+		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to upload bundle"})
+		return
+	}
+
 	tx.Commit()
 	chix.JSON(w, r, http.StatusOK, chix.M{"code": newBundle.DownloadCode})
 
 }
 
-func (h *Handler) uploadPkmn(r *http.Request, db *ent.Client, logger log.Interface, args models.GpssConsoleArgs) (*ent.Pokemon, error) {
+// findOrUploadPkmn returns the stored pokemon with the same data, uploading it when there isn't one.
+func (h *Handler) findOrUploadPkmn(r *http.Request, db *ent.Client, logger log.Interface, args models.GpssConsoleArgs) (*ent.Pokemon, error) {
+	gen, err := generation.Parse(args.Generation)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(args.Pokemon)
+	if err != nil {
+		return nil, err
+	}
+
+	up, err := newUpload(r.Context(), gen, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.findOrUpload(r, db, logger, up)
+}
+
+// findOrUpload is findOrUploadPkmn for a pokemon that has already been read.
+func (h *Handler) findOrUpload(r *http.Request, db *ent.Client, logger log.Interface, up *upload) (*ent.Pokemon, error) {
+	hash := up.hash()
+	pkmn, err := db.Pokemon.Query().Where(pokemon.ContentHash(hash)).First(r.Context())
+	if err == nil {
+		return existingPkmn(pkmn)
+	}
+
+	if !ent.IsNotFound(err) {
+		logger.WithError(err).Error("failed to check database for existing pokemon")
+		return nil, err
+	}
+
+	pkmn, err = h.uploadPkmn(r, db, logger, up)
+	if ent.IsConstraintError(err) {
+		// Someone else uploaded the same pokemon in the meantime.
+		pkmn, err = db.Pokemon.Query().Where(pokemon.ContentHash(hash)).First(r.Context())
+		if err != nil {
+			return nil, err
+		}
+		return existingPkmn(pkmn)
+	}
+
+	return pkmn, err
+}
+
+// existingPkmn is what an upload of an already stored pokemon ends up as, the ones that have been taken
+// down stay down.
+func existingPkmn(pkmn *ent.Pokemon) (*ent.Pokemon, error) {
+	if pkmn.Visibility != pokemon.VisibilityVisible {
+		return nil, errRemoved
+	}
+
+	return pkmn, nil
+}
+
+// upload is a pokemon sent by a client, in the form it's stored and deduplicated in.
+type upload struct {
+	gen generation.Generation
+	// raw is the pokemon as it was sent, party data still has the level the stored data lacks.
+	raw  []byte
+	data []byte
+}
+
+func newUpload(ctx context.Context, gen generation.Generation, raw []byte) (*upload, error) {
+	if gen == generation.Unknown {
+		return nil, errors.New("unknown generation")
+	}
+
+	return &upload{gen: gen, raw: raw, data: database.StoredData(ctx, raw, gen)}, nil
+}
+
+func (u *upload) hash() string {
+	return database.ContentHash(u.data)
+}
+
+func (h *Handler) uploadPkmn(r *http.Request, db *ent.Client, logger log.Interface, up *upload) (*ent.Pokemon, error) {
 	// We call out to the same function as the legality check endpoint does as we need to do two things
 	// 1. Make sure the file sent over is an actual Pokémon
 	// 2. Check the legality status.
-	result, err := utils.ExecGpssConsole[models.GpssLegalityCheckReply](r.Context(), args)
+	gen := up.gen
+	result, err := h.checker.Check(r.Context(), base64.StdEncoding.EncodeToString(up.data), gen.Console())
 	if err != nil {
-		logger.WithError(err).Error("failed to communicate with GpssConsole")
+		logger.WithError(err).Debug("failed to check legality")
 		return nil, err
 	}
 
+	// Keep track of what engine produced the report, so it's clear when it's outdated.
+	engineVersion := ""
+	version, err := h.checker.Version(r.Context())
+	if err != nil {
+		logger.WithError(err).Warn("failed to get the engine version")
+	} else {
+		engineVersion = version.String()
+	}
+
 	downloadCode, err := utils.GenerateDownloadCode(r.Context(), "pokemon")
 	if err != nil {
 		logger.WithError(err).Error("failed to generate download code")
 		return nil, err
 	}
 
-	pkmn, err := db.Pokemon.Create().
+	create := db.Pokemon.Create().
 		SetUploadDatetime(time.Now()).
-		SetGeneration(args.Generation).
+		SetGeneration(gen.String()).
 		SetLegal(result.Legal).
+		SetLegalityReport(result.Report).
+		SetEngineVersion(engineVersion).
+		SetLegalityCheckedAt(time.Now()).
 		SetDownloadCode(downloadCode).
-		SetBase64(args.Pokemon).Save(r.Context())
+		SetData(up.data).
+		SetContentHash(up.hash())
+
+	// PKHeX was happy with it, so not being able to decode it ourselves isn't a reason to turn it away.
+	info, err := pkm.Decode(up.raw, gen)
+	if err != nil {
+		logger.WithError(err).Warn("failed to decode pokemon metadata")
+	} else {
+		utils.SetPokemonMetadata(create.Mutation(), info)
+	}
+
+	pkmn, err := create.Save(r.Context())
 	if err != nil {
 		logger.WithError(err).Error("failed to insert pokemon into database")
 		return nil, err
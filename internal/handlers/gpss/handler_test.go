@@ -0,0 +1,514 @@
+package gpss
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/FlagBrew/local-gpss/internal/checker"
+	"github.com/FlagBrew/local-gpss/internal/database"
+	"github.com/FlagBrew/local-gpss/internal/database/ent"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/generation"
+	"github.com/FlagBrew/local-gpss/internal/models"
+	"github.com/apex/log"
+	"github.com/apex/log/handlers/discard"
+	"github.com/go-chi/chi/v5"
+)
+
+var (
+	legalMon   = []byte("legal pokemon")
+	legalMon2  = []byte("another legal pokemon")
+	illegalMon = []byte("illegal pokemon")
+	unknownMon = []byte("pokemon without a fixture")
+)
+
+type testServer struct {
+	http.Handler
+	ctx  context.Context
+	db   *ent.Client
+	fake *checker.Fake
+}
+
+// newTestServer runs the handler against a fresh sqlite database and a fake checker that knows about the
+// legal and illegal test pokemon.
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+
+	logger := &log.Logger{Handler: discard.New(), Level: log.ErrorLevel}
+	ctx := log.NewContext(context.Background(), logger)
+	cfg := &models.DatabaseConfig{
+		DBType:           "sqlite",
+		ConnectionString: "file:" + filepath.Join(t.TempDir(), "gpss.db") + "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)",
+	}
+
+	db := database.New(ctx, cfg)
+	t.Cleanup(func() { db.Close() })
+
+	ctx = ent.NewContext(ctx, db)
+	database.Migrate(ctx, cfg, false)
+
+	s := &testServer{ctx: ctx, db: db, fake: checker.NewFake(checker.Fixtures{})}
+	for _, gen := range []generation.Generation{generation.Gen7, generation.Gen8} {
+		s.fake.SetCheck(s.key(legalMon, gen), models.GpssLegalityCheckReply{Legal: true})
+		s.fake.SetCheck(s.key(legalMon2, gen), models.GpssLegalityCheckReply{Legal: true})
+		s.fake.SetCheck(s.key(illegalMon, gen), models.GpssLegalityCheckReply{Legal: false, Report: []string{"Invalid: moves"}})
+	}
+
+	r := chi.NewRouter()
+	r.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(ent.NewContext(log.NewContext(r.Context(), logger), db)))
+		})
+	})
+	r.Route("/", NewHandler(s.fake).Route)
+	s.Handler = r
+
+	return s
+}
+
+// sample reads one of the sample pokemon of the pkm package.
+func sample(t *testing.T, name string) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("..", "..", "pkm", "testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
+
+// key is what the checker is asked about for the pokemon, its stored form.
+func (s *testServer) key(raw []byte, gen generation.Generation) string {
+	return base64.StdEncoding.EncodeToString(database.StoredData(s.ctx, raw, gen))
+}
+
+// upload sends the files the way PKSM does, with the rest of the request in the headers.
+func (s *testServer) upload(t *testing.T, path string, headers map[string]string, files map[string][]byte) *httptest.ResponseRecorder {
+	t.Helper()
+
+	return s.uploadForm(t, path, headers, nil, files)
+}
+
+// uploadForm sends the files along with form fields, the way the web UI does.
+func (s *testServer) uploadForm(t *testing.T, path string, headers, fields map[string]string, files map[string][]byte) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for name, value := range fields {
+		if err := mw.WriteField(name, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for name, data := range files {
+		fw, err := mw.CreateFormFile(name, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fw.Write(data)
+	}
+	mw.Close()
+
+	req := httptest.NewRequest(http.MethodPost, path, &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+	return w
+}
+
+func (s *testServer) search(t *testing.T, entityType string, payload any, reply any) {
+	t.Helper()
+
+	s.searchQuery(t, entityType, nil, payload, reply)
+}
+
+// searchQuery is search with the page, amount or cursor in the query.
+func (s *testServer) searchQuery(t *testing.T, entityType string, query url.Values, payload any, reply any) {
+	t.Helper()
+
+	w := s.list(t, entityType, query, payload)
+	if w.Code != http.StatusOK {
+		t.Fatalf("search status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+
+	if err := json.NewDecoder(w.Body).Decode(reply); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (s *testServer) list(t *testing.T, entityType string, query url.Values, payload any) *httptest.ResponseRecorder {
+	t.Helper()
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/search/"+entityType+"?"+query.Encode(), bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+	return w
+}
+
+func decodeCode(t *testing.T, w *httptest.ResponseRecorder) string {
+	t.Helper()
+
+	var reply struct {
+		Code string `json:"code"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&reply); err != nil {
+		t.Fatal(err)
+	}
+
+	return reply.Code
+}
+
+func TestUploadPokemon(t *testing.T) {
+	s := newTestServer(t)
+
+	removed := s.upload(t, "/upload/pokemon", map[string]string{"generation": "8"}, map[string][]byte{"pkmn": legalMon2})
+	if removed.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", removed.Code, removed.Body)
+	}
+	if _, err := database.SetPokemonVisibility(s.ctx, s.db, decodeCode(t, removed), pokemon.VisibilityDeleted, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		generation string
+		pkmn       []byte
+		status     int
+		legal      bool
+	}{
+		{name: "legal", generation: "8", pkmn: legalMon, status: http.StatusOK, legal: true},
+		{name: "illegal", generation: "8", pkmn: illegalMon, status: http.StatusOK},
+		{name: "unknown pokemon", generation: "8", pkmn: unknownMon, status: http.StatusUnprocessableEntity},
+		{name: "missing generation", pkmn: legalMon, status: http.StatusBadRequest},
+		{name: "removed", generation: "8", pkmn: legalMon2, status: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			headers := map[string]string{}
+			if tt.generation != "" {
+				headers["generation"] = tt.generation
+			}
+
+			w := s.upload(t, "/upload/pokemon", headers, map[string][]byte{"pkmn": tt.pkmn})
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+
+			if tt.status != http.StatusOK {
+				return
+			}
+
+			code := decodeCode(t, w)
+			mon, err := s.db.Pokemon.Query().Where(pokemon.DownloadCode(code)).Only(s.ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if mon.Legal != tt.legal {
+				t.Errorf("legal = %v, want %v", mon.Legal, tt.legal)
+			}
+
+			// Uploading the same pokemon again hands out the stored one.
+			again := s.upload(t, "/upload/pokemon", headers, map[string][]byte{"pkmn": tt.pkmn})
+			if again.Code != http.StatusOK {
+				t.Fatalf("status of the second upload = %d: %s", again.Code, again.Body)
+			}
+
+			if againCode := decodeCode(t, again); againCode != code {
+				t.Errorf("code of the second upload = %s, want %s", againCode, code)
+			}
+		})
+	}
+}
+
+// TestUploadForms sends the same pokemon the ways PKSM can, encrypted or not and from a box or the
+// party. They're all stored as the same pokemon.
+func TestUploadForms(t *testing.T) {
+	s := newTestServer(t)
+
+	files := []string{
+		"pk8_box_decrypted.pk8",
+		"pk8_box_encrypted.pk8",
+		"pk8_party_decrypted.pk8",
+		"pk8_party_encrypted.pk8",
+	}
+
+	var code string
+	for _, file := range files {
+		data := sample(t, file)
+		s.fake.SetCheck(s.key(data, generation.Gen8), models.GpssLegalityCheckReply{Legal: true})
+
+		w := s.upload(t, "/upload/pokemon", map[string]string{"generation": "8"}, map[string][]byte{"pkmn": data})
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: status = %d: %s", file, w.Code, w.Body)
+		}
+
+		got := decodeCode(t, w)
+		if code == "" {
+			code = got
+		} else if got != code {
+			t.Errorf("%s: code = %s, want %s", file, got, code)
+		}
+	}
+
+	mons, err := s.db.Pokemon.Query().All(s.ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(mons) != 1 || !bytes.Equal(mons[0].Data, sample(t, "pk8_box_decrypted.pk8")) {
+		t.Errorf("stored %d pokemon, want the canonical form once", len(mons))
+	}
+}
+
+// TestMergedDuplicateCode checks the download code of a duplicate that was merged into another pokemon
+// leads to that one.
+func TestMergedDuplicateCode(t *testing.T) {
+	s := newTestServer(t)
+
+	w := s.upload(t, "/upload/bundle", map[string]string{"count": "2", "generations": "8,8"}, map[string][]byte{"pkmn1": legalMon, "pkmn2": legalMon2})
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", w.Code, w.Body)
+	}
+	bundleCode := decodeCode(t, w)
+
+	original, err := s.db.Pokemon.Query().Where(pokemon.Data(legalMon)).Only(s.ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// What database.mergeDuplicate leaves behind.
+	dup, err := s.db.Pokemon.Create().
+		SetUploadDatetime(time.Now()).
+		SetDownloadCode("0000000042").
+		SetGeneration(generation.Gen8.String()).
+		SetLegal(true).
+		SetData(legalMon).
+		SetMergedInto(original).
+		SetVisibility(pokemon.VisibilityDeleted).
+		Save(s.ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	detail := get("/pokemon/" + dup.DownloadCode)
+	if detail.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", detail.Code, detail.Body)
+	}
+	if code := decodeCode(t, detail); code != original.DownloadCode {
+		t.Errorf("code = %s, want %s", code, original.DownloadCode)
+	}
+
+	var reply gpssBundleListResponse
+	s.search(t, "bundles", listRequest{ContainsCodes: []string{dup.DownloadCode}}, &reply)
+	if len(reply.Bundles) != 1 || reply.Bundles[0].DownloadCode != bundleCode {
+		t.Errorf("bundles containing the duplicate = %+v, want %s", reply.Bundles, bundleCode)
+	}
+
+	// Once the original is taken down the duplicate's code goes with it.
+	if _, err := database.SetPokemonVisibility(s.ctx, s.db, original.DownloadCode, pokemon.VisibilityHidden, ""); err != nil {
+		t.Fatal(err)
+	}
+	if w := get("/pokemon/" + dup.DownloadCode); w.Code != http.StatusNotFound {
+		t.Errorf("status after hiding the original = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestUploadBundle(t *testing.T) {
+	s := newTestServer(t)
+
+	// A member that was stored before counts towards the legality of the bundle as well.
+	stored := s.upload(t, "/upload/pokemon", map[string]string{"generation": "7"}, map[string][]byte{"pkmn": illegalMon})
+	if stored.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", stored.Code, stored.Body)
+	}
+
+	tests := []struct {
+		name        string
+		count       string
+		generations string
+		pkmn        [][]byte
+		status      int
+		legal       bool
+		errPrefix   string
+	}{
+		{name: "legal", count: "2", generations: "8,7", pkmn: [][]byte{legalMon, legalMon2}, status: http.StatusOK, legal: true},
+		{name: "same pokemon twice", count: "2", generations: "8,8", pkmn: [][]byte{legalMon, legalMon}, status: http.StatusOK, legal: true},
+		{name: "illegal member", count: "2", generations: "8,8", pkmn: [][]byte{legalMon, illegalMon}, status: http.StatusOK},
+		{name: "stored illegal member", count: "2", generations: "7,8", pkmn: [][]byte{illegalMon, legalMon2}, status: http.StatusOK},
+		{name: "unknown member", count: "2", generations: "8,8", pkmn: [][]byte{legalMon, unknownMon}, status: http.StatusUnprocessableEntity, errPrefix: "pkmn2:"},
+		{name: "missing count", generations: "8", pkmn: [][]byte{legalMon}, status: http.StatusBadRequest},
+		{name: "too many", count: "7", generations: "8,8,8,8,8,8,8", pkmn: [][]byte{legalMon}, status: http.StatusBadRequest},
+		{name: "generations mismatch", count: "2", generations: "8", pkmn: [][]byte{legalMon, legalMon2}, status: http.StatusBadRequest},
+		{name: "unknown generation", count: "1", generations: "42", pkmn: [][]byte{legalMon}, status: http.StatusBadRequest, errPrefix: "pkmn1:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			headers := map[string]string{"generations": tt.generations}
+			if tt.count != "" {
+				headers["count"] = tt.count
+			}
+
+			files := map[string][]byte{}
+			for i, data := range tt.pkmn {
+				files[fmt.Sprintf("pkmn%d", i+1)] = data
+			}
+
+			w := s.upload(t, "/upload/bundle", headers, files)
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+
+			if tt.status != http.StatusOK {
+				var reply struct {
+					Error string `json:"error"`
+				}
+				if err := json.NewDecoder(w.Body).Decode(&reply); err != nil {
+					t.Fatal(err)
+				}
+
+				if !strings.HasPrefix(reply.Error, tt.errPrefix) {
+					t.Errorf("error = %q, want it to start with %q", reply.Error, tt.errPrefix)
+				}
+				return
+			}
+
+			code := decodeCode(t, w)
+			bun, err := s.db.Bundle.Query().Where(bundle.DownloadCode(code)).WithSlots(withSlots).Only(s.ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if bun.Legal != tt.legal {
+				t.Errorf("legal = %v, want %v", bun.Legal, tt.legal)
+			}
+
+			gens := strings.Split(tt.generations, ",")
+			if len(bun.Edges.Slots) != len(gens) {
+				t.Fatalf("slots = %d, want %d", len(bun.Edges.Slots), len(gens))
+			}
+
+			for i, slot := range bun.Edges.Slots {
+				gen, _ := generation.Parse(gens[i])
+				if slot.Position != i || slot.Generation != gen.String() {
+					t.Errorf("slot %d = position %d generation %s, want generation %s", i, slot.Position, slot.Generation, gen)
+				}
+			}
+
+			// Uploading the same bundle again hands out the stored one.
+			if again := s.upload(t, "/upload/bundle", headers, files); decodeCode(t, again) != code {
+				t.Errorf("the second upload didn't return %s", code)
+			}
+		})
+	}
+}
+
+func TestUploadBundleDescription(t *testing.T) {
+	s := newTestServer(t)
+
+	headers := map[string]string{"count": "1", "generations": "8"}
+	files := map[string][]byte{"pkmn1": legalMon}
+
+	tests := []struct {
+		name    string
+		fields  map[string]string
+		headers map[string]string
+		status  int
+		want    string
+	}{
+		{name: "form fields", fields: map[string]string{"title": " Starters ", "description": "d", "notes": "n"}, status: http.StatusOK, want: "Starters"},
+		{name: "headers", headers: map[string]string{"title": "From headers"}, status: http.StatusOK, want: "From headers"},
+		// The limits are in characters, not bytes.
+		{name: "longest title", fields: map[string]string{"title": strings.Repeat("é", maxTitleLen)}, status: http.StatusOK, want: strings.Repeat("é", maxTitleLen)},
+		{name: "title too long", fields: map[string]string{"title": strings.Repeat("é", maxTitleLen+1)}, status: http.StatusBadRequest, want: "title can be at most 100 characters"},
+		{name: "description too long", fields: map[string]string{"description": strings.Repeat("a", maxDescriptionLen+1)}, status: http.StatusBadRequest, want: "description can be at most 1000 characters"},
+		{name: "notes too long", headers: map[string]string{"notes": strings.Repeat("a", maxNotesLen+1)}, status: http.StatusBadRequest, want: "notes can be at most 2000 characters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := map[string]string{}
+			for _, m := range []map[string]string{headers, tt.headers} {
+				for k, v := range m {
+					h[k] = v
+				}
+			}
+
+			w := s.uploadForm(t, "/upload/bundle", h, tt.fields, files)
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+
+			if tt.status != http.StatusOK {
+				var reply struct {
+					Error string `json:"error"`
+				}
+				if err := json.NewDecoder(w.Body).Decode(&reply); err != nil {
+					t.Fatal(err)
+				}
+
+				if reply.Error != tt.want {
+					t.Errorf("error = %q, want %q", reply.Error, tt.want)
+				}
+				return
+			}
+
+			bun, err := s.db.Bundle.Query().Where(bundle.DownloadCode(decodeCode(t, w))).Only(s.ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if bun.Title == nil || *bun.Title != tt.want {
+				t.Errorf("title = %v, want %q", bun.Title, tt.want)
+			}
+		})
+	}
+}
+
+func TestUploadBundleMalformed(t *testing.T) {
+	s := newTestServer(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/upload/bundle", strings.NewReader("not a multipart body"))
+	req.Header.Set("Content-Type", "multipart/form-data; boundary=missing")
+	req.Header.Set("count", "1")
+	req.Header.Set("generations", "8")
+
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d: %s", w.Code, http.StatusBadRequest, w.Body)
+	}
+}
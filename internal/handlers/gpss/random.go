@@ -0,0 +1,151 @@
+package gpss
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/FlagBrew/local-gpss/internal/console"
+	"github.com/FlagBrew/local-gpss/internal/database/ent"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/utils"
+	"github.com/apex/log"
+	"github.com/lrstanley/chix"
+)
+
+type gpssRandomResponse struct {
+	Pokemon gpssPokemon `json:"pokemon"`
+	// Uploaded is the download code of the pokemon given in exchange, if any.
+	Uploaded string `json:"uploaded,omitempty"`
+}
+
+// aggregateAs applies the aggregate function to the ID column under the given name, so it can be scanned.
+func aggregateAs(fn func(string) string, name string) ent.AggregateFunc {
+	return func(s *entsql.Selector) string {
+		return entsql.As(fn(s.C(pokemon.FieldID)), name)
+	}
+}
+
+// randomProbes is how many random IDs pickRandom tries before it falls back to counting the matches.
+const randomProbes = 8
+
+// pickRandom returns a random pokemon of the query, every one of them as likely as the others. Random IDs
+// between the lowest and highest match are looked up until one matches, instead of ORDER BY RANDOM()
+// which has to shuffle the whole table. Taking the first match after a random ID would be cheaper but
+// favours the pokemon after gaps, left by removed pokemon or the filters. When the matches are too
+// sparse for the probes to find one they're counted and a random one is skipped to.
+func pickRandom(ctx context.Context, query *ent.PokemonQuery) (*ent.Pokemon, error) {
+	var bounds []struct {
+		Min sql.NullInt64 `json:"min"`
+		Max sql.NullInt64 `json:"max"`
+	}
+	err := query.Clone().Aggregate(aggregateAs(entsql.Min, "min"), aggregateAs(entsql.Max, "max")).Scan(ctx, &bounds)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bounds) == 0 || !bounds[0].Min.Valid {
+		return nil, &ent.NotFoundError{}
+	}
+
+	low, high := bounds[0].Min.Int64, bounds[0].Max.Int64
+	for range randomProbes {
+		mon, err := query.Clone().Where(pokemon.ID(int(low + rand.Int64N(high-low+1)))).Only(ctx)
+		if !ent.IsNotFound(err) {
+			return mon, err
+		}
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if total == 0 {
+		return nil, &ent.NotFoundError{}
+	}
+
+	return query.Clone().Order(pokemon.ByID()).Offset(rand.IntN(total)).First(ctx)
+}
+
+// random hands out a random pokemon matching the same filters as search, surprise trade style. When a
+// pokemon is sent along (like uploadPokemon) it's uploaded first and the trade only happens if that
+// succeeds, it's never handed back to the sender.
+func (h *Handler) random(w http.ResponseWriter, r *http.Request) {
+	var payload listRequest
+	if chix.Error(w, r, chix.Bind(r, &payload)) {
+		return
+	}
+
+	logger := log.FromContext(r.Context())
+	db := ent.FromContext(r.Context())
+	if db == nil {
+		logger.Error("db is nil")
+		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to connect to database"})
+		return
+	}
+
+	args := payload.pokemonPredicates()
+	var resp gpssRandomResponse
+
+	if r.MultipartForm != nil && len(r.MultipartForm.File["pkmn"]) > 0 {
+		consoleArgs, statusCode, err := utils.PrepareCall(r, "legality")
+		if err != nil {
+			chix.JSON(w, r, statusCode, chix.M{"error": err.Error()})
+			return
+		}
+
+		uploaded, err := h.findOrUploadPkmn(r, db, logger, *consoleArgs)
+		if err != nil {
+			if errors.Is(err, errRemoved) {
+				chix.JSON(w, r, http.StatusForbidden, chix.M{"error": err.Error()})
+				return
+			}
+
+			var consoleErr *console.Error
+			if errors.As(err, &consoleErr) {
+				utils.WriteLegalityError(w, r, err)
+				return
+			}
+
+			chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to upload pokemon"})
+			return
+		}
+
+		resp.Uploaded = uploaded.DownloadCode
+		args = append(args, pokemon.IDNEQ(uploaded.ID))
+	}
+
+	mon, err := pickRandom(r.Context(), db.Pokemon.Query().Where(pokemon.And(args...)))
+	if ent.IsNotFound(err) {
+		// The upload is kept either way, let the sender know where it went.
+		body := chix.M{"error": "no pokemon match the filters"}
+		if resp.Uploaded != "" {
+			body["uploaded"] = resp.Uploaded
+		}
+		chix.JSON(w, r, http.StatusNotFound, body)
+		return
+	} else if err != nil {
+		logger.WithError(err).Error("failed to get a random pokemon")
+		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to get a random pokemon"})
+		return
+	}
+
+	if _, err = mon.Update().AddDownloadCount(1).Save(r.Context()); err != nil {
+		logger.WithError(err).WithField("download_code", mon.DownloadCode).Error("failed to update download count")
+		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": "failed to get a random pokemon"})
+		return
+	}
+
+	resp.Pokemon = gpssPokemon{
+		Legal:      mon.Legal,
+		Generation: mon.Generation,
+		Code:       mon.DownloadCode,
+		Base64:     pokemonBase64(mon),
+	}
+
+	chix.JSON(w, r, http.StatusOK, resp)
+}
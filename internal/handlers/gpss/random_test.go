@@ -0,0 +1,101 @@
+package gpss
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/generation"
+)
+
+func (s *testServer) random(t *testing.T) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/random/pokemon", bytes.NewReader([]byte("{}")))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	s.ServeHTTP(w, req)
+	return w
+}
+
+// TestRandomUniform leaves three visible pokemon with a gap of hidden ones in front of the last, which
+// taking the first match after a random ID would pick most of the time.
+func TestRandomUniform(t *testing.T) {
+	s := newTestServer(t)
+
+	visible := map[string]int{}
+	for i := range 50 {
+		code := fmt.Sprintf("%010d", i+1)
+		create := s.db.Pokemon.Create().
+			SetUploadDatetime(time.Now()).
+			SetDownloadCode(code).
+			SetGeneration(generation.Gen8.String()).
+			SetLegal(true).
+			SetData([]byte(code))
+		if i == 0 || i == 1 || i == 49 {
+			visible[code] = 0
+		} else {
+			create.SetVisibility(pokemon.VisibilityHidden)
+		}
+
+		if err := create.Exec(s.ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	const picks = 300
+	for range picks {
+		w := s.random(t)
+		if w.Code != http.StatusOK {
+			t.Fatalf("status = %d: %s", w.Code, w.Body)
+		}
+
+		var reply gpssRandomResponse
+		if err := json.NewDecoder(w.Body).Decode(&reply); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, ok := visible[reply.Pokemon.Code]; !ok {
+			t.Fatalf("picked %s, which is hidden", reply.Pokemon.Code)
+		}
+		visible[reply.Pokemon.Code]++
+	}
+
+	// Each is expected 100 times, this is about five standard deviations either way.
+	for code, n := range visible {
+		if n < 60 || n > 140 {
+			t.Errorf("picked %s %d out of %d times, want about %d", code, n, picks, picks/len(visible))
+		}
+	}
+}
+
+func TestRandomNoMatch(t *testing.T) {
+	s := newTestServer(t)
+
+	if w := s.random(t); w.Code != http.StatusNotFound {
+		t.Errorf("status with nothing stored = %d, want %d", w.Code, http.StatusNotFound)
+	}
+
+	// The pokemon sent in exchange is never handed back, but it's kept.
+	w := s.upload(t, "/random/pokemon", map[string]string{"generation": "8"}, map[string][]byte{"pkmn": legalMon})
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusNotFound, w.Body)
+	}
+
+	var reply struct {
+		Uploaded string `json:"uploaded"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&reply); err != nil {
+		t.Fatal(err)
+	}
+
+	if exists, _ := s.db.Pokemon.Query().Where(pokemon.DownloadCode(reply.Uploaded)).Exist(s.ctx); !exists {
+		t.Errorf("uploaded pokemon %q wasn't kept", reply.Uploaded)
+	}
+}
@@ -1,8 +1,253 @@
 package gpss
 
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/predicate"
+	"github.com/FlagBrew/local-gpss/internal/generation"
+)
+
 type listRequest struct {
 	Generations   []string `json:"generations" form:"generations"`
 	LegalOnly     bool     `json:"legal_only" form:"legal_only"`
 	SortDirection bool     `json:"sort_direction" form:"sort_direction"`
 	SortField     string   `json:"sort_field" form:"sort_field"`
+
+	// Sort takes precedence over sort_field/sort_direction, the seed keeps random pages consistent.
+	Sort []sortKey `json:"sort" form:"sort" validate:"max=5,dive"`
+	Seed *int64    `json:"seed" form:"seed" validate:"omitempty,min=0,max=1000000000"`
+
+	// Filters on the decoded metadata, only used when searching pokemon.
+	Species  []int    `json:"species" form:"species" validate:"dive,min=1"`
+	Forms    []int    `json:"forms" form:"forms" validate:"dive,min=0"`
+	Shiny    *bool    `json:"shiny" form:"shiny"`
+	MinLevel int      `json:"min_level" form:"min_level" validate:"omitempty,min=1,max=100"`
+	MaxLevel int      `json:"max_level" form:"max_level" validate:"omitempty,min=1,max=100,gtefield=MinLevel"`
+	Natures  []int    `json:"natures" form:"natures" validate:"dive,min=0,max=24"`
+	Balls    []int    `json:"balls" form:"balls" validate:"dive,min=0"`
+	OTNames  []string `json:"ot_names" form:"ot_names" validate:"dive,required"`
+
+	// Filters on the members, only used when searching bundles.
+	ContainsSpecies []int    `json:"contains_species" form:"contains_species" validate:"dive,min=1"`
+	SpeciesMatch    string   `json:"species_match" form:"species_match" validate:"omitempty,oneof=any all"`
+	ContainsCodes   []string `json:"contains_codes" form:"contains_codes" validate:"dive,required"`
+	MinMembers      int      `json:"min_members" form:"min_members" validate:"omitempty,min=1,max=6"`
+	MaxMembers      int      `json:"max_members" form:"max_members" validate:"omitempty,min=1,max=6,gtefield=MinMembers"`
+
+	// Looked for in the title, description and notes of bundles, regardless of case.
+	Text string `json:"text" form:"text" validate:"max=100"`
+}
+
+// generations returns the requested generations in order, unknown values have always been ignored.
+func (p *listRequest) generations() []generation.Generation {
+	var gens []generation.Generation
+	for _, name := range p.Generations {
+		if gen, err := generation.Parse(name); err == nil {
+			gens = append(gens, gen)
+		}
+	}
+	slices.SortFunc(gens, generation.Compare)
+
+	return gens
+}
+
+// pokemonPredicates returns everything the request filters pokemon on.
+func (p *listRequest) pokemonPredicates() []predicate.Pokemon {
+	preds := []predicate.Pokemon{pokemon.VisibilityEQ(pokemon.VisibilityVisible)}
+
+	if gens := p.generations(); len(gens) > 0 {
+		names := make([]string, len(gens))
+		for i, gen := range gens {
+			names[i] = gen.String()
+		}
+		preds = append(preds, pokemon.GenerationIn(names...))
+	}
+
+	if p.LegalOnly {
+		preds = append(preds, pokemon.Legal(true))
+	}
+
+	return append(preds, p.pokemonFilters()...)
+}
+
+// pokemonFilters translates the metadata filters into predicates, filters left empty match everything.
+func (p *listRequest) pokemonFilters() []predicate.Pokemon {
+	var filters []predicate.Pokemon
+
+	if len(p.Species) > 0 {
+		filters = append(filters, pokemon.SpeciesIn(p.Species...))
+	}
+
+	if len(p.Forms) > 0 {
+		filters = append(filters, pokemon.FormIn(p.Forms...))
+	}
+
+	if p.Shiny != nil {
+		filters = append(filters, pokemon.Shiny(*p.Shiny))
+	}
+
+	if p.MinLevel > 0 {
+		filters = append(filters, pokemon.LevelGTE(p.MinLevel))
+	}
+
+	if p.MaxLevel > 0 {
+		filters = append(filters, pokemon.LevelLTE(p.MaxLevel))
+	}
+
+	if len(p.Natures) > 0 {
+		filters = append(filters, pokemon.NatureIn(p.Natures...))
+	}
+
+	if len(p.Balls) > 0 {
+		filters = append(filters, pokemon.BallIn(p.Balls...))
+	}
+
+	if len(p.OTNames) > 0 {
+		// OT names are matched regardless of case, PKSM users won't remember how it was capitalised.
+		var names []predicate.Pokemon
+		for _, name := range p.OTNames {
+			names = append(names, pokemon.OtNameEqualFold(name))
+		}
+		filters = append(filters, pokemon.Or(names...))
+	}
+
+	return filters
+}
+
+// bundleFilters translates the bundle filters into predicates. Species match any of the given species
+// unless species_match is "all", a bundle has to contain every one of the given download codes.
+func (p *listRequest) bundleFilters() []predicate.Bundle {
+	var filters []predicate.Bundle
+
+	if len(p.ContainsSpecies) > 0 {
+		if p.SpeciesMatch == "all" {
+			for _, species := range p.ContainsSpecies {
+				filters = append(filters, hasMemberWith(pokemon.Species(species)))
+			}
+		} else {
+			filters = append(filters, hasMemberWith(pokemon.SpeciesIn(p.ContainsSpecies...)))
+		}
+	}
+
+	for _, code := range p.ContainsCodes {
+		filters = append(filters, hasMemberWith(pokemonCode(code)))
+	}
+
+	if text := strings.TrimSpace(p.Text); text != "" {
+		filters = append(filters, bundle.Or(
+			bundle.TitleContainsFold(text),
+			bundle.DescriptionContainsFold(text),
+			bundle.NotesContainsFold(text),
+		))
+	}
+
+	if p.MinMembers > 0 {
+		filters = append(filters, memberCount(sql.OpGTE, p.MinMembers))
+	}
+
+	if p.MaxMembers > 0 {
+		filters = append(filters, memberCount(sql.OpLTE, p.MaxMembers))
+	}
+
+	return filters
+}
+
+// memberCount compares the amount of slots in a bundle against n.
+func memberCount(op sql.Op, n int) predicate.Bundle {
+	return predicate.Bundle(func(s *sql.Selector) {
+		t := sql.Table(bundleslot.Table)
+		count := sql.Select(sql.Count("*")).
+			From(t).
+			Where(sql.ColumnsEQ(t.C(bundleslot.FieldBundleID), s.C(bundle.FieldID)))
+
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Wrap(func(b *sql.Builder) {
+				b.Join(count)
+			})
+			b.WriteOp(op)
+			b.Arg(n)
+		}))
+	})
+}
+
+// The most characters an uploader can describe a bundle with.
+const (
+	maxTitleLen       = 100
+	maxDescriptionLen = 1000
+	maxNotesLen       = 2000
+)
+
+// bundleDescription is what the uploader tells about a bundle, empty values are left unset.
+type bundleDescription struct {
+	Title       string
+	Description string
+	Notes       string
+}
+
+// parseBundleDescription reads the description from the form fields of an upload, falling back to headers
+// of the same name for clients that only send the files.
+func parseBundleDescription(r *http.Request) (bundleDescription, error) {
+	value := func(name string) string {
+		if v := r.PostFormValue(name); v != "" {
+			return strings.TrimSpace(v)
+		}
+		return strings.TrimSpace(r.Header.Get(name))
+	}
+
+	desc := bundleDescription{
+		Title:       value("title"),
+		Description: value("description"),
+		Notes:       value("notes"),
+	}
+
+	limits := []struct {
+		name  string
+		value string
+		max   int
+	}{
+		{"title", desc.Title, maxTitleLen},
+		{"description", desc.Description, maxDescriptionLen},
+		{"notes", desc.Notes, maxNotesLen},
+	}
+
+	for _, limit := range limits {
+		if utf8.RuneCountInString(limit.value) > limit.max {
+			return desc, fmt.Errorf("%s can be at most %d characters", limit.name, limit.max)
+		}
+	}
+
+	return desc, nil
+}
+
+// predicates matches the bundles with the same description.
+func (d bundleDescription) predicates() []predicate.Bundle {
+	return []predicate.Bundle{
+		optionalEQ(d.Title, bundle.Title, bundle.TitleIsNil),
+		optionalEQ(d.Description, bundle.Description, bundle.DescriptionIsNil),
+		optionalEQ(d.Notes, bundle.Notes, bundle.NotesIsNil),
+	}
+}
+
+// optionalEQ matches a field that's nil when left empty.
+func optionalEQ(v string, eq func(string) predicate.Bundle, isNil func() predicate.Bundle) predicate.Bundle {
+	if v == "" {
+		return isNil()
+	}
+	return eq(v)
+}
+
+// optional is nil for an empty value, so it's left unset.
+func optional(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
 }
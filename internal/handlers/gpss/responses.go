@@ -1,9 +1,21 @@
 package gpss
 
+import (
+	"encoding/base64"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/FlagBrew/local-gpss/internal/database/ent"
+)
+
+// gpssPokemonListResponse leaves out the total and pages when the page was asked for with a cursor.
 type gpssPokemonListResponse struct {
 	Page    int           `json:"page"`
-	Pages   int           `json:"pages"`
-	Total   int           `json:"total"`
+	Pages   *int          `json:"pages,omitempty"`
+	Total   *int          `json:"total,omitempty"`
+	Seed    *int64        `json:"seed,omitempty"`
+	Cursor  string        `json:"cursor,omitempty"`
 	Pokemon []gpssPokemon `json:"pokemon"`
 }
 
@@ -16,11 +28,23 @@ type gpssPokemon struct {
 
 type gpssBundleListResponse struct {
 	Page    int          `json:"page"`
-	Pages   int          `json:"pages"`
-	Total   int          `json:"total"`
+	Pages   *int         `json:"pages,omitempty"`
+	Total   *int         `json:"total,omitempty"`
+	Seed    *int64       `json:"seed,omitempty"`
+	Cursor  string       `json:"cursor,omitempty"`
 	Bundles []gpssBundle `json:"bundles"`
 }
 
+// pageCount returns how many pages of limit the total fills, nil when the total wasn't counted.
+func pageCount(total *int, limit int) *int {
+	if total == nil {
+		return nil
+	}
+
+	pages := int(math.Ceil(float64(*total) / float64(limit)))
+	return &pages
+}
+
 type gpssBundlePokemon struct {
 	Legal      bool   `json:"legality"`
 	Base64     string `json:"base_64"`
@@ -36,4 +60,141 @@ type gpssBundle struct {
 	MaxGen        string              `json:"max_gen"`
 	Count         int                 `json:"count"`
 	Legal         bool                `json:"legality"`
+	Title         *string             `json:"title,omitempty"`
+	Description   *string             `json:"description,omitempty"`
+	Notes         *string             `json:"notes,omitempty"`
+}
+
+type gpssPokemonMetadata struct {
+	Species    *int    `json:"species"`
+	Form       *int    `json:"form"`
+	Level      *int    `json:"level"`
+	Shiny      *bool   `json:"shiny"`
+	Nature     *int    `json:"nature"`
+	Gender     *int    `json:"gender"`
+	OTName     *string `json:"ot_name"`
+	TID        *int    `json:"tid"`
+	SID        *int    `json:"sid"`
+	Ball       *int    `json:"ball"`
+	HeldItem   *int    `json:"held_item"`
+	Language   *int    `json:"language"`
+	OriginGame *int    `json:"origin_game"`
+}
+
+type gpssPokemonDetail struct {
+	Code           string               `json:"code"`
+	Generation     string               `json:"generation"`
+	Base64         string               `json:"base_64"`
+	Legal          bool                 `json:"legal"`
+	UploadDatetime time.Time            `json:"upload_datetime"`
+	DownloadCount  int                  `json:"download_count"`
+	Legality       gpssLegalityResponse `json:"legality"`
+	Metadata       gpssPokemonMetadata  `json:"metadata"`
+}
+
+type gpssBundleDetail struct {
+	Code           string              `json:"code"`
+	Legal          bool                `json:"legal"`
+	MinGen         string              `json:"min_gen"`
+	MaxGen         string              `json:"max_gen"`
+	UploadDatetime time.Time           `json:"upload_datetime"`
+	DownloadCount  int                 `json:"download_count"`
+	Count          int                 `json:"count"`
+	Title          *string             `json:"title,omitempty"`
+	Description    *string             `json:"description,omitempty"`
+	Notes          *string             `json:"notes,omitempty"`
+	Pokemons       []gpssPokemonDetail `json:"pokemons"`
+}
+
+type gpssLegalityResponse struct {
+	Code          string     `json:"code"`
+	Legal         bool       `json:"legal"`
+	Report        []string   `json:"report"`
+	EngineVersion string     `json:"engine_version"`
+	CheckedAt     *time.Time `json:"checked_at"`
+}
+
+type gpssBundleLegalityResponse struct {
+	Code         string                 `json:"code"`
+	Legal        bool                   `json:"legal"`
+	IllegalCount int                    `json:"illegal_count"`
+	Pokemons     []gpssLegalityResponse `json:"pokemons"`
+}
+
+func newLegalityResponse(mon *ent.Pokemon) gpssLegalityResponse {
+	resp := gpssLegalityResponse{
+		Code:          mon.DownloadCode,
+		Legal:         mon.Legal,
+		Report:        mon.LegalityReport,
+		EngineVersion: mon.EngineVersion,
+		CheckedAt:     mon.LegalityCheckedAt,
+	}
+
+	// Pokémon imported without a recheck have never had a report stored.
+	if resp.Report == nil {
+		resp.Report = []string{}
+	}
+
+	return resp
+}
+
+func newPokemonDetail(mon *ent.Pokemon) gpssPokemonDetail {
+	return gpssPokemonDetail{
+		Code:           mon.DownloadCode,
+		Generation:     mon.Generation,
+		Base64:         pokemonBase64(mon),
+		Legal:          mon.Legal,
+		UploadDatetime: mon.UploadDatetime,
+		DownloadCount:  mon.DownloadCount,
+		Legality:       newLegalityResponse(mon),
+		Metadata: gpssPokemonMetadata{
+			Species:    mon.Species,
+			Form:       mon.Form,
+			Level:      mon.Level,
+			Shiny:      mon.Shiny,
+			Nature:     mon.Nature,
+			Gender:     mon.Gender,
+			OTName:     mon.OtName,
+			TID:        mon.Tid,
+			SID:        mon.Sid,
+			Ball:       mon.Ball,
+			HeldItem:   mon.HeldItem,
+			Language:   mon.Language,
+			OriginGame: mon.OriginGame,
+		},
+	}
+}
+
+func newBundleDetail(bun *ent.Bundle) gpssBundleDetail {
+	resp := gpssBundleDetail{
+		Code:           bun.DownloadCode,
+		Legal:          bun.Legal,
+		MinGen:         formatGen(bun.MinGen),
+		MaxGen:         formatGen(bun.MaxGen),
+		UploadDatetime: bun.UploadDatetime,
+		DownloadCount:  bun.DownloadCount,
+		Count:          len(bun.Edges.Slots),
+		Title:          bun.Title,
+		Description:    bun.Description,
+		Notes:          bun.Notes,
+		Pokemons:       []gpssPokemonDetail{},
+	}
+
+	for _, slot := range bun.Edges.Slots {
+		detail := newPokemonDetail(slot.Edges.Pokemon)
+		detail.Generation = slot.Generation
+		resp.Pokemons = append(resp.Pokemons, detail)
+	}
+
+	return resp
+}
+
+// formatGen turns the numeric min/max gen of a bundle back into how generations are written elsewhere.
+func formatGen(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
+
+// pokemonBase64 is the form PKSM and GpssConsole take pokemon in, they're stored as binary.
+func pokemonBase64(mon *ent.Pokemon) string {
+	return base64.StdEncoding.EncodeToString(mon.Data)
 }
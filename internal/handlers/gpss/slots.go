@@ -0,0 +1,28 @@
+package gpss
+
+import (
+	"github.com/FlagBrew/local-gpss/internal/database/ent"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/predicate"
+)
+
+// withSlots loads the slots of a bundle in order, along with their pokemon.
+func withSlots(q *ent.BundleSlotQuery) {
+	q.Order(bundleslot.ByPosition()).WithPokemon()
+}
+
+// members returns the pokemon of a bundle loaded with withSlots, in slot order.
+func members(bun *ent.Bundle) []*ent.Pokemon {
+	mons := make([]*ent.Pokemon, len(bun.Edges.Slots))
+	for i, slot := range bun.Edges.Slots {
+		mons[i] = slot.Edges.Pokemon
+	}
+
+	return mons
+}
+
+// hasMemberWith matches the bundles that have a pokemon matching all the predicates.
+func hasMemberWith(preds ...predicate.Pokemon) predicate.Bundle {
+	return bundle.HasSlotsWith(bundleslot.HasPokemonWith(preds...))
+}
@@ -0,0 +1,186 @@
+package gpss
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/FlagBrew/local-gpss/internal/database/ent"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/generation"
+)
+
+const maxSeed = 1_000_000_000
+
+type sortKey struct {
+	Field      string `json:"field" form:"field" validate:"required,oneof=latest popularity generation legality species level random"`
+	Descending bool   `json:"descending" form:"descending"`
+}
+
+// sortKeys returns the requested sort keys, falling back to the single sort_field/sort_direction pair
+// older clients send. Unknown legacy fields have always meant latest.
+func (p *listRequest) sortKeys() []sortKey {
+	if len(p.Sort) > 0 {
+		return p.Sort
+	}
+
+	key := sortKey{Field: "latest", Descending: p.SortDirection}
+	switch p.SortField {
+	case "popularity", "generation", "legality", "species", "level", "random":
+		key.Field = p.SortField
+	}
+
+	return []sortKey{key}
+}
+
+// term is a single expression rows are ordered by. The value of the expression for a row is what ends
+// up in the cursor, so the next page can continue right after it.
+type term[T any] struct {
+	expr  func(*sql.Selector) string
+	desc  bool
+	kind  valueKind
+	value func(T) any
+}
+
+func column[T any](name string) func(*sql.Selector) string {
+	return func(s *sql.Selector) string { return s.C(name) }
+}
+
+// pokemonTerms translates the sort keys into terms, the ID is always added last so pages don't shuffle
+// around when the other keys are equal. It goes in the direction of the last key, which lists the newest
+// of equal rows first when sorting descending and turns the whole listing around with every key.
+func pokemonTerms(keys []sortKey, seed int64) ([]term[*ent.Pokemon], error) {
+	var terms []term[*ent.Pokemon]
+	for _, key := range keys {
+		t := term[*ent.Pokemon]{desc: key.Descending}
+		switch key.Field {
+		case "latest":
+			t.expr, t.kind = column[*ent.Pokemon](pokemon.FieldUploadDatetime), kindTime
+			t.value = func(p *ent.Pokemon) any { return p.UploadDatetime }
+		case "popularity":
+			t.expr, t.kind = column[*ent.Pokemon](pokemon.FieldDownloadCount), kindInt
+			t.value = func(p *ent.Pokemon) any { return p.DownloadCount }
+		case "generation":
+			t.expr, t.kind = generationExpr(pokemon.FieldGeneration), kindFloat
+			t.value = func(p *ent.Pokemon) any {
+				gen, _ := generation.Parse(p.Generation)
+				return gen.Number()
+			}
+		case "legality":
+			t.expr, t.kind = column[*ent.Pokemon](pokemon.FieldLegal), kindBool
+			t.value = func(p *ent.Pokemon) any { return p.Legal }
+		case "species":
+			// Pokemon that couldn't be decoded sort as 0, databases don't agree where NULL goes.
+			t.expr, t.kind = coalesceExpr(pokemon.FieldSpecies), kindInt
+			t.value = func(p *ent.Pokemon) any { return derefInt(p.Species) }
+		case "level":
+			t.expr, t.kind = coalesceExpr(pokemon.FieldLevel), kindInt
+			t.value = func(p *ent.Pokemon) any { return derefInt(p.Level) }
+		case "random":
+			t.expr, t.kind = randomExpr(pokemon.FieldID, seed), kindInt
+			t.value = func(p *ent.Pokemon) any { return randomValue(p.ID, seed) }
+		default:
+			return nil, fmt.Errorf("cannot sort pokemon by %s", key.Field)
+		}
+		terms = append(terms, t)
+	}
+
+	return append(terms, term[*ent.Pokemon]{
+		expr:  column[*ent.Pokemon](pokemon.FieldID),
+		desc:  keys[len(keys)-1].Descending,
+		kind:  kindInt,
+		value: func(p *ent.Pokemon) any { return p.ID },
+	}), nil
+}
+
+// bundleTerms is pokemonTerms for bundles, which can't be sorted by anything a single member decides.
+func bundleTerms(keys []sortKey, seed int64) ([]term[*ent.Bundle], error) {
+	var terms []term[*ent.Bundle]
+	for _, key := range keys {
+		t := term[*ent.Bundle]{desc: key.Descending}
+		switch key.Field {
+		case "latest":
+			t.expr, t.kind = column[*ent.Bundle](bundle.FieldUploadDatetime), kindTime
+			t.value = func(b *ent.Bundle) any { return b.UploadDatetime }
+		case "popularity":
+			t.expr, t.kind = column[*ent.Bundle](bundle.FieldDownloadCount), kindInt
+			t.value = func(b *ent.Bundle) any { return b.DownloadCount }
+		case "generation":
+			t.expr, t.kind = column[*ent.Bundle](bundle.FieldMinGen), kindFloat
+			t.value = func(b *ent.Bundle) any { return b.MinGen }
+			terms = append(terms, t)
+
+			t = term[*ent.Bundle]{desc: key.Descending}
+			t.expr, t.kind = column[*ent.Bundle](bundle.FieldMaxGen), kindFloat
+			t.value = func(b *ent.Bundle) any { return b.MaxGen }
+		case "legality":
+			t.expr, t.kind = column[*ent.Bundle](bundle.FieldLegal), kindBool
+			t.value = func(b *ent.Bundle) any { return b.Legal }
+		case "random":
+			t.expr, t.kind = randomExpr(bundle.FieldID, seed), kindInt
+			t.value = func(b *ent.Bundle) any { return randomValue(b.ID, seed) }
+		default:
+			return nil, fmt.Errorf("cannot sort bundles by %s", key.Field)
+		}
+		terms = append(terms, t)
+	}
+
+	return append(terms, term[*ent.Bundle]{
+		expr:  column[*ent.Bundle](bundle.FieldID),
+		desc:  keys[len(keys)-1].Descending,
+		kind:  kindInt,
+		value: func(b *ent.Bundle) any { return b.ID },
+	}), nil
+}
+
+// orderBy orders the query by the terms.
+func orderBy[T any](terms []term[T]) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		for _, t := range terms {
+			expr := t.expr(s)
+			if t.desc {
+				expr += " DESC"
+			}
+			s.OrderExpr(sql.Expr(expr))
+		}
+	}
+}
+
+// generationExpr orders a generation column by its number rather than as a string.
+func generationExpr(name string) func(*sql.Selector) string {
+	return func(s *sql.Selector) string {
+		var b strings.Builder
+		b.WriteString("CASE " + s.C(name))
+		for _, gen := range generation.All() {
+			fmt.Fprintf(&b, " WHEN '%s' THEN %v", gen.String(), gen.Number())
+		}
+		b.WriteString(" ELSE 0 END")
+		return b.String()
+	}
+}
+
+func coalesceExpr(name string) func(*sql.Selector) string {
+	return func(s *sql.Selector) string {
+		return fmt.Sprintf("COALESCE(%s, 0)", s.C(name))
+	}
+}
+
+// randomExpr shuffles rows by hashing their ID with the seed, the same seed always gives the same order
+// so a random listing can still be paged through. randomValue has to stay in sync with it.
+func randomExpr(name string, seed int64) func(*sql.Selector) string {
+	return func(s *sql.Selector) string {
+		return fmt.Sprintf("((%s + %d) * 2654435761) %% 4294967291", s.C(name), seed)
+	}
+}
+
+func randomValue(id int, seed int64) int64 {
+	return ((int64(id) + seed) * 2654435761) % 4294967291
+}
+
+func derefInt(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
@@ -0,0 +1,75 @@
+package gpss
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"testing"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+)
+
+// TestRandomValue checks the database hashes IDs the same way randomValue does, the cursors of random
+// listings rely on it.
+func TestRandomValue(t *testing.T) {
+	s := newTestServer(t)
+	createListed(t, s)
+
+	ids := s.db.Pokemon.Query().IDsX(s.ctx)
+	for _, seed := range []int64{0, 1, 12345, maxSeed - 1} {
+		for _, id := range ids {
+			n, err := s.db.Pokemon.Query().
+				Where(pokemon.ID(id), func(sel *sql.Selector) {
+					sel.Where(compare(randomExpr(pokemon.FieldID, seed)(sel), sql.OpEQ, randomValue(id, seed)))
+				}).
+				Count(s.ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if n != 1 {
+				t.Errorf("seed %d id %d: the database doesn't hash to %d", seed, id, randomValue(id, seed))
+			}
+		}
+	}
+}
+
+func listCodes(t *testing.T, s *testServer, keys []sortKey) []string {
+	t.Helper()
+
+	var reply gpssPokemonListResponse
+	s.searchQuery(t, "pokemon", url.Values{"amount": {"100"}}, listRequest{Sort: keys}, &reply)
+	return codes(reply.Pokemon)
+}
+
+// TestSortTieBreaker checks the ID breaks ties in the direction of the last key.
+func TestSortTieBreaker(t *testing.T) {
+	s := newTestServer(t)
+	createListed(t, s)
+
+	code := func(ids ...int) []string {
+		var codes []string
+		for _, id := range ids {
+			codes = append(codes, fmt.Sprintf("%010d", id))
+		}
+		return codes
+	}
+
+	// The even IDs were downloaded once, the odd ones never.
+	if got, want := listCodes(t, s, []sortKey{{Field: "popularity", Descending: true}}), code(6, 4, 2, 7, 5, 3, 1); !slices.Equal(got, want) {
+		t.Errorf("most popular first = %v, want %v", got, want)
+	}
+
+	if got, want := listCodes(t, s, []sortKey{{Field: "popularity"}}), code(1, 3, 5, 7, 2, 4, 6); !slices.Equal(got, want) {
+		t.Errorf("least popular first = %v, want %v", got, want)
+	}
+
+	// Turning every key around turns the whole listing around.
+	forward := listCodes(t, s, []sortKey{{Field: "legality", Descending: true}, {Field: "popularity"}})
+	backward := listCodes(t, s, []sortKey{{Field: "legality"}, {Field: "popularity", Descending: true}})
+	slices.Reverse(backward)
+	if !slices.Equal(forward, backward) {
+		t.Errorf("reversed listing = %v, want %v", backward, forward)
+	}
+}
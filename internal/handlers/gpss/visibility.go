@@ -0,0 +1,33 @@
+package gpss
+
+import (
+	"errors"
+
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/predicate"
+)
+
+// errRemoved is returned when a pokemon that has been taken down is uploaded again, uploading it doesn't
+// bring it back.
+var errRemoved = errors.New("this pokemon has been removed")
+
+// pokemonCode matches the pokemon with the download code, or the one it was merged into as a duplicate
+// (see database.mergeDuplicate) so the codes handed out for duplicates keep working.
+func pokemonCode(code string) predicate.Pokemon {
+	return pokemon.Or(
+		pokemon.DownloadCode(code),
+		pokemon.HasDuplicatesWith(pokemon.DownloadCode(code), pokemon.VisibilityEQ(pokemon.VisibilityDeleted)),
+	)
+}
+
+// visiblePokemon matches the pokemon with the download code, unless it has been taken down. Those are
+// treated like they don't exist.
+func visiblePokemon(code string) predicate.Pokemon {
+	return pokemon.And(pokemonCode(code), pokemon.VisibilityEQ(pokemon.VisibilityVisible))
+}
+
+// visibleBundle is visiblePokemon for bundles.
+func visibleBundle(code string) predicate.Bundle {
+	return bundle.And(bundle.DownloadCode(code), bundle.VisibilityEQ(bundle.VisibilityVisible))
+}
@@ -1,23 +1,62 @@
 package legality
 
 import (
+	"context"
 	"net/http"
+	"time"
 
+	"github.com/FlagBrew/local-gpss/internal/checker"
+	"github.com/FlagBrew/local-gpss/internal/console"
 	"github.com/FlagBrew/local-gpss/internal/models"
 	"github.com/FlagBrew/local-gpss/internal/utils"
+	"github.com/apex/log"
 	"github.com/go-chi/chi/v5"
 	"github.com/lrstanley/chix"
+	"golang.org/x/sync/errgroup"
 )
 
-type Handler struct{}
+const (
+	maxBatchSize            = 30
+	defaultBatchConcurrency = 4
+	defaultLegalizeTimeout  = 5 * time.Minute
+)
+
+type Handler struct {
+	checker          checker.LegalityChecker
+	jobs             *jobStore
+	batchConcurrency int
+	legalizeTimeout  time.Duration
+	// shutdown is done once the server is shutting down, async jobs stop with it.
+	shutdown context.Context
+}
+
+func NewHandler(ctx context.Context, legalityChecker checker.LegalityChecker, cfg *models.LegalityConfig) *Handler {
+	batchConcurrency := cfg.BatchConcurrency
+	if batchConcurrency <= 0 {
+		batchConcurrency = defaultBatchConcurrency
+	}
+
+	legalizeTimeout := defaultLegalizeTimeout
+	if cfg.LegalizeTimeout > 0 {
+		legalizeTimeout = time.Duration(cfg.LegalizeTimeout) * time.Second
+	}
 
-func NewHandler() *Handler {
-	return &Handler{}
+	return &Handler{
+		checker:          legalityChecker,
+		jobs:             newJobStore(time.Duration(cfg.JobRetention) * time.Second),
+		batchConcurrency: batchConcurrency,
+		legalizeTimeout:  legalizeTimeout,
+		shutdown:         ctx,
+	}
 }
 
 func (h *Handler) Route(r chi.Router) {
 	r.Post("/legality", h.legalityCheck)
+	r.Post("/legality/batch", h.legalityCheckBatch)
 	r.Post("/legalize", h.legalize)
+	r.Post("/legalize/async", h.legalizeAsync)
+	r.Get("/jobs/{id}", h.getJob)
+	r.Get("/version", h.version)
 }
 
 func (h *Handler) legalityCheck(w http.ResponseWriter, r *http.Request) {
@@ -27,16 +66,59 @@ func (h *Handler) legalityCheck(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := utils.ExecGpssConsole[models.GpssLegalityCheckReply](r.Context(), *args)
+	result, err := h.checker.Check(r.Context(), args.Pokemon, args.Generation)
 
 	if err != nil {
-		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": err.Error()})
+		utils.WriteLegalityError(w, r, err)
 		return
 	}
 
 	chix.JSON(w, r, http.StatusOK, result)
 }
 
+// batchResult is the outcome for a single pokemon in a batch, either the legality check or the error.
+type batchResult struct {
+	*models.GpssLegalityCheckReply
+	Error string            `json:"error,omitempty"`
+	Code  console.ErrorCode `json:"code,omitempty"`
+}
+
+func (h *Handler) legalityCheckBatch(w http.ResponseWriter, r *http.Request) {
+	batch, statusCode, err := utils.PrepareBatchCall(r, "legality", maxBatchSize)
+	if err != nil {
+		chix.JSON(w, r, statusCode, chix.M{"error": err.Error()})
+		return
+	}
+
+	results := make([]batchResult, len(batch))
+	eg := errgroup.Group{}
+	eg.SetLimit(h.batchConcurrency)
+	for i, args := range batch {
+		eg.Go(func() error {
+			// The batch shares the queue with everyone else, wait for a turn rather than failing part of it.
+			result, err := checker.WaitForTurn(r.Context(), func() (*models.GpssLegalityCheckReply, error) {
+				return h.checker.Check(r.Context(), args.Pokemon, args.Generation)
+			})
+			if err != nil {
+				consoleErr := console.AsError(err)
+				results[i] = batchResult{Error: consoleErr.Error(), Code: consoleErr.Code}
+				return nil
+			}
+
+			results[i] = batchResult{GpssLegalityCheckReply: result}
+			return nil
+		})
+	}
+	eg.Wait()
+
+	// Nobody is around to read the results anymore.
+	if r.Context().Err() != nil {
+		return
+	}
+
+	chix.JSON(w, r, http.StatusOK, results)
+}
+
 func (h *Handler) legalize(w http.ResponseWriter, r *http.Request) {
 	args, statusCode, err := utils.PrepareCall(r, "legalize")
 	if err != nil {
@@ -44,10 +126,89 @@ func (h *Handler) legalize(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := utils.ExecGpssConsole[models.GpssAutoLegalityReply](r.Context(), *args)
+	result, err := h.checker.Legalize(r.Context(), args.Pokemon, args.Generation, args.Version)
+
+	if err != nil {
+		utils.WriteLegalityError(w, r, err)
+		return
+	}
+
+	chix.JSON(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) legalizeAsync(w http.ResponseWriter, r *http.Request) {
+	args, statusCode, err := utils.PrepareCall(r, "legalize")
+	if err != nil {
+		chix.JSON(w, r, statusCode, chix.M{"error": err.Error()})
+		return
+	}
+
+	j := h.jobs.create()
+	if j == nil {
+		w.Header().Set("Retry-After", "60")
+		chix.JSON(w, r, http.StatusServiceUnavailable, chix.M{"error": "too many legalize jobs, try again later", "code": console.CodeBusy})
+		return
+	}
+
+	// The job outlives the request, so keep the values (logger, db) but only stop it on shutdown or once
+	// it's taken too long. Legalizing takes longer than a check, so the worker gets as long as the job.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(r.Context()), h.legalizeTimeout)
+	ctx = console.WithTimeout(ctx, h.legalizeTimeout)
+	stop := context.AfterFunc(h.shutdown, cancel)
+	go func() {
+		defer stop()
+		defer cancel()
+		h.runLegalizeJob(ctx, j.ID, args)
+	}()
+
+	chix.JSON(w, r, http.StatusAccepted, chix.M{"id": j.ID, "status": jobPending})
+}
+
+func (h *Handler) getJob(w http.ResponseWriter, r *http.Request) {
+	j, ok := h.jobs.get(chi.URLParam(r, "id"))
+	if !ok {
+		chix.JSON(w, r, http.StatusNotFound, chix.M{"error": "job not found"})
+		return
+	}
+
+	chix.JSON(w, r, http.StatusOK, j)
+}
+
+// runLegalizeJob legalizes the pokemon and stores the outcome on the job. A full legality queue isn't
+// a failure here, the job stays pending until it's let through the queue or the context is done.
+func (h *Handler) runLegalizeJob(ctx context.Context, id string, args *models.GpssConsoleArgs) {
+	ctx = checker.WithStarted(ctx, func() {
+		h.jobs.update(id, func(j *job) { j.Status = jobRunning })
+	})
+
+	result, err := checker.WaitForTurn(ctx, func() (*models.GpssAutoLegalityReply, error) {
+		return h.checker.Legalize(ctx, args.Pokemon, args.Generation, args.Version)
+	})
+
+	now := time.Now()
+	h.jobs.update(id, func(j *job) {
+		j.FinishedAt = &now
+		if err != nil {
+			consoleErr := console.AsError(err)
+			j.Status = jobFailed
+			j.Error = consoleErr.Error()
+			j.Code = consoleErr.Code
+			return
+		}
+
+		j.Status = jobDone
+		j.Result = result
+	})
+
+	if err != nil && h.shutdown.Err() == nil {
+		log.FromContext(ctx).WithError(err).WithField("job", id).Warn("async legalize job failed")
+	}
+}
 
+func (h *Handler) version(w http.ResponseWriter, r *http.Request) {
+	result, err := h.checker.Version(r.Context())
 	if err != nil {
-		chix.JSON(w, r, http.StatusInternalServerError, chix.M{"error": err.Error()})
+		utils.WriteLegalityError(w, r, err)
 		return
 	}
 
@@ -0,0 +1,358 @@
+package legality
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/FlagBrew/local-gpss/internal/checker"
+	"github.com/FlagBrew/local-gpss/internal/console"
+	"github.com/FlagBrew/local-gpss/internal/models"
+	"github.com/go-chi/chi/v5"
+)
+
+var (
+	legalMon   = []byte("legal pokemon")
+	illegalMon = []byte("illegal pokemon")
+	fixedMon   = "fixed pokemon"
+)
+
+func newTestServer(t *testing.T) http.Handler {
+	t.Helper()
+
+	return newServer(t, context.Background(), newFake(), &models.LegalityConfig{})
+}
+
+// newFake knows the legal and illegal test pokemon, the illegal one can be legalized.
+func newFake() *checker.Fake {
+	return checker.NewFake(checker.Fixtures{
+		Check: map[string]models.GpssLegalityCheckReply{
+			base64.StdEncoding.EncodeToString(legalMon):   {Legal: true},
+			base64.StdEncoding.EncodeToString(illegalMon): {Legal: false, Report: []string{"Invalid: moves"}},
+		},
+		Legalize: map[string]models.GpssAutoLegalityReply{
+			base64.StdEncoding.EncodeToString(illegalMon): {Legal: true, Success: true, Ran: true, Pokemon: &fixedMon},
+		},
+	})
+}
+
+// newServer runs the handler until ctx is done or the test is over.
+func newServer(t *testing.T, ctx context.Context, c checker.LegalityChecker, cfg *models.LegalityConfig) http.Handler {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(ctx)
+	t.Cleanup(cancel)
+
+	r := chi.NewRouter()
+	r.Route("/", NewHandler(ctx, c, cfg).Route)
+	return r
+}
+
+// newRequest builds a request the way PKSM sends them, the pokemon as multipart files and the rest as headers.
+func newRequest(t *testing.T, path string, headers map[string]string, files map[string][]byte) *http.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for name, data := range files {
+		fw, err := mw.CreateFormFile(name, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fw.Write(data)
+	}
+	mw.Close()
+
+	req := httptest.NewRequest(http.MethodPost, path, &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	return req
+}
+
+func TestLegalityCheck(t *testing.T) {
+	srv := newTestServer(t)
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		files   map[string][]byte
+		status  int
+		legal   bool
+	}{
+		{name: "legal", headers: map[string]string{"generation": "8"}, files: map[string][]byte{"pkmn": legalMon}, status: http.StatusOK, legal: true},
+		{name: "illegal", headers: map[string]string{"generation": "8"}, files: map[string][]byte{"pkmn": illegalMon}, status: http.StatusOK},
+		{name: "unknown pokemon", headers: map[string]string{"generation": "8"}, files: map[string][]byte{"pkmn": []byte("?")}, status: http.StatusUnprocessableEntity},
+		{name: "missing generation", files: map[string][]byte{"pkmn": legalMon}, status: http.StatusBadRequest},
+		{name: "unknown generation", headers: map[string]string{"generation": "42"}, files: map[string][]byte{"pkmn": legalMon}, status: http.StatusBadRequest},
+		{name: "missing pokemon", headers: map[string]string{"generation": "8"}, status: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			srv.ServeHTTP(w, newRequest(t, "/legality", tt.headers, tt.files))
+
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+
+			if tt.status != http.StatusOK {
+				return
+			}
+
+			var reply models.GpssLegalityCheckReply
+			if err := json.NewDecoder(w.Body).Decode(&reply); err != nil {
+				t.Fatal(err)
+			}
+
+			if reply.Legal != tt.legal {
+				t.Errorf("legal = %v, want %v", reply.Legal, tt.legal)
+			}
+		})
+	}
+}
+
+func TestLegalize(t *testing.T) {
+	srv := newTestServer(t)
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		files   map[string][]byte
+		status  int
+	}{
+		{name: "legalized", headers: map[string]string{"generation": "8", "version": "SW"}, files: map[string][]byte{"pkmn": illegalMon}, status: http.StatusOK},
+		{name: "unknown pokemon", headers: map[string]string{"generation": "8", "version": "SW"}, files: map[string][]byte{"pkmn": legalMon}, status: http.StatusUnprocessableEntity},
+		{name: "missing version", headers: map[string]string{"generation": "8"}, files: map[string][]byte{"pkmn": illegalMon}, status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			srv.ServeHTTP(w, newRequest(t, "/legalize", tt.headers, tt.files))
+
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+
+			if tt.status != http.StatusOK {
+				return
+			}
+
+			var reply models.GpssAutoLegalityReply
+			if err := json.NewDecoder(w.Body).Decode(&reply); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reply.Success || reply.Pokemon == nil || *reply.Pokemon != fixedMon {
+				t.Errorf("reply = %+v, want the fixed pokemon", reply)
+			}
+		})
+	}
+}
+
+// blockingChecker legalizes once it's released, unless the context is done first. Calls let through are
+// sent to entered when it's set.
+type blockingChecker struct {
+	*checker.Fake
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (c *blockingChecker) Legalize(ctx context.Context, pokemon, generation, version string) (*models.GpssAutoLegalityReply, error) {
+	if c.entered != nil {
+		c.entered <- struct{}{}
+	}
+
+	select {
+	case <-c.release:
+		return c.Fake.Legalize(ctx, pokemon, generation, version)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func startJob(t *testing.T, srv http.Handler, pkmn []byte) string {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	srv.ServeHTTP(w, newRequest(t, "/legalize/async", map[string]string{"generation": "8", "version": "SW"}, map[string][]byte{"pkmn": pkmn}))
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusAccepted, w.Body)
+	}
+
+	var created job
+	if err := json.NewDecoder(w.Body).Decode(&created); err != nil {
+		t.Fatal(err)
+	}
+
+	if created.Status != jobPending {
+		t.Errorf("status of a new job = %q, want %q", created.Status, jobPending)
+	}
+
+	return created.ID
+}
+
+func getJob(t *testing.T, srv http.Handler, id string) job {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	srv.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/jobs/"+id, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+
+	var j job
+	if err := json.NewDecoder(w.Body).Decode(&j); err != nil {
+		t.Fatal(err)
+	}
+
+	return j
+}
+
+// waitForJob polls the job until it has the status.
+func waitForJob(t *testing.T, srv http.Handler, id string, status jobStatus) job {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		j := getJob(t, srv, id)
+		if j.Status == status {
+			return j
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("job status = %q, want %q", j.Status, status)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestLegalizeAsync(t *testing.T) {
+	srv := newTestServer(t)
+
+	done := waitForJob(t, srv, startJob(t, srv, illegalMon), jobDone)
+	if done.Result == nil || done.Result.Pokemon == nil || *done.Result.Pokemon != fixedMon || done.FinishedAt == nil {
+		t.Errorf("job = %+v, want the fixed pokemon", done)
+	}
+
+	failed := waitForJob(t, srv, startJob(t, srv, legalMon), jobFailed)
+	if failed.Code != console.CodeInvalidData || failed.FinishedAt == nil {
+		t.Errorf("job = %+v, want code %s", failed, console.CodeInvalidData)
+	}
+}
+
+// TestLegalizeAsyncQueued checks a job only shows as running once the legality queue lets it through,
+// whether it waits in the queue or has to retry as the queue is full.
+func TestLegalizeAsyncQueued(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		depth int
+	}{
+		{name: "waiting in the queue", depth: 1},
+		{name: "queue full", depth: 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &blockingChecker{Fake: newFake(), release: make(chan struct{})}
+			srv := newServer(t, context.Background(), checker.NewQueued(c, 1, tt.depth), &models.LegalityConfig{})
+
+			first := startJob(t, srv, illegalMon)
+			waitForJob(t, srv, first, jobRunning)
+
+			second := startJob(t, srv, illegalMon)
+			time.Sleep(50 * time.Millisecond)
+			if j := getJob(t, srv, second); j.Status != jobPending {
+				t.Errorf("status of the queued job = %q, want %q", j.Status, jobPending)
+			}
+
+			close(c.release)
+			waitForJob(t, srv, first, jobDone)
+			waitForJob(t, srv, second, jobDone)
+		})
+	}
+}
+
+func TestLegalizeAsyncTimeout(t *testing.T) {
+	c := &blockingChecker{Fake: newFake(), release: make(chan struct{})}
+	srv := newServer(t, context.Background(), checker.NewQueued(c, 1, 1), &models.LegalityConfig{LegalizeTimeout: 1})
+
+	// The timeout covers the whole job, including the time spent waiting for a turn.
+	running := startJob(t, srv, illegalMon)
+	queued := startJob(t, srv, illegalMon)
+
+	for _, id := range []string{running, queued} {
+		if j := waitForJob(t, srv, id, jobFailed); j.Code != console.CodeTimeout {
+			t.Errorf("code = %q, want %q", j.Code, console.CodeTimeout)
+		}
+	}
+}
+
+func TestLegalizeAsyncShutdown(t *testing.T) {
+	ctx, shutdown := context.WithCancel(context.Background())
+	c := &blockingChecker{Fake: newFake(), release: make(chan struct{})}
+	srv := newServer(t, ctx, c, &models.LegalityConfig{})
+
+	id := startJob(t, srv, illegalMon)
+	shutdown()
+
+	waitForJob(t, srv, id, jobFailed)
+}
+
+func TestGetJobNotFound(t *testing.T) {
+	w := httptest.NewRecorder()
+	newTestServer(t).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/jobs/missing", nil))
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+// TestLegalizeBusy checks a full legality queue turns requests away with a 503 and when to come back.
+func TestLegalizeBusy(t *testing.T) {
+	c := &blockingChecker{Fake: newFake(), entered: make(chan struct{}, 1), release: make(chan struct{})}
+	srv := newServer(t, context.Background(), checker.NewQueued(c, 1, 0), &models.LegalityConfig{})
+	headers := map[string]string{"generation": "8", "version": "SW"}
+
+	first := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		srv.ServeHTTP(first, newRequest(t, "/legalize", headers, map[string][]byte{"pkmn": illegalMon}))
+		close(done)
+	}()
+	<-c.entered
+
+	w := httptest.NewRecorder()
+	srv.ServeHTTP(w, newRequest(t, "/legalize", headers, map[string][]byte{"pkmn": illegalMon}))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusServiceUnavailable, w.Body)
+	}
+
+	if got := w.Header().Get("Retry-After"); got != "1" {
+		t.Errorf("Retry-After = %q, want 1", got)
+	}
+
+	var reply struct {
+		Code console.ErrorCode `json:"code"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&reply); err != nil {
+		t.Fatal(err)
+	}
+	if reply.Code != console.CodeBusy {
+		t.Errorf("code = %q, want %q", reply.Code, console.CodeBusy)
+	}
+
+	close(c.release)
+	<-done
+	if first.Code != http.StatusOK {
+		t.Errorf("status of the request let through = %d, want %d: %s", first.Code, http.StatusOK, first.Body)
+	}
+}
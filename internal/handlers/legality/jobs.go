@@ -0,0 +1,109 @@
+package legality
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/FlagBrew/local-gpss/internal/console"
+	"github.com/FlagBrew/local-gpss/internal/models"
+)
+
+const (
+	defaultJobRetention = 10 * time.Minute
+	maxJobs             = 1000
+)
+
+type jobStatus string
+
+const (
+	jobPending jobStatus = "pending"
+	jobRunning jobStatus = "running"
+	jobDone    jobStatus = "done"
+	jobFailed  jobStatus = "failed"
+)
+
+type job struct {
+	ID         string                        `json:"id"`
+	Status     jobStatus                     `json:"status"`
+	Result     *models.GpssAutoLegalityReply `json:"result,omitempty"`
+	Error      string                        `json:"error,omitempty"`
+	Code       console.ErrorCode             `json:"code,omitempty"`
+	CreatedAt  time.Time                     `json:"created_at"`
+	FinishedAt *time.Time                    `json:"finished_at,omitempty"`
+}
+
+// jobStore keeps track of async legalize jobs in memory, finished jobs are dropped once they're older
+// than the retention window.
+type jobStore struct {
+	mu        sync.Mutex
+	retention time.Duration
+	jobs      map[string]*job
+}
+
+func newJobStore(retention time.Duration) *jobStore {
+	if retention <= 0 {
+		retention = defaultJobRetention
+	}
+
+	return &jobStore{
+		retention: retention,
+		jobs:      map[string]*job{},
+	}
+}
+
+// create adds a new pending job, it returns nil if there are too many jobs already.
+func (s *jobStore) create() *job {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.purge()
+	if len(s.jobs) >= maxJobs {
+		return nil
+	}
+
+	id := make([]byte, 16)
+	rand.Read(id)
+
+	j := &job{
+		ID:        hex.EncodeToString(id),
+		Status:    jobPending,
+		CreatedAt: time.Now(),
+	}
+	s.jobs[j.ID] = j
+
+	return j
+}
+
+// get returns a copy of the job so it can be safely encoded while the job is still being worked on.
+func (s *jobStore) get(id string) (job, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.purge()
+	j, ok := s.jobs[id]
+	if !ok {
+		return job{}, false
+	}
+
+	return *j, true
+}
+
+func (s *jobStore) update(id string, fn func(j *job)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if j, ok := s.jobs[id]; ok {
+		fn(j)
+	}
+}
+
+// purge removes finished jobs past the retention window, the lock must be held.
+func (s *jobStore) purge() {
+	for id, j := range s.jobs {
+		if j.FinishedAt != nil && time.Since(*j.FinishedAt) > s.retention {
+			delete(s.jobs, id)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package legality
+
+import (
+	"testing"
+	"time"
+)
+
+// finish marks the job as finished the given time ago.
+func finish(s *jobStore, id string, ago time.Duration) {
+	s.update(id, func(j *job) {
+		finishedAt := time.Now().Add(-ago)
+		j.Status = jobDone
+		j.FinishedAt = &finishedAt
+	})
+}
+
+func TestJobStoreRetention(t *testing.T) {
+	s := newJobStore(time.Minute)
+
+	running := s.create()
+	s.update(running.ID, func(j *job) {
+		// Jobs are only dropped once they're finished, however long they've been going.
+		j.Status = jobRunning
+		j.CreatedAt = time.Now().Add(-time.Hour)
+	})
+
+	recent := s.create()
+	finish(s, recent.ID, 30*time.Second)
+
+	expired := s.create()
+	finish(s, expired.ID, 2*time.Minute)
+
+	for _, tt := range []struct {
+		id   string
+		kept bool
+	}{
+		{running.ID, true},
+		{recent.ID, true},
+		{expired.ID, false},
+	} {
+		if _, ok := s.get(tt.id); ok != tt.kept {
+			t.Errorf("job %s kept = %v, want %v", tt.id, ok, tt.kept)
+		}
+	}
+}
+
+func TestJobStoreDefaultRetention(t *testing.T) {
+	if s := newJobStore(0); s.retention != defaultJobRetention {
+		t.Errorf("retention = %s, want %s", s.retention, defaultJobRetention)
+	}
+}
+
+func TestJobStoreLimit(t *testing.T) {
+	s := newJobStore(time.Minute)
+
+	var ids []string
+	for range maxJobs {
+		j := s.create()
+		if j == nil {
+			t.Fatalf("created %d jobs, want %d", len(ids), maxJobs)
+		}
+		ids = append(ids, j.ID)
+	}
+
+	if s.create() != nil {
+		t.Fatal("created a job past the limit")
+	}
+
+	// Finished jobs keep their room until they expire.
+	finish(s, ids[0], 30*time.Second)
+	if s.create() != nil {
+		t.Fatal("created a job while the finished ones are retained")
+	}
+
+	finish(s, ids[1], 2*time.Minute)
+	if s.create() == nil {
+		t.Fatal("expired jobs weren't evicted to make room")
+	}
+}
+
+// TestJobStoreGetCopy checks the job handed out can't change the stored one, it's encoded without the lock.
+func TestJobStoreGetCopy(t *testing.T) {
+	s := newJobStore(time.Minute)
+	created := s.create()
+
+	j, _ := s.get(created.ID)
+	j.Status = jobFailed
+
+	if j, _ := s.get(created.ID); j.Status != jobPending {
+		t.Errorf("status = %q, want %q", j.Status, jobPending)
+	}
+}
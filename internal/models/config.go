@@ -5,6 +5,8 @@ type Config struct {
 	Database    DatabaseConfig `json:"database"`
 	HTTP        HTTPConfig     `json:"http"`
 	Misc        MiscConfig     `json:"misc"`
+	GpssConsole ConsoleConfig  `json:"gpss_console"`
+	Legality    LegalityConfig `json:"legality"`
 }
 
 type DatabaseConfig struct {
@@ -22,3 +24,39 @@ type MiscConfig struct {
 	MigrateOriginalDb  bool `json:"migrate_original_db"`
 	DownloadOriginalDb bool `json:"download_original_db"`
 }
+
+type ConsoleConfig struct {
+	// Path is the location of the GpssConsole binary, defaults to the one in ./bin for the current platform.
+	Path string `json:"path"`
+	// Workers is the amount of GpssConsole processes kept alive, defaults to 2 when unset.
+	Workers int `json:"workers" validate:"min=0,max=32"`
+	// Timeout is how long (in seconds) a single call can take before the worker is restarted, defaults to 30.
+	Timeout int `json:"timeout" validate:"min=0"`
+}
+
+type LegalityConfig struct {
+	// Engine picks what runs the legality checks: subprocess (GpssConsole, the default), remote or fake.
+	Engine string `json:"engine" validate:"omitempty,oneof=subprocess remote fake"`
+	// RemoteURL is the base URL of another Local GPSS instance, used by the remote engine.
+	RemoteURL string `json:"remote_url"`
+	// Fixtures is a JSON file with canned replies, used by the fake engine.
+	Fixtures string `json:"fixtures"`
+	// DisableCache turns off caching legality results by Pokémon and engine version.
+	DisableCache bool `json:"disable_cache"`
+	// CacheSize is the amount of results kept in memory in front of the database cache, defaults to 1024.
+	CacheSize int `json:"cache_size" validate:"min=0"`
+	// DisableRecheck stops stored Pokémon from being rechecked in the background when the engine is upgraded.
+	DisableRecheck bool `json:"disable_recheck"`
+	// RecheckConcurrency is the amount of Pokémon rechecked at once, defaults to 4.
+	RecheckConcurrency int `json:"recheck_concurrency" validate:"min=0"`
+	// MaxConcurrent is the amount of legality checks allowed to run at once, defaults to the amount of GpssConsole workers.
+	MaxConcurrent int `json:"max_concurrent" validate:"min=0"`
+	// QueueDepth is the amount of legality checks allowed to wait for their turn before requests are turned away, defaults to 32.
+	QueueDepth int `json:"queue_depth" validate:"min=0"`
+	// JobRetention is how long (in seconds) finished async legalize jobs are kept around, defaults to 600.
+	JobRetention int `json:"job_retention" validate:"min=0"`
+	// LegalizeTimeout is how long (in seconds) an async legalize job can take, waiting for its turn included, defaults to 300.
+	LegalizeTimeout int `json:"legalize_timeout" validate:"min=0"`
+	// BatchConcurrency is the amount of Pokémon from a single batch request checked at once, defaults to 4.
+	BatchConcurrency int `json:"batch_concurrency" validate:"min=0"`
+}
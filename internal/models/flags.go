@@ -1,5 +1,11 @@
 package models
 
 type Flags struct {
-	Mode string `short:"m" long:"mode" env:"MODE" required:"true" description:"The mode Local GPSS is running in: cli/docker" default:"cli"`
+	Mode               string `short:"m" long:"mode" env:"MODE" required:"true" description:"The mode Local GPSS is running in: cli/docker" default:"cli"`
+	BackfillMetadata   bool   `long:"backfill-metadata" description:"Decode the metadata of stored Pokémon that don't have it yet, then exit"`
+	Migrate            string `long:"migrate" choice:"status" choice:"up" choice:"diff" description:"Manage the database migrations, then exit: status lists them, up applies the pending ones and diff writes one named after the first argument for the changes to the ent schema (run from the repository root)"`
+	ApproveDestructive bool   `long:"approve-destructive" env:"APPROVE_DESTRUCTIVE" description:"Apply pending migrations that can lose data, back up the database first"`
+	DevDatabase        string `long:"dev-database" description:"Connection string of an empty database of the configured type for --migrate diff, defaults to an in-memory SQLite database"`
+	SetVisibility      string `long:"set-visibility" choice:"visible" choice:"hidden" choice:"deleted" description:"Change the visibility of stored Pokémon or bundles, then exit: the first argument is pokemon or bundle and the rest are their download codes, visible restores them"`
+	Reason             string `long:"reason" description:"Why the visibility is changed by --set-visibility, kept along with it"`
 }
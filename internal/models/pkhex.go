@@ -9,11 +9,13 @@ type GpssConsoleArgs struct {
 
 type GpssErrorReply struct {
 	Error string `json:"error"`
+	Code  string `json:"code,omitempty"`
 }
 
 type GpssLegalityCheckReply struct {
 	Legal  bool     `json:"legal"`
 	Report []string `json:"report"`
+	Cached bool     `json:"cached"`
 }
 
 type GpssAutoLegalityReply struct {
@@ -23,3 +25,26 @@ type GpssAutoLegalityReply struct {
 	Report  []string `json:"report"`
 	Pokemon *string  `json:"pokemon"`
 }
+
+type EngineVersion struct {
+	PKHeX   string `json:"pkhex"`
+	AutoMod string `json:"automod"`
+}
+
+func (v EngineVersion) String() string {
+	return "pkhex-" + v.PKHeX + "+automod-" + v.AutoMod
+}
+
+// ConsoleCapabilities is what GpssConsole reports during the startup handshake.
+type ConsoleCapabilities struct {
+	EngineVersion
+	Modes []string `json:"modes"`
+}
+
+// ConsoleStatus is the outcome of the GpssConsole startup handshake and self-test.
+type ConsoleStatus struct {
+	Path         string
+	Capabilities *ConsoleCapabilities
+	SelfTest     bool
+	Error        string
+}
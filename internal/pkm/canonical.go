@@ -0,0 +1,78 @@
+package pkm
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/FlagBrew/local-gpss/internal/generation"
+)
+
+// Canonical returns the form a Pokémon is stored in so the same Pokémon always has the same bytes:
+// decrypted, cut to the stored size and with a valid checksum. Gen 1 and 2 files have neither
+// encryption nor party stats to drop and are returned as they are once they've been validated.
+func Canonical(data []byte, gen generation.Generation) ([]byte, error) {
+	data = append([]byte(nil), data...)
+
+	switch gen {
+	case generation.Gen1, generation.Gen2:
+		if _, err := Decode(data, gen); err != nil {
+			return nil, err
+		}
+		return data, nil
+	case generation.Gen3:
+		if len(data) != pk3StoredSize && len(data) != pk3PartySize {
+			return nil, sizeError("PK3", len(data))
+		}
+
+		if err := decryptPK3(data, binary.LittleEndian.Uint32(data)); err != nil {
+			return nil, err
+		}
+		return data[:pk3StoredSize], nil
+	case generation.Gen4:
+		return canonicalGen45(data, pk4PartySize, "PK4")
+	case generation.Gen5:
+		return canonicalGen45(data, pk5PartySize, "PK5")
+	case generation.Gen6, generation.Gen7:
+		return canonicalModern(data, layoutPK6)
+	case generation.LGPE:
+		return canonicalModern(data, layoutPB7)
+	case generation.Gen8:
+		return canonicalModern(data, layoutPK8)
+	case generation.BDSP:
+		return canonicalModern(data, layoutPB8)
+	case generation.PLA:
+		return canonicalModern(data, layoutPA8)
+	case generation.Gen9:
+		return canonicalModern(data, layoutPK9)
+	default:
+		return nil, fmt.Errorf("%w: generation %q", ErrUnknownFormat, gen.String())
+	}
+}
+
+func canonicalGen45(data []byte, partySize int, format string) ([]byte, error) {
+	if len(data) != pk45StoredSize && len(data) != partySize {
+		return nil, sizeError(format, len(data))
+	}
+
+	if err := decryptGen4Plus(data, pk45StoredSize, pk45BlockSize, true); err != nil {
+		return nil, err
+	}
+
+	return data[:pk45StoredSize], nil
+}
+
+func canonicalModern(data []byte, l layout) ([]byte, error) {
+	if len(data) != l.storedSize && len(data) != l.partySize {
+		return nil, sizeError(l.name, len(data))
+	}
+
+	if err := decryptGen4Plus(data, l.storedSize, l.blockSize, false); err != nil {
+		return nil, err
+	}
+
+	if l.boxedParty {
+		return data, nil
+	}
+
+	return data[:l.storedSize], nil
+}
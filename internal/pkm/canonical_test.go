@@ -0,0 +1,96 @@
+package pkm
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/FlagBrew/local-gpss/internal/generation"
+)
+
+// TestCanonical checks the same Pokémon gets the same bytes whether it's sent encrypted or not, from the
+// party or from a box.
+func TestCanonical(t *testing.T) {
+	tests := []struct {
+		format string
+		gen    generation.Generation
+		// want is the file holding the canonical form.
+		want  string
+		files []string
+	}{
+		{format: "pk3", gen: generation.Gen3},
+		{format: "pk4", gen: generation.Gen4},
+		{format: "pk5", gen: generation.Gen5},
+		{format: "pk6", gen: generation.Gen6},
+		{format: "pk7", gen: generation.Gen7},
+		{format: "pk8", gen: generation.Gen8},
+		{format: "pb8", gen: generation.BDSP},
+		{format: "pa8", gen: generation.PLA},
+		{format: "pk9", gen: generation.Gen9},
+		{
+			// LGPE keeps the party stats in its boxes, so they're kept.
+			format: "pb7", gen: generation.LGPE, want: "pb7_party_decrypted.pb7",
+			files: []string{"pb7_party_decrypted.pb7", "pb7_party_encrypted.pb7"},
+		},
+		// Gen 1 and 2 have neither encryption nor box data.
+		{format: "pk1", gen: generation.Gen1, want: "pk1.pk1", files: []string{"pk1.pk1"}},
+		{format: "pk2", gen: generation.Gen2, want: "pk2_unown_shiny.pk2", files: []string{"pk2_unown_shiny.pk2"}},
+	}
+
+	for _, tt := range tests {
+		if tt.want == "" {
+			tt.want = tt.format + "_box_decrypted." + tt.format
+			for _, kind := range []string{"box", "party"} {
+				for _, state := range []string{"decrypted", "encrypted"} {
+					tt.files = append(tt.files, tt.format+"_"+kind+"_"+state+"."+tt.format)
+				}
+			}
+		}
+
+		want := readSample(t, tt.want)
+		for _, file := range tt.files {
+			t.Run(file, func(t *testing.T) {
+				data := readSample(t, file)
+				orig := bytes.Clone(data)
+
+				got, err := Canonical(data, tt.gen)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if !bytes.Equal(got, want) {
+					t.Errorf("canonical form differs from %s", tt.want)
+				}
+
+				if !bytes.Equal(data, orig) {
+					t.Error("input was modified")
+				}
+			})
+		}
+	}
+}
+
+func TestCanonicalErrors(t *testing.T) {
+	corrupt := readSample(t, "pk4_party_encrypted.pk4")
+	corrupt[0x50] ^= 0xFF
+
+	tests := []struct {
+		name string
+		data []byte
+		gen  generation.Generation
+		want error
+	}{
+		{name: "checksum", data: corrupt, gen: generation.Gen4, want: ErrChecksum},
+		{name: "size", data: readSample(t, "pk9_party_decrypted.pk9")[:0x150], gen: generation.Gen9, want: ErrUnknownFormat},
+		{name: "game boy size", data: readSample(t, "pk2_unown.pk2")[:40], gen: generation.Gen2, want: ErrUnknownFormat},
+		{name: "unknown generation", data: readSample(t, "pk8_box_decrypted.pk8"), gen: generation.Unknown, want: ErrUnknownFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Canonical(tt.data, tt.gen); !errors.Is(err, tt.want) {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package pkm
+
+import "encoding/binary"
+
+// blockPosition maps the shuffle value to where each of the four blocks (A, B, C, D) is stored.
+var blockPosition = [24][4]int{
+	{0, 1, 2, 3}, {0, 1, 3, 2}, {0, 2, 1, 3}, {0, 3, 1, 2}, {0, 2, 3, 1}, {0, 3, 2, 1},
+	{1, 0, 2, 3}, {1, 0, 3, 2}, {2, 0, 1, 3}, {3, 0, 1, 2}, {2, 0, 3, 1}, {3, 0, 2, 1},
+	{1, 2, 0, 3}, {1, 3, 0, 2}, {2, 1, 0, 3}, {3, 1, 0, 2}, {2, 3, 0, 1}, {3, 2, 0, 1},
+	{1, 2, 3, 0}, {1, 3, 2, 0}, {2, 1, 3, 0}, {3, 1, 2, 0}, {2, 3, 1, 0}, {3, 2, 1, 0},
+}
+
+// unshuffle puts the four blocks starting at start back in their A, B, C, D order.
+func unshuffle(data []byte, start, blockSize int, sv uint32) {
+	blocks := append([]byte(nil), data[start:start+4*blockSize]...)
+	for block, pos := range blockPosition[sv%24] {
+		copy(data[start+block*blockSize:], blocks[pos*blockSize:(pos+1)*blockSize])
+	}
+}
+
+// cryptArray xors the data with the LCRNG stream used from Gen 4 onwards, it both encrypts and decrypts.
+func cryptArray(data []byte, seed uint32) {
+	for i := 0; i+1 < len(data); i += 2 {
+		seed = seed*0x41C64E6D + 0x00006073
+		binary.LittleEndian.PutUint16(data[i:], binary.LittleEndian.Uint16(data[i:])^uint16(seed>>16))
+	}
+}
+
+// checksum16 sums the data as little endian uint16s.
+func checksum16(data []byte) uint16 {
+	var sum uint16
+	for i := 0; i+1 < len(data); i += 2 {
+		sum += binary.LittleEndian.Uint16(data[i:])
+	}
+
+	return sum
+}
+
+// decryptGen4Plus decrypts the data in place unless it already is. Gen 4 and 5 seed the blocks with the
+// checksum, later generations use the encryption constant for both the blocks and the party stats.
+func decryptGen4Plus(data []byte, storedSize, blockSize int, gen45 bool) error {
+	stored := binary.LittleEndian.Uint16(data[0x06:])
+	if checksum16(data[8:storedSize]) == stored {
+		return nil
+	}
+
+	ec := binary.LittleEndian.Uint32(data)
+	seed, sv := ec, (ec>>13)&31
+	if gen45 {
+		seed, sv = uint32(stored), (ec&0x3E000)>>13
+	}
+
+	cryptArray(data[8:storedSize], seed)
+	if len(data) > storedSize {
+		cryptArray(data[storedSize:], ec)
+	}
+	unshuffle(data, 8, blockSize, sv)
+
+	if checksum16(data[8:storedSize]) != stored {
+		return ErrChecksum
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package pkm
+
+import "encoding/binary"
+
+const (
+	pk1PartySize = 44
+	pk2PartySize = 48
+	gbListHeader = 3
+	gbNameIntl   = 11
+	gbNameJP     = 6
+	unownSpecies = 201
+)
+
+// gameBoyList splits a Gen 1 or 2 Pokémon, they're stored as a single entry list: the count, the species,
+// a terminator, the party data and then the OT name and nickname.
+func gameBoyList(data []byte, partySize int, format string) (mon, otName []byte, language int, err error) {
+	nameSize := gbNameIntl
+	language = languageEnglish
+
+	switch len(data) {
+	case gbListHeader + partySize + 2*gbNameIntl:
+	case gbListHeader + partySize + 2*gbNameJP:
+		nameSize = gbNameJP
+		language = languageJapanese
+	default:
+		return nil, nil, 0, sizeError(format, len(data))
+	}
+
+	mon = data[gbListHeader : gbListHeader+partySize]
+	otName = data[gbListHeader+partySize : gbListHeader+partySize+nameSize]
+	return mon, otName, language, nil
+}
+
+// dvs splits the Game Boy IVs into attack, defense, speed and special.
+func dvs(data []byte) (atk, def, spe, spc int) {
+	v := binary.BigEndian.Uint16(data)
+	return int(v >> 12), int(v>>8) & 0xF, int(v>>4) & 0xF, int(v) & 0xF
+}
+
+// isShinyGameBoy uses the Gen 2 definition, which also applies to Gen 1 Pokémon once transferred.
+func isShinyGameBoy(atk, def, spe, spc int) bool {
+	return def == 10 && spe == 10 && spc == 10 && atk&2 != 0
+}
+
+func gameBoyInfo(mon, otName []byte, language int, dvOffset, tidOffset int) *Info {
+	info := &Info{
+		TID:      int(binary.BigEndian.Uint16(mon[tidOffset:])),
+		Language: language,
+	}
+
+	info.Shiny = isShinyGameBoy(dvs(mon[dvOffset:]))
+	if language != languageJapanese {
+		info.OTName = decodeGameBoyString(otName)
+	}
+
+	return info
+}
+
+func decodePK1(data []byte) (*Info, error) {
+	mon, otName, language, err := gameBoyList(data, pk1PartySize, "PK1")
+	if err != nil {
+		return nil, err
+	}
+
+	info := gameBoyInfo(mon, otName, language, 0x1B, 0x0C)
+	info.Species = species(speciesGen1(int(mon[0x00])))
+	info.Level = level(mon, 0x21)
+
+	return info, nil
+}
+
+func decodePK2(data []byte) (*Info, error) {
+	mon, otName, language, err := gameBoyList(data, pk2PartySize, "PK2")
+	if err != nil {
+		return nil, err
+	}
+
+	info := gameBoyInfo(mon, otName, language, 0x15, 0x06)
+	info.Species = species(int(mon[0x00]))
+	info.HeldItem = int(mon[0x01])
+	info.Level = level(mon, 0x1F)
+
+	if int(mon[0x00]) == unownSpecies {
+		atk, def, spe, spc := dvs(mon[0x15:])
+		info.Form = ((atk&6)<<5 | (def&6)<<3 | (spe&6)<<1 | (spc&6)>>1) / 10
+	}
+
+	return info, nil
+}
@@ -0,0 +1,69 @@
+package pkm
+
+import "encoding/binary"
+
+const (
+	pk3StoredSize = 80
+	pk3PartySize  = 100
+	pk3BlockSize  = 12
+)
+
+func decodePK3(data []byte) (*Info, error) {
+	if len(data) != pk3StoredSize && len(data) != pk3PartySize {
+		return nil, sizeError("PK3", len(data))
+	}
+
+	pid := binary.LittleEndian.Uint32(data)
+	if err := decryptPK3(data, pid); err != nil {
+		return nil, err
+	}
+
+	tid := int(binary.LittleEndian.Uint16(data[0x04:]))
+	sid := int(binary.LittleEndian.Uint16(data[0x06:]))
+	origins := binary.LittleEndian.Uint16(data[0x46:])
+
+	info := &Info{
+		Species:    species(speciesGen3(int(binary.LittleEndian.Uint16(data[0x20:])))),
+		Level:      level(data, 0x54),
+		Shiny:      isShiny(pid, tid, sid, 8),
+		Nature:     ptr(int(pid % 25)),
+		TID:        tid,
+		SID:        sid,
+		Ball:       int(origins>>11) & 0xF,
+		HeldItem:   int(binary.LittleEndian.Uint16(data[0x22:])),
+		Language:   int(data[0x12]),
+		OriginGame: int(origins>>7) & 0xF,
+	}
+
+	if info.Species != nil && *info.Species == unownSpecies {
+		info.Form = int((pid>>24)&3<<6|(pid>>16)&3<<4|(pid>>8)&3<<2|pid&3) % 28
+	}
+
+	if info.Language != languageJapanese {
+		info.OTName = decodeGen3String(data[0x14:0x1B])
+	}
+
+	return info, nil
+}
+
+// decryptPK3 decrypts the substructures in place unless they already are, Gen 3 xors them with the
+// PID and trainer ID rather than using the LCRNG.
+func decryptPK3(data []byte, pid uint32) error {
+	stored := binary.LittleEndian.Uint16(data[0x1C:])
+	blocks := data[0x20 : 0x20+4*pk3BlockSize]
+	if checksum16(blocks) == stored {
+		return nil
+	}
+
+	key := pid ^ binary.LittleEndian.Uint32(data[0x04:])
+	for i := 0; i < len(blocks); i += 4 {
+		binary.LittleEndian.PutUint32(blocks[i:], binary.LittleEndian.Uint32(blocks[i:])^key)
+	}
+	unshuffle(data, 0x20, pk3BlockSize, pid%24)
+
+	if checksum16(blocks) != stored {
+		return ErrChecksum
+	}
+
+	return nil
+}
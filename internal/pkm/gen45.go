@@ -0,0 +1,66 @@
+package pkm
+
+import "encoding/binary"
+
+const (
+	pk45StoredSize = 0x88
+	pk4PartySize   = 0xEC
+	pk5PartySize   = 0xDC
+	pk45BlockSize  = 0x20
+)
+
+func decodePK4(data []byte) (*Info, error) {
+	return decodeGen45(data, pk4PartySize, "PK4")
+}
+
+func decodePK5(data []byte) (*Info, error) {
+	return decodeGen45(data, pk5PartySize, "PK5")
+}
+
+func decodeGen45(data []byte, partySize int, format string) (*Info, error) {
+	if len(data) != pk45StoredSize && len(data) != partySize {
+		return nil, sizeError(format, len(data))
+	}
+
+	if err := decryptGen4Plus(data, pk45StoredSize, pk45BlockSize, true); err != nil {
+		return nil, err
+	}
+
+	gen5 := format == "PK5"
+	pid := binary.LittleEndian.Uint32(data)
+	tid := int(binary.LittleEndian.Uint16(data[0x0C:]))
+	sid := int(binary.LittleEndian.Uint16(data[0x0E:]))
+
+	info := &Info{
+		Species:    species(int(binary.LittleEndian.Uint16(data[0x08:]))),
+		Form:       int(data[0x40] >> 3),
+		Level:      level(data, 0x8C),
+		Shiny:      isShiny(pid, tid, sid, 8),
+		Nature:     ptr(int(pid % 25)),
+		Gender:     ptr(int(data[0x40]>>1) & 3),
+		TID:        tid,
+		SID:        sid,
+		Ball:       int(data[0x83]),
+		HeldItem:   int(binary.LittleEndian.Uint16(data[0x0A:])),
+		Language:   int(data[0x17]),
+		OriginGame: int(data[0x5F]),
+	}
+
+	if gen5 {
+		// Gen 5 stores the nature separately from the PID.
+		info.Nature = ptr(int(data[0x41]))
+		info.OTName = decodeUTF16String(data[0x68:0x78])
+		return info, nil
+	}
+
+	// HGSS has its own ball field for the balls DPPt doesn't know about.
+	if ball := data[0x86]; ball != 0 {
+		info.Ball = int(ball)
+	}
+
+	if info.Language != languageJapanese && info.Language != languageKorean {
+		info.OTName = decodeGen4String(data[0x68:0x78])
+	}
+
+	return info, nil
+}
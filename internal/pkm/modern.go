@@ -0,0 +1,101 @@
+package pkm
+
+import "encoding/binary"
+
+// layout holds the offsets that moved around between the formats from Gen 6 onwards.
+type layout struct {
+	name        string
+	storedSize  int
+	partySize   int
+	blockSize   int
+	pid         int
+	nature      int
+	gender      int
+	genderShift int
+	form        int
+	formWide    bool
+	otName      int
+	ball        int
+	version     int
+	language    int
+	level       int
+	species     func(int) int
+	// boxedParty is set when the game keeps the party stats in its boxes too, so they're part of the
+	// stored data.
+	boxedParty bool
+}
+
+var (
+	layoutPK6 = layout{
+		name: "PK6/PK7", storedSize: 0xE8, partySize: 0x104, blockSize: 0x38,
+		pid: 0x18, nature: 0x1C, gender: 0x1D, genderShift: 1, form: 0x1D,
+		otName: 0xB0, ball: 0xDC, version: 0xDF, language: 0xE3, level: 0xEC,
+	}
+	layoutPB7 = layout{
+		name: "PB7", storedSize: 0xE8, partySize: 0x104, blockSize: 0x38,
+		pid: 0x18, nature: 0x1C, gender: 0x1D, genderShift: 1, form: 0x1D,
+		otName: 0xB0, ball: 0xDC, version: 0xDF, language: 0xE3, level: 0xEC, boxedParty: true,
+	}
+	layoutPK8 = layout{
+		name: "PK8", storedSize: 0x148, partySize: 0x158, blockSize: 0x50,
+		pid: 0x1C, nature: 0x20, gender: 0x22, genderShift: 2, form: 0x24, formWide: true,
+		otName: 0xF8, ball: 0x124, version: 0xDE, language: 0xE2, level: 0x148,
+	}
+	layoutPB8 = layout{
+		name: "PB8", storedSize: 0x148, partySize: 0x158, blockSize: 0x50,
+		pid: 0x1C, nature: 0x20, gender: 0x22, genderShift: 2, form: 0x24, formWide: true,
+		otName: 0xF8, ball: 0x124, version: 0xDE, language: 0xE2, level: 0x148,
+	}
+	layoutPA8 = layout{
+		name: "PA8", storedSize: 0x168, partySize: 0x178, blockSize: 0x58,
+		pid: 0x1C, nature: 0x20, gender: 0x22, genderShift: 2, form: 0x24, formWide: true,
+		otName: 0x110, ball: 0x137, version: 0xEE, language: 0xF2, level: 0x168,
+	}
+	layoutPK9 = layout{
+		name: "PK9", storedSize: 0x148, partySize: 0x158, blockSize: 0x50,
+		pid: 0x1C, nature: 0x20, gender: 0x22, genderShift: 1, form: 0x24, formWide: true,
+		otName: 0xF8, ball: 0x124, version: 0xCE, language: 0xD5, level: 0x148, species: speciesGen9,
+	}
+)
+
+// decodeModern reads the formats from Gen 6 onwards, they all share the same encryption and the party
+// level right after the stored data.
+func decodeModern(data []byte, l layout) (*Info, error) {
+	if len(data) != l.storedSize && len(data) != l.partySize {
+		return nil, sizeError(l.name, len(data))
+	}
+
+	if err := decryptGen4Plus(data, l.storedSize, l.blockSize, false); err != nil {
+		return nil, err
+	}
+
+	pid := binary.LittleEndian.Uint32(data[l.pid:])
+	tid := int(binary.LittleEndian.Uint16(data[0x0C:]))
+	sid := int(binary.LittleEndian.Uint16(data[0x0E:]))
+
+	speciesIndex := int(binary.LittleEndian.Uint16(data[0x08:]))
+	if l.species != nil {
+		speciesIndex = l.species(speciesIndex)
+	}
+
+	form := int(data[l.form] >> 3)
+	if l.formWide {
+		form = int(binary.LittleEndian.Uint16(data[l.form:]))
+	}
+
+	return &Info{
+		Species:    species(speciesIndex),
+		Form:       form,
+		Level:      level(data, l.level),
+		Shiny:      isShiny(pid, tid, sid, 16),
+		Nature:     ptr(int(data[l.nature])),
+		Gender:     ptr(int(data[l.gender]>>l.genderShift) & 3),
+		OTName:     decodeUTF16String(data[l.otName : l.otName+0x1A]),
+		TID:        tid,
+		SID:        sid,
+		Ball:       int(data[l.ball]),
+		HeldItem:   int(binary.LittleEndian.Uint16(data[0x0A:])),
+		Language:   int(data[l.language]),
+		OriginGame: int(data[l.version]),
+	}, nil
+}
@@ -0,0 +1,129 @@
+// Package pkm decodes the metadata of Pokémon files (PK1 through PK9, PB7, PB8 and PA8) without
+// going through GpssConsole, so it can be stored alongside the raw data and searched on.
+package pkm
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/FlagBrew/local-gpss/internal/generation"
+)
+
+const (
+	GenderMale       = 0
+	GenderFemale     = 1
+	GenderGenderless = 2
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown pokemon format")
+	ErrChecksum      = errors.New("pokemon checksum mismatch")
+)
+
+// Info is everything we know how to read from a Pokémon file. Pointer fields are nil when the format
+// doesn't store them: box data has no level, Gen 1 and 2 have no natures and Gen 1 to 3 derive the
+// gender from the species, which isn't tracked here.
+type Info struct {
+	// Species is the national dex number, nil if it couldn't be mapped.
+	Species *int
+	Form    int
+	Level   *int
+	Shiny   bool
+	Nature  *int
+	Gender  *int
+	OTName  string
+	TID     int
+	SID     int
+	Ball    int
+	// HeldItem is the item index of the format, Gen 1 to 3 use their own item tables.
+	HeldItem int
+	// Language follows PKHeX's LanguageID.
+	Language int
+	// OriginGame follows PKHeX's GameVersion, 0 if the format doesn't record it.
+	OriginGame int
+}
+
+// Decode reads the Pokémon, encrypted data is decrypted first, the input isn't modified.
+func Decode(data []byte, gen generation.Generation) (*Info, error) {
+	data = append([]byte(nil), data...)
+
+	switch gen {
+	case generation.Gen1:
+		return decodePK1(data)
+	case generation.Gen2:
+		return decodePK2(data)
+	case generation.Gen3:
+		return decodePK3(data)
+	case generation.Gen4:
+		return decodePK4(data)
+	case generation.Gen5:
+		return decodePK5(data)
+	case generation.Gen6, generation.Gen7:
+		return decodeModern(data, layoutPK6)
+	case generation.LGPE:
+		return decodeModern(data, layoutPB7)
+	case generation.Gen8:
+		return decodeModern(data, layoutPK8)
+	case generation.BDSP:
+		return decodeModern(data, layoutPB8)
+	case generation.PLA:
+		return decodeModern(data, layoutPA8)
+	case generation.Gen9:
+		return decodeModern(data, layoutPK9)
+	default:
+		return nil, fmt.Errorf("%w: generation %q", ErrUnknownFormat, gen.String())
+	}
+}
+
+// Extension returns the file extension PKHeX uses for the generation's format, without the dot.
+func Extension(gen generation.Generation) (string, error) {
+	switch gen {
+	case generation.LGPE:
+		return "pb7", nil
+	case generation.BDSP:
+		return "pb8", nil
+	case generation.PLA:
+		return "pa8", nil
+	case generation.Unknown:
+		return "", fmt.Errorf("%w: generation %q", ErrUnknownFormat, gen.String())
+	default:
+		return "pk" + gen.String(), nil
+	}
+}
+
+func sizeError(format string, size int) error {
+	return fmt.Errorf("%w: %d bytes isn't a valid %s", ErrUnknownFormat, size, format)
+}
+
+// ptr returns a pointer to v.
+func ptr(v int) *int {
+	return &v
+}
+
+// level returns the party level when it's sane, box data doesn't have one.
+func level(data []byte, offset int) *int {
+	if len(data) <= offset {
+		return nil
+	}
+
+	if lvl := int(data[offset]); lvl >= 1 && lvl <= 100 {
+		return &lvl
+	}
+
+	return nil
+}
+
+// species returns nil for 0 as that's never a valid Pokémon.
+func species(v int) *int {
+	if v <= 0 {
+		return nil
+	}
+
+	return &v
+}
+
+// isShiny compares the PID against the trainer IDs, Gen 6 onwards doubled the threshold.
+func isShiny(pid uint32, tid, sid int, threshold int) bool {
+	xor := uint32(tid) ^ uint32(sid) ^ (pid >> 16) ^ (pid & 0xFFFF)
+	return xor < uint32(threshold)
+}
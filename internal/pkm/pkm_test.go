@@ -0,0 +1,239 @@
+package pkm
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/FlagBrew/local-gpss/internal/generation"
+)
+
+//go:generate go run testdata/generate.go
+
+func readSample(t *testing.T, name string) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
+
+func TestDecodeGameBoy(t *testing.T) {
+	tests := []struct {
+		file string
+		gen  generation.Generation
+		want Info
+	}{
+		{
+			file: "pk1.pk1", gen: generation.Gen1,
+			want: Info{Species: ptr(25), Level: ptr(25), OTName: "RED", TID: 0xBEEF, Language: languageEnglish},
+		},
+		{
+			// Japanese names aren't decoded, the shorter name fields are what tells the language apart.
+			file: "pk1_jp.pk1", gen: generation.Gen1,
+			want: Info{Species: ptr(1), Level: ptr(5), TID: 0x0102, Language: languageJapanese},
+		},
+		{
+			// Shiny DVs only leave room for the letters I and V.
+			file: "pk2_unown_shiny.pk2", gen: generation.Gen2,
+			want: Info{
+				Species: ptr(201), Form: 8, Level: ptr(30), Shiny: true, OTName: "GOLD", TID: 54321,
+				HeldItem: 0x2A, Language: languageEnglish,
+			},
+		},
+		{
+			file: "pk2_unown.pk2", gen: generation.Gen2,
+			want: Info{Species: ptr(201), Form: 25, Level: ptr(12), OTName: "Kris", TID: 1000, Language: languageEnglish},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			got, err := Decode(readSample(t, tt.file), tt.gen)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("got %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		format string
+		gen    generation.Generation
+		// party is the level read from the party data, the box data has none.
+		party int
+		want  Info
+	}{
+		{
+			format: "pk3", gen: generation.Gen3, party: 5,
+			want: Info{
+				Species: ptr(252), Nature: ptr(0x87654321 % 25), OTName: "BRENDAN", TID: 31337, SID: 4242,
+				Ball: 4, HeldItem: 13, Language: languageEnglish, OriginGame: 2,
+			},
+		},
+		{
+			format: "pk4", gen: generation.Gen4, party: 22,
+			want: Info{
+				Species: ptr(422), Form: 1, Nature: ptr(0x00C0FFEE % 25), Gender: ptr(GenderFemale),
+				OTName: "Lucas 7", TID: 12, SID: 34, Ball: 7, Language: languageEnglish, OriginGame: 10,
+			},
+		},
+		{
+			format: "pk5", gen: generation.Gen5, party: 5,
+			want: Info{
+				Species: ptr(495), Nature: ptr(20), Gender: ptr(GenderMale), OTName: "Hilbert", TID: 65535,
+				SID: 1, Ball: 4, HeldItem: 234, Language: languageEnglish, OriginGame: 21,
+			},
+		},
+		{
+			format: "pk6", gen: generation.Gen6, party: 36,
+			want: Info{
+				Species: ptr(658), Nature: ptr(10), Gender: ptr(GenderMale), OTName: "Calem", TID: 1111,
+				SID: 2222, Ball: 4, Language: languageEnglish, OriginGame: 24,
+			},
+		},
+		{
+			format: "pk7", gen: generation.Gen7, party: 44,
+			want: Info{
+				Species: ptr(26), Form: 1, Shiny: true, Nature: ptr(5), Gender: ptr(GenderFemale),
+				OTName: "Elio", TID: 0x3039, SID: 0xD431, Ball: 5, HeldItem: 50, Language: languageEnglish,
+				OriginGame: 30,
+			},
+		},
+		{
+			format: "pk8", gen: generation.Gen8, party: 50,
+			want: Info{
+				Species: ptr(815), Nature: ptr(13), Gender: ptr(GenderMale), OTName: "Victor", TID: 40000,
+				SID: 1, Ball: 4, HeldItem: 1606, Language: languageEnglish, OriginGame: 44,
+			},
+		},
+		{
+			format: "pb8", gen: generation.BDSP, party: 5,
+			want: Info{
+				Species: ptr(393), Nature: ptr(0), Gender: ptr(GenderMale), OTName: "Lucas", TID: 500,
+				SID: 600, Ball: 4, Language: 5, OriginGame: 48,
+			},
+		},
+		{
+			format: "pa8", gen: generation.PLA, party: 70,
+			want: Info{
+				Species: ptr(724), Form: 1, Nature: ptr(24), Gender: ptr(GenderMale), OTName: "Rei", TID: 101,
+				SID: 202, Ball: 28, Language: languageEnglish, OriginGame: 47,
+			},
+		},
+		{
+			// Stored as 917, the first Gen 9 index that doesn't follow the national dex.
+			format: "pk9", gen: generation.Gen9, party: 62,
+			want: Info{
+				Species: ptr(982), Form: 1, Shiny: true, Nature: ptr(7), Gender: ptr(GenderFemale),
+				OTName: "Juliana", TID: 0x1234, SID: 0xABCD, Ball: 4, Language: languageEnglish, OriginGame: 50,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, kind := range []string{"box", "party"} {
+			for _, state := range []string{"decrypted", "encrypted"} {
+				file := tt.format + "_" + kind + "_" + state + "." + tt.format
+				t.Run(file, func(t *testing.T) {
+					data := readSample(t, file)
+					orig := append([]byte(nil), data...)
+
+					got, err := Decode(data, tt.gen)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					want := tt.want
+					if kind == "party" {
+						want.Level = ptr(tt.party)
+					}
+
+					if !reflect.DeepEqual(*got, want) {
+						t.Errorf("got %+v, want %+v", *got, want)
+					}
+
+					if !reflect.DeepEqual(data, orig) {
+						t.Error("input was modified")
+					}
+				})
+			}
+		}
+	}
+}
+
+// TestDecodePB7 only has party sized files, LGPE keeps the party stats in its boxes.
+func TestDecodePB7(t *testing.T) {
+	want := Info{
+		Species: ptr(25), Level: ptr(12), Nature: ptr(3), Gender: ptr(GenderFemale), OTName: "Chase", TID: 7,
+		SID: 8, Ball: 4, Language: languageEnglish, OriginGame: 42,
+	}
+
+	for _, file := range []string{"pb7_party_decrypted.pb7", "pb7_party_encrypted.pb7"} {
+		t.Run(file, func(t *testing.T) {
+			got, err := Decode(readSample(t, file), generation.LGPE)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(*got, want) {
+				t.Errorf("got %+v, want %+v", *got, want)
+			}
+		})
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	corrupt := readSample(t, "pk8_box_encrypted.pk8")
+	corrupt[0x40] ^= 0xFF
+
+	tests := []struct {
+		name string
+		data []byte
+		gen  generation.Generation
+		want error
+	}{
+		{name: "checksum", data: corrupt, gen: generation.Gen8, want: ErrChecksum},
+		{name: "size", data: readSample(t, "pk8_box_decrypted.pk8")[:0x100], gen: generation.Gen8, want: ErrUnknownFormat},
+		{name: "wrong generation", data: readSample(t, "pk3_box_decrypted.pk3"), gen: generation.Gen4, want: ErrUnknownFormat},
+		{name: "unknown generation", data: readSample(t, "pk1.pk1"), gen: generation.Unknown, want: ErrUnknownFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Decode(tt.data, tt.gen); !errors.Is(err, tt.want) {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpeciesGen9(t *testing.T) {
+	tests := []struct{ index, want int }{
+		{906, 906},
+		{916, 916},
+		{917, 982},
+		{918, 917},
+		{954, 921},
+		{1010, 979},
+		// The DLC Pokémon follow the national dex again.
+		{1011, 1011},
+		{1025, 1025},
+	}
+
+	for _, tt := range tests {
+		if got := speciesGen9(tt.index); got != tt.want {
+			t.Errorf("speciesGen9(%d) = %d, want %d", tt.index, got, tt.want)
+		}
+	}
+}
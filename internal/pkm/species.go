@@ -0,0 +1,72 @@
+package pkm
+
+// Gen 1 stores Pokémon by an internal index rather than their national dex number.
+var gen1Species = [...]int{
+	0, 112, 115, 32, 35, 21, 100, 34, 80, 2, 103, 108, 102, 88, 94, 29,
+	31, 104, 111, 131, 59, 151, 130, 90, 72, 92, 123, 120, 9, 127, 114, 0,
+	0, 58, 95, 22, 16, 79, 64, 75, 113, 67, 122, 106, 107, 24, 47, 54,
+	96, 76, 0, 126, 0, 125, 82, 109, 0, 56, 86, 50, 128, 0, 0, 0,
+	83, 48, 149, 0, 0, 0, 84, 60, 124, 146, 144, 145, 132, 52, 98, 0,
+	0, 0, 37, 38, 25, 26, 0, 0, 147, 148, 140, 141, 116, 117, 0, 0,
+	27, 28, 138, 139, 39, 40, 133, 136, 135, 134, 66, 41, 23, 46, 61, 62,
+	13, 14, 15, 0, 85, 57, 51, 49, 87, 0, 0, 10, 11, 12, 68, 0,
+	55, 97, 42, 150, 143, 129, 0, 0, 89, 0, 99, 91, 0, 101, 36, 110,
+	53, 105, 0, 93, 63, 65, 17, 18, 121, 1, 3, 73, 0, 118, 119, 0,
+	0, 0, 0, 77, 78, 19, 20, 33, 30, 74, 137, 142, 0, 81, 0, 0,
+	4, 7, 5, 8, 6, 0, 0, 0, 0, 43, 44, 45, 69, 70, 71}
+
+// Gen 3 matches the national dex up to Celebi, after that there's a gap of 25 unused slots and the
+// Hoenn Pokémon follow in their own order.
+var gen3Species = [...]int{
+	252, 253, 254, 255, 256, 257, 258, 259, 260, 261, 262, 263, 264, 265, 266, 267,
+	268, 269, 270, 271, 272, 273, 274, 275, 290, 291, 292, 276, 277, 285, 286, 327,
+	278, 279, 283, 284, 320, 321, 300, 301, 352, 343, 344, 299, 324, 302, 339, 340,
+	370, 341, 342, 349, 350, 318, 319, 328, 329, 330, 296, 297, 309, 310, 322, 323,
+	363, 364, 365, 331, 332, 361, 362, 337, 338, 298, 325, 326, 311, 312, 303, 307,
+	308, 333, 334, 360, 355, 356, 315, 287, 288, 289, 316, 317, 357, 293, 294, 295,
+	366, 367, 368, 359, 353, 354, 336, 335, 369, 304, 305, 306, 351, 313, 314, 345,
+	346, 347, 348, 280, 281, 282, 371, 372, 373, 374, 375, 376, 377, 378, 379, 382,
+	383, 384, 380, 381, 385, 386, 358}
+
+// Gen 9 matches the national dex up to Oinkologne, the Pokémon that follow are in the order Paldea was
+// made in. The ones added by the DLC match the national dex again.
+var gen9Species = [...]int{
+	982, 917, 918, 919, 920, 953, 954, 971, 972, 955, 956, 981, 960, 961, 977, 976,
+	963, 964, 928, 929, 930, 951, 952, 938, 939, 965, 966, 968, 924, 925, 974, 975,
+	996, 997, 998, 978, 967, 921, 922, 923, 940, 941, 962, 931, 973, 950, 932, 933,
+	934, 969, 970, 944, 945, 926, 927, 942, 943, 946, 947, 999, 1000, 984, 986, 1009,
+	989, 985, 987, 988, 1005, 990, 1010, 994, 992, 993, 995, 991, 1006, 1003, 1002, 1001,
+	1004, 1007, 1008, 957, 958, 959, 935, 936, 937, 948, 949, 983, 980, 979}
+
+const (
+	gen3FirstHoenn = 277
+	// gen9FirstUnaligned is where the Gen 9 internal index stops following the national dex.
+	gen9FirstUnaligned = 917
+)
+
+func speciesGen1(index int) int {
+	if index < len(gen1Species) {
+		return gen1Species[index]
+	}
+
+	return 0
+}
+
+func speciesGen3(index int) int {
+	switch {
+	case index <= 251:
+		return index
+	case index >= gen3FirstHoenn && index-gen3FirstHoenn < len(gen3Species):
+		return gen3Species[index-gen3FirstHoenn]
+	default:
+		return 0
+	}
+}
+
+func speciesGen9(index int) int {
+	if index >= gen9FirstUnaligned && index-gen9FirstUnaligned < len(gen9Species) {
+		return gen9Species[index-gen9FirstUnaligned]
+	}
+
+	return index
+}
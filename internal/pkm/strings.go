@@ -0,0 +1,108 @@
+package pkm
+
+import (
+	"encoding/binary"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	languageJapanese = 1
+	languageEnglish  = 2
+	languageKorean   = 8
+)
+
+// gameBoyChars covers the international Gen 1 and 2 character set.
+var gameBoyChars = map[byte]rune{
+	0x7F: ' ', 0x9A: '(', 0x9B: ')', 0x9C: ':', 0x9D: ';', 0x9E: '[', 0x9F: ']', 0xBA: 'é',
+	0xE0: '\'', 0xE3: '-', 0xE6: '?', 0xE7: '!', 0xE8: '.', 0xEF: '♂', 0xF1: '×', 0xF2: '.',
+	0xF3: '/', 0xF4: ',', 0xF5: '♀',
+}
+
+// gen3Chars covers the international Gen 3 character set.
+var gen3Chars = map[byte]rune{
+	0x00: ' ', 0x1B: 'é', 0xAB: '!', 0xAC: '?', 0xAD: '.', 0xAE: '-', 0xB0: '…', 0xB1: '“',
+	0xB2: '”', 0xB3: '‘', 0xB4: '’', 0xB5: '♂', 0xB6: '♀', 0xB8: ',', 0xBA: '/',
+}
+
+// decodeGameBoyString reads a Gen 1 or 2 string, Japanese names aren't supported.
+func decodeGameBoyString(data []byte) string {
+	var sb strings.Builder
+	for _, b := range data {
+		switch {
+		case b == 0x50:
+			return sb.String()
+		case b >= 0x80 && b <= 0x99:
+			sb.WriteRune(rune('A' + b - 0x80))
+		case b >= 0xA0 && b <= 0xB9:
+			sb.WriteRune(rune('a' + b - 0xA0))
+		case b >= 0xF6:
+			sb.WriteRune(rune('0' + b - 0xF6))
+		default:
+			if r, ok := gameBoyChars[b]; ok {
+				sb.WriteRune(r)
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// decodeGen3String reads a Gen 3 string, Japanese names aren't supported.
+func decodeGen3String(data []byte) string {
+	var sb strings.Builder
+	for _, b := range data {
+		switch {
+		case b == 0xFF:
+			return sb.String()
+		case b >= 0xA1 && b <= 0xAA:
+			sb.WriteRune(rune('0' + b - 0xA1))
+		case b >= 0xBB && b <= 0xD4:
+			sb.WriteRune(rune('A' + b - 0xBB))
+		case b >= 0xD5 && b <= 0xEE:
+			sb.WriteRune(rune('a' + b - 0xD5))
+		default:
+			if r, ok := gen3Chars[b]; ok {
+				sb.WriteRune(r)
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// decodeGen4String reads a Gen 4 string, only latin letters, digits and spaces are supported.
+func decodeGen4String(data []byte) string {
+	var sb strings.Builder
+	for i := 0; i+1 < len(data); i += 2 {
+		c := binary.LittleEndian.Uint16(data[i:])
+		switch {
+		case c == 0xFFFF:
+			return sb.String()
+		case c >= 0x0121 && c <= 0x012A:
+			sb.WriteRune(rune('0' + c - 0x0121))
+		case c >= 0x012B && c <= 0x0144:
+			sb.WriteRune(rune('A' + c - 0x012B))
+		case c >= 0x0145 && c <= 0x015E:
+			sb.WriteRune(rune('a' + c - 0x0145))
+		case c == 0x01DE:
+			sb.WriteRune(' ')
+		}
+	}
+
+	return sb.String()
+}
+
+// decodeUTF16String reads the UTF-16 strings used from Gen 5 onwards, terminated by 0x0000 or 0xFFFF.
+func decodeUTF16String(data []byte) string {
+	var chars []uint16
+	for i := 0; i+1 < len(data); i += 2 {
+		c := binary.LittleEndian.Uint16(data[i:])
+		if c == 0 || c == 0xFFFF {
+			break
+		}
+		chars = append(chars, c)
+	}
+
+	return string(utf16.Decode(chars))
+}
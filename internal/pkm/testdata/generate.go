@@ -0,0 +1,333 @@
+//go:build ignore
+
+// generate writes the sample Pokémon the pkm tests decode. Every format is written decrypted and
+// encrypted, as box and as party data, using the layouts documented by PKHeX. The encryption is done
+// here rather than with the package's own code so a mistake in one doesn't hide a mistake in the other.
+//
+// Run it from internal/pkm with: go run testdata/generate.go
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"unicode/utf16"
+)
+
+// blockOrder is the order the A, B, C, D blocks are written in for each shuffle value.
+var blockOrder = [24]string{
+	"ABCD", "ABDC", "ACBD", "ACDB", "ADBC", "ADCB",
+	"BACD", "BADC", "BCAD", "BCDA", "BDAC", "BDCA",
+	"CABD", "CADB", "CBAD", "CBDA", "CDAB", "CDBA",
+	"DABC", "DACB", "DBAC", "DBCA", "DCAB", "DCBA",
+}
+
+func main() {
+	for name, data := range samples() {
+		if err := os.WriteFile(filepath.Join("testdata", name), data, 0o644); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
+
+func samples() map[string][]byte {
+	out := map[string][]byte{}
+
+	out["pk1.pk1"] = pk1(0x54, 0x1234, 25, 0xBEEF, gbString("RED", 11), 11)
+	out["pk1_jp.pk1"] = pk1(0x99, 0xFFFF, 5, 0x0102, []byte{0x80, 0x50, 0x50, 0x50, 0x50, 0x50}, 6)
+	// Shiny Unown can only be I or V in Gen 2.
+	out["pk2_unown_shiny.pk2"] = pk2(201, 0x2AAA, 0x2A, 30, 54321, gbString("GOLD", 11))
+	out["pk2_unown.pk2"] = pk2(201, 0xFFFF, 0, 12, 1000, gbString("Kris", 11))
+
+	variants(out, "pk3", pk3(), 80, func(data []byte) { encryptPK3(data) })
+
+	variants(out, "pk4", pk4(), 0x88, func(data []byte) { encryptGen45(data) })
+	variants(out, "pk5", pk5(), 0x88, func(data []byte) { encryptGen45(data) })
+
+	for name, m := range modern() {
+		stored := m.layout.storedSize
+		variants(out, name, m.data(), stored, func(data []byte) { encryptModern(data, stored) })
+	}
+
+	return out
+}
+
+// variants adds the box and party data of a Pokémon, both decrypted and encrypted. PB7 keeps the party
+// stats in the box so it only has the full size.
+func variants(out map[string][]byte, name string, party []byte, storedSize int, encrypt func([]byte)) {
+	sizes := map[string]int{"box": storedSize, "party": len(party)}
+	if name == "pb7" {
+		sizes = map[string]int{"party": len(party)}
+	}
+
+	for kind, size := range sizes {
+		data := append([]byte(nil), party[:size]...)
+		out[fmt.Sprintf("%s_%s_decrypted.%s", name, kind, name)] = data
+
+		enc := append([]byte(nil), data...)
+		encrypt(enc)
+		out[fmt.Sprintf("%s_%s_encrypted.%s", name, kind, name)] = enc
+	}
+}
+
+// filler returns bytes that stand in for the fields the decoder doesn't read, like moves and EVs, so
+// the checksums and encryption have something to work on.
+func filler(size int, seed uint32) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		seed = seed*1103515245 + 12345
+		data[i] = byte(seed >> 16)
+	}
+
+	return data
+}
+
+func checksum(data []byte) uint16 {
+	var sum uint16
+	for i := 0; i+1 < len(data); i += 2 {
+		sum += binary.LittleEndian.Uint16(data[i:])
+	}
+
+	return sum
+}
+
+// shuffle writes the A, B, C, D blocks starting at start in the order picked by the shuffle value.
+func shuffle(data []byte, start, blockSize int, sv uint32) {
+	plain := append([]byte(nil), data[start:start+4*blockSize]...)
+	for i, block := range blockOrder[sv%24] {
+		src := int(block-'A') * blockSize
+		copy(data[start+i*blockSize:], plain[src:src+blockSize])
+	}
+}
+
+func lcrng(data []byte, seed uint32) {
+	for i := 0; i+1 < len(data); i += 2 {
+		seed = seed*0x41C64E6D + 0x6073
+		v := binary.LittleEndian.Uint16(data[i:]) ^ uint16(seed>>16)
+		binary.LittleEndian.PutUint16(data[i:], v)
+	}
+}
+
+func gbString(s string, size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = 0x50
+	}
+
+	for i, c := range s {
+		switch {
+		case c >= 'A' && c <= 'Z':
+			data[i] = byte(0x80 + c - 'A')
+		case c >= 'a' && c <= 'z':
+			data[i] = byte(0xA0 + c - 'a')
+		}
+	}
+
+	return data
+}
+
+// gameBoyList wraps a Gen 1 or 2 Pokémon in a single entry list followed by its OT name and nickname.
+func gameBoyList(species byte, mon, otName []byte) []byte {
+	data := []byte{1, species, 0xFF}
+	data = append(data, mon...)
+	data = append(data, otName...)
+	return append(data, otName...)
+}
+
+func pk1(species byte, dvs uint16, lvl byte, tid uint16, otName []byte, nameSize int) []byte {
+	mon := filler(44, uint32(species))
+	mon[0x00] = species
+	binary.BigEndian.PutUint16(mon[0x0C:], tid)
+	binary.BigEndian.PutUint16(mon[0x1B:], dvs)
+	mon[0x21] = lvl
+
+	return gameBoyList(species, mon, otName[:nameSize])
+}
+
+func pk2(species byte, dvs uint16, item, lvl byte, tid uint16, otName []byte) []byte {
+	mon := filler(48, uint32(dvs))
+	mon[0x00] = species
+	mon[0x01] = item
+	binary.BigEndian.PutUint16(mon[0x06:], tid)
+	binary.BigEndian.PutUint16(mon[0x15:], dvs)
+	mon[0x1F] = lvl
+
+	return gameBoyList(species, mon, otName)
+}
+
+// pk3 is a Treecko from Ruby, its internal species index is 277.
+func pk3() []byte {
+	data := filler(100, 3)
+	pid := uint32(0x87654321)
+	binary.LittleEndian.PutUint32(data[0x00:], pid)
+	binary.LittleEndian.PutUint16(data[0x04:], 31337)
+	binary.LittleEndian.PutUint16(data[0x06:], 4242)
+	data[0x12] = 2
+	copy(data[0x14:], []byte{0xBC, 0xCC, 0xBF, 0xC8, 0xBE, 0xBB, 0xC8}) // BRENDAN
+	binary.LittleEndian.PutUint16(data[0x20:], 277)
+	binary.LittleEndian.PutUint16(data[0x22:], 13)
+	binary.LittleEndian.PutUint16(data[0x46:], 4<<11|2<<7|5)
+	data[0x54] = 5
+	binary.LittleEndian.PutUint16(data[0x1C:], checksum(data[0x20:0x50]))
+
+	return data
+}
+
+func encryptPK3(data []byte) {
+	pid := binary.LittleEndian.Uint32(data)
+	key := pid ^ binary.LittleEndian.Uint32(data[0x04:])
+	shuffle(data, 0x20, 12, pid%24)
+	for i := 0x20; i < 0x50; i += 4 {
+		binary.LittleEndian.PutUint32(data[i:], binary.LittleEndian.Uint32(data[i:])^key)
+	}
+}
+
+func gen45(pid uint32, size int, species, item, tid, sid uint16, formGender, language, version, ball byte, lvl byte) []byte {
+	data := filler(size, pid)
+	binary.LittleEndian.PutUint32(data[0x00:], pid)
+	binary.LittleEndian.PutUint16(data[0x08:], species)
+	binary.LittleEndian.PutUint16(data[0x0A:], item)
+	binary.LittleEndian.PutUint16(data[0x0C:], tid)
+	binary.LittleEndian.PutUint16(data[0x0E:], sid)
+	data[0x17] = language
+	data[0x40] = formGender
+	data[0x5F] = version
+	data[0x83] = ball
+	data[0x86] = 0
+	data[0x8C] = lvl
+
+	return data
+}
+
+// pk4 is a female Shellos (East Sea) from Diamond caught in a Dive Ball.
+func pk4() []byte {
+	data := gen45(0x00C0FFEE, 0xEC, 422, 0, 12, 34, 1<<3|1<<1, 2, 10, 7, 22)
+	name := []uint16{0x012B + 'L' - 'A', 0x0145 + 'u' - 'a', 0x0145 + 'c' - 'a', 0x0145 + 'a' - 'a',
+		0x0145 + 's' - 'a', 0x01DE, 0x0121 + 7, 0xFFFF}
+	for i, c := range name {
+		binary.LittleEndian.PutUint16(data[0x68+2*i:], c)
+	}
+	binary.LittleEndian.PutUint16(data[0x06:], checksum(data[0x08:0x88]))
+
+	return data
+}
+
+// pk5 is a Snivy from Black.
+func pk5() []byte {
+	data := gen45(0x55AA55AA, 0xDC, 495, 234, 65535, 1, 0, 2, 21, 4, 5)
+	data[0x41] = 20
+	putUTF16(data[0x68:0x78], "Hilbert", 0xFFFF)
+	binary.LittleEndian.PutUint16(data[0x06:], checksum(data[0x08:0x88]))
+
+	return data
+}
+
+func encryptGen45(data []byte) {
+	pid := binary.LittleEndian.Uint32(data)
+	shuffle(data, 0x08, 0x20, (pid&0x3E000)>>13)
+	lcrng(data[0x08:0x88], uint32(binary.LittleEndian.Uint16(data[0x06:])))
+	if len(data) > 0x88 {
+		lcrng(data[0x88:], pid)
+	}
+}
+
+func putUTF16(data []byte, s string, terminator uint16) {
+	chars := append(utf16.Encode([]rune(s)), terminator)
+	for i, c := range chars {
+		binary.LittleEndian.PutUint16(data[2*i:], c)
+	}
+}
+
+// modernLayout is where the fields the decoder reads are in the formats from Gen 6 onwards.
+type modernLayout struct {
+	storedSize, partySize                          int
+	pid, nature, gender, genderShift, form, otName int
+	ball, version, language, level                 int
+	formWide                                       bool
+}
+
+var (
+	layoutGen67 = modernLayout{storedSize: 0xE8, partySize: 0x104, pid: 0x18, nature: 0x1C, gender: 0x1D,
+		genderShift: 1, form: 0x1D, otName: 0xB0, ball: 0xDC, version: 0xDF, language: 0xE3, level: 0xEC}
+	layoutSWSH = modernLayout{storedSize: 0x148, partySize: 0x158, pid: 0x1C, nature: 0x20, gender: 0x22,
+		genderShift: 2, form: 0x24, formWide: true, otName: 0xF8, ball: 0x124, version: 0xDE, language: 0xE2,
+		level: 0x148}
+	layoutPLA = modernLayout{storedSize: 0x168, partySize: 0x178, pid: 0x1C, nature: 0x20, gender: 0x22,
+		genderShift: 2, form: 0x24, formWide: true, otName: 0x110, ball: 0x137, version: 0xEE, language: 0xF2,
+		level: 0x168}
+	layoutSV = modernLayout{storedSize: 0x148, partySize: 0x158, pid: 0x1C, nature: 0x20, gender: 0x22,
+		genderShift: 1, form: 0x24, formWide: true, otName: 0xF8, ball: 0x124, version: 0xCE, language: 0xD5,
+		level: 0x148}
+)
+
+type modernMon struct {
+	layout                                  modernLayout
+	ec, pid                                 uint32
+	species, item, tid, sid, form           uint16
+	nature, gender, ball, version, language byte
+	level                                   byte
+	otName                                  string
+}
+
+func (m modernMon) data() []byte {
+	l := m.layout
+	data := filler(l.partySize, m.ec)
+	binary.LittleEndian.PutUint32(data[0x00:], m.ec)
+	binary.LittleEndian.PutUint16(data[0x08:], m.species)
+	binary.LittleEndian.PutUint16(data[0x0A:], m.item)
+	binary.LittleEndian.PutUint16(data[0x0C:], m.tid)
+	binary.LittleEndian.PutUint16(data[0x0E:], m.sid)
+	binary.LittleEndian.PutUint32(data[l.pid:], m.pid)
+	data[l.nature] = m.nature
+	if l.formWide {
+		data[l.gender] = m.gender << l.genderShift
+		binary.LittleEndian.PutUint16(data[l.form:], m.form)
+	} else {
+		data[l.form] = byte(m.form)<<3 | m.gender<<l.genderShift
+	}
+	clear(data[l.otName : l.otName+0x1A])
+	putUTF16(data[l.otName:], m.otName, 0)
+	data[l.ball] = m.ball
+	data[l.version] = m.version
+	data[l.language] = m.language
+	data[l.level] = m.level
+	binary.LittleEndian.PutUint16(data[0x06:], checksum(data[0x08:l.storedSize]))
+
+	return data
+}
+
+func modern() map[string]modernMon {
+	return map[string]modernMon{
+		"pk6": {layout: layoutGen67, ec: 0xA1B2C3D4, pid: 0x11112222, species: 658, tid: 1111, sid: 2222,
+			nature: 10, ball: 4, version: 24, language: 2, level: 36, otName: "Calem"},
+		// Alolan Raichu, shiny as the trainer IDs xor to the PID halves.
+		"pk7": {layout: layoutGen67, ec: 0x0F1E2D3C, pid: 0x3039D431, species: 26, form: 1, tid: 0x3039,
+			sid: 0xD431, item: 50, nature: 5, gender: 1, ball: 5, version: 30, language: 2, level: 44,
+			otName: "Elio"},
+		"pb7": {layout: layoutGen67, ec: 0x0BADF00D, pid: 0x0000FFFF, species: 25, tid: 7, sid: 8, nature: 3,
+			gender: 1, ball: 4, version: 42, language: 2, level: 12, otName: "Chase"},
+		"pk8": {layout: layoutSWSH, ec: 0xDEADBEEF, pid: 0x12345678, species: 815, tid: 40000, sid: 1,
+			item: 1606, nature: 13, ball: 4, version: 44, language: 2, level: 50, otName: "Victor"},
+		"pb8": {layout: layoutSWSH, ec: 0xCAFEBABE, pid: 0x9ABCDEF0, species: 393, tid: 500, sid: 600,
+			ball: 4, version: 48, language: 5, level: 5, otName: "Lucas"},
+		// Hisuian Decidueye.
+		"pa8": {layout: layoutPLA, ec: 0x01234567, pid: 0x76543210, species: 724, form: 1, tid: 101, sid: 202,
+			nature: 24, ball: 28, version: 47, language: 2, level: 70, otName: "Rei"},
+		// Three-segment Dudunsparce, stored as 917: the first internal index that doesn't match the
+		// national dex. Shiny as the trainer IDs xor to the PID halves.
+		"pk9": {layout: layoutSV, ec: 0x76543210, pid: 0xABCD1234, species: 917, form: 1, tid: 0x1234,
+			sid: 0xABCD, nature: 7, gender: 1, ball: 4, version: 50, language: 2, level: 62,
+			otName: "Juliana"},
+	}
+}
+
+func encryptModern(data []byte, storedSize int) {
+	ec := binary.LittleEndian.Uint32(data)
+	shuffle(data, 0x08, (storedSize-8)/4, (ec>>13)&31)
+	lcrng(data[0x08:storedSize], ec)
+	if len(data) > storedSize {
+		lcrng(data[storedSize:], ec)
+	}
+}
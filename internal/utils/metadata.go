@@ -0,0 +1,117 @@
+package utils
+
+import (
+	"context"
+	"errors"
+
+	"github.com/FlagBrew/local-gpss/internal/database/ent"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/generation"
+	"github.com/FlagBrew/local-gpss/internal/pkm"
+	"github.com/apex/log"
+)
+
+const backfillBatchSize = 500
+
+// SetPokemonMetadata copies the decoded metadata onto a Pokémon being created or updated. The OT name
+// is always set, so a nil one means the Pokémon still has to be decoded.
+func SetPokemonMetadata(m *ent.PokemonMutation, info *pkm.Info) {
+	if info.Species != nil {
+		m.SetSpecies(*info.Species)
+	}
+
+	if info.Level != nil {
+		m.SetLevel(*info.Level)
+	}
+
+	if info.Nature != nil {
+		m.SetNature(*info.Nature)
+	}
+
+	if info.Gender != nil {
+		m.SetGender(*info.Gender)
+	}
+
+	m.SetForm(info.Form)
+	m.SetShiny(info.Shiny)
+	m.SetOtName(info.OTName)
+	m.SetTid(info.TID)
+	m.SetSid(info.SID)
+	m.SetBall(info.Ball)
+	m.SetHeldItem(info.HeldItem)
+	m.SetLanguage(info.Language)
+	m.SetOriginGame(info.OriginGame)
+}
+
+// BackfillMetadata decodes the metadata of every stored Pokémon that doesn't have it yet, Pokémon that
+// can't be decoded are logged and skipped. Gen 9 Pokémon without a species are decoded again, as the
+// Paldea ones couldn't be mapped to the national dex before.
+func BackfillMetadata(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+	db := ent.FromContext(ctx)
+	if db == nil {
+		return errors.New("DB missing from context")
+	}
+
+	missing := pokemon.And(
+		pokemon.Or(pokemon.OtNameIsNil(), pokemon.And(pokemon.SpeciesIsNil(), pokemon.Generation(generation.Gen9.String()))),
+		pokemon.DataNotNil(),
+	)
+
+	total, err := db.Pokemon.Query().Where(missing).Count(ctx)
+	if err != nil {
+		return err
+	}
+
+	logger.WithField("total", total).Info("backfilling pokemon metadata")
+
+	lastID, decoded, failed := 0, 0, 0
+	for {
+		mons, err := db.Pokemon.Query().
+			Where(pokemon.IDGT(lastID), missing).
+			Order(pokemon.ByID()).
+			Limit(backfillBatchSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(mons) == 0 {
+			break
+		}
+
+		for _, mon := range mons {
+			lastID = mon.ID
+
+			gen, err := generation.Parse(mon.Generation)
+			if err != nil {
+				failed++
+				logger.WithError(err).WithField("code", mon.DownloadCode).Warn("failed to decode pokemon")
+				continue
+			}
+
+			info, err := pkm.Decode(mon.Data, gen)
+			if err != nil {
+				failed++
+				logger.WithError(err).WithField("code", mon.DownloadCode).Warn("failed to decode pokemon")
+				continue
+			}
+
+			update := db.Pokemon.UpdateOne(mon)
+			SetPokemonMetadata(update.Mutation(), info)
+			if err = update.Exec(ctx); err != nil {
+				return err
+			}
+			decoded++
+		}
+
+		logger.Infof("Backfilled: %d/%d, failed: %d", decoded+failed, total, failed)
+	}
+
+	logger.WithFields(log.Fields{
+		"decoded": decoded,
+		"failed":  failed,
+	}).Info("pokemon metadata backfill complete")
+
+	return nil
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
@@ -13,8 +14,12 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/FlagBrew/local-gpss/internal/checker"
+	"github.com/FlagBrew/local-gpss/internal/database"
 	"github.com/FlagBrew/local-gpss/internal/database/ent"
 	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/generation"
 	"github.com/FlagBrew/local-gpss/internal/models"
 	"github.com/apex/log"
 	"golang.org/x/sync/errgroup"
@@ -26,8 +31,11 @@ type oldPokemon struct {
 	DownloadCode   string
 	DownloadCount  int
 	Generation     string
+	Gen            generation.Generation
 	Legal          bool
 	Base64         string
+	Report         []string
+	CheckedAt      *time.Time
 }
 
 type oldBundle struct {
@@ -40,6 +48,13 @@ type oldBundle struct {
 	MaxGen         string
 }
 
+// parseGenNumber converts a generation from the old database to how bundles store it now, unknown
+// generations end up as 0 until the bundle's generations are corrected from its pokemon.
+func parseGenNumber(s string) float64 {
+	gen, _ := generation.Parse(s)
+	return gen.Number()
+}
+
 type oldBundlePokemon struct {
 	PokemonID int
 	BundleID  int
@@ -55,7 +70,7 @@ type bundleBinding struct {
 	NewId int
 }
 
-func MigrateOriginalDb(ctx context.Context, cfg *models.Config) {
+func MigrateOriginalDb(ctx context.Context, cfg *models.Config, legalityChecker checker.LegalityChecker) {
 	logger := log.FromContext(ctx)
 	db := ent.FromContext(ctx)
 	if db == nil {
@@ -123,6 +138,13 @@ func MigrateOriginalDb(ctx context.Context, cfg *models.Config) {
 			return
 		}
 
+		// Keep whatever was stored if it can't be parsed, the pokemon just won't be rechecked.
+		if pokemon.Gen, err = generation.Parse(pokemon.Generation); err == nil {
+			pokemon.Generation = pokemon.Gen.String()
+		} else {
+			logger.WithError(err).WithField("code", pokemon.DownloadCode).Warn("pokemon in old database has an unknown generation")
+		}
+
 		oldPokemons = append(oldPokemons, pokemon)
 	}
 
@@ -158,7 +180,8 @@ func MigrateOriginalDb(ctx context.Context, cfg *models.Config) {
 
 	var oldPokemonBundles []oldBundlePokemon
 
-	rows, err = oldDb.QueryContext(ctx, "SELECT pokemon_id, bundle_id FROM bundle_pokemon")
+	// The old database doesn't store the slots, the members were added in slot order though.
+	rows, err = oldDb.QueryContext(ctx, "SELECT pokemon_id, bundle_id FROM bundle_pokemon ORDER BY rowid")
 	if err != nil {
 		logger.WithError(err).Error("failed to read bundle_pokemon table from old database")
 		return
@@ -183,7 +206,15 @@ func MigrateOriginalDb(ctx context.Context, cfg *models.Config) {
 
 	pkmnMap := sync.Map{}
 	pkmnBindingMap := sync.Map{}
+	engineVersion := ""
 	if cfg.Misc.RecheckLegality {
+		version, err := legalityChecker.Version(ctx)
+		if err != nil {
+			logger.WithError(err).Warn("failed to get the engine version")
+		} else {
+			engineVersion = version.String()
+		}
+
 		failedCount := atomic.Int64{}
 		logger.Info("Rechecking legal information, please wait...")
 		eg, _ := errgroup.WithContext(ctx)
@@ -201,18 +232,22 @@ func MigrateOriginalDb(ctx context.Context, cfg *models.Config) {
 			}
 
 			eg.Go(func() error {
-				// Call GpssConsole to get the latest info
-				result, err := ExecGpssConsole[models.GpssLegalityCheckReply](ctx, models.GpssConsoleArgs{
-					Mode:       "legality",
-					Pokemon:    oldPkmn.Base64,
-					Generation: oldPkmn.Generation,
-				})
+				if oldPkmn.Gen == generation.Unknown {
+					failedCount.Add(1)
+					return nil
+				}
+
+				// Call the legality checker to get the latest info
+				result, err := legalityChecker.Check(ctx, oldPkmn.Base64, oldPkmn.Gen.Console())
 				if err != nil {
 					failedCount.Add(1)
 					return nil
 				}
 
+				checkedAt := time.Now()
 				oldPokemons[i].Legal = result.Legal
+				oldPokemons[i].Report = result.Report
+				oldPokemons[i].CheckedAt = &checkedAt
 				return nil
 			})
 
@@ -229,6 +264,19 @@ func MigrateOriginalDb(ctx context.Context, cfg *models.Config) {
 		return
 	}
 
+	// The old database never stopped duplicates, only the first copy of a pokemon gets the hash.
+	existingHashes, err := tx.Pokemon.Query().Where(pokemon.ContentHashNotNil()).Select(pokemon.FieldContentHash).Strings(ctx)
+	if err != nil {
+		logger.WithError(err).Error("failed to get the stored pokemon hashes")
+		tx.Rollback()
+		return
+	}
+
+	seenHashes := map[string]bool{}
+	for _, hash := range existingHashes {
+		seenHashes[hash] = true
+	}
+
 	logger.Info("Inserting pokemons to database, please wait...")
 	counter := 0
 	for i, oldPkmn := range oldPokemons {
@@ -242,13 +290,35 @@ func MigrateOriginalDb(ctx context.Context, cfg *models.Config) {
 			fmt.Printf("Created: %d/%d\r", i+1, len(oldPokemons))
 		}
 
-		newPkmn, err := tx.Pokemon.Create().
+		create := tx.Pokemon.Create().
 			SetUploadDatetime(oldPkmn.UploadDateTime).
 			SetDownloadCode(oldPkmn.DownloadCode).
 			SetDownloadCount(oldPkmn.DownloadCount).
 			SetGeneration(oldPkmn.Generation).
-			SetLegal(oldPkmn.Legal).
-			SetBase64(oldPkmn.Base64).Save(ctx)
+			SetLegal(oldPkmn.Legal)
+
+		// Pokemon that can't be decoded keep their base64 like the ones converted by database.Migrate do.
+		if raw, err := base64.StdEncoding.DecodeString(oldPkmn.Base64); err != nil {
+			logger.WithError(err).WithField("code", oldPkmn.DownloadCode).Warn("failed to decode pokemon")
+			create.SetBase64(oldPkmn.Base64)
+		} else {
+			data := database.StoredData(ctx, raw, oldPkmn.Gen)
+			create.SetData(data)
+
+			if hash := database.ContentHash(data); !seenHashes[hash] {
+				seenHashes[hash] = true
+				create.SetContentHash(hash)
+			}
+		}
+
+		// Only rechecked pokemon have a report, the old database never stored one.
+		if oldPkmn.CheckedAt != nil {
+			create.SetLegalityReport(oldPkmn.Report).
+				SetEngineVersion(engineVersion).
+				SetLegalityCheckedAt(*oldPkmn.CheckedAt)
+		}
+
+		newPkmn, err := create.Save(ctx)
 
 		if err != nil {
 			logger.WithError(err).Error("failed to save pokemon")
@@ -282,8 +352,8 @@ func MigrateOriginalDb(ctx context.Context, cfg *models.Config) {
 			SetUploadDatetime(ob.UploadDateTime).
 			SetDownloadCount(ob.DownloadCount).
 			SetLegal(ob.Legal).
-			SetMinGen(ob.MinGen).
-			SetMaxGen(ob.MaxGen).Save(ctx)
+			SetMinGen(parseGenNumber(ob.MinGen)).
+			SetMaxGen(parseGenNumber(ob.MaxGen)).Save(ctx)
 
 		if err != nil {
 			logger.WithError(err).Error("failed to save bundle")
@@ -299,7 +369,7 @@ func MigrateOriginalDb(ctx context.Context, cfg *models.Config) {
 	}
 	logger.Info("Finished inserting bundles to database.")
 
-	genMap := map[int][]string{}
+	genMap := map[int][]generation.Generation{}
 	logger.Info("Attaching pokemon to bundles, please wait...")
 	counter = 0
 	for i, ob := range oldPokemonBundles {
@@ -364,22 +434,28 @@ func MigrateOriginalDb(ctx context.Context, cfg *models.Config) {
 			}
 		}
 
-		_, err = tx.Pokemon.UpdateOne(p2).AddBundleIDs(b.ID).Save(ctx)
+		_, err = tx.BundleSlot.Create().
+			SetBundleID(b.ID).
+			SetPokemonID(p2.ID).
+			SetPosition(len(genMap[b.ID])).
+			SetGeneration(p2.Generation).
+			Save(ctx)
 		if err != nil {
-			logger.WithError(err).Error("failed to save pokemon")
+			logger.WithError(err).Error("failed to save bundle slot")
 			tx.Rollback()
 			return
 		}
 
-		genMap[b.ID] = append(genMap[b.ID], p2.Generation)
+		gen, _ := generation.Parse(p2.Generation)
+		genMap[b.ID] = append(genMap[b.ID], gen)
 	}
 	logger.Info("Finished attaching pokemon to bundles.")
 
 	logger.Info("Correcting bundle generations, please wait...")
 	for k, g := range genMap {
-		slices.Sort(g)
+		slices.SortFunc(g, generation.Compare)
 
-		_, err = tx.Bundle.Update().SetMinGen(g[0]).SetMaxGen(g[len(g)-1]).Where(bundle.ID(k)).Save(ctx)
+		_, err = tx.Bundle.Update().SetMinGen(g[0].Number()).SetMaxGen(g[len(g)-1].Number()).Where(bundle.ID(k)).Save(ctx)
 		if err != nil {
 			logger.WithError(err).Error("failed to correct bundle info")
 			tx.Rollback()
@@ -2,100 +2,119 @@ package utils
 
 import (
 	"bytes"
-	"context"
 	"encoding/base64"
-	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
-	"os"
-	"os/exec"
-	"runtime"
+	"strconv"
 	"strings"
 
+	"github.com/FlagBrew/local-gpss/internal/console"
+	"github.com/FlagBrew/local-gpss/internal/generation"
 	"github.com/FlagBrew/local-gpss/internal/models"
-	"github.com/apex/log"
+	"github.com/lrstanley/chix"
 )
 
-func ExecGpssConsole[T any](ctx context.Context, args models.GpssConsoleArgs) (*T, error) {
-	logger := log.FromContext(ctx)
-	var path string
-
-	switch runtime.GOOS {
-	case "darwin":
-		path = "./bin/GpssConsole"
-	case "linux":
-		path = "./bin/GpssConsole"
-	case "windows":
-		path = "./bin/GpssConsole.exe"
-	default:
-		logger.WithField("platform", runtime.GOOS).Error("unsupported platform")
+func PrepareCall(r *http.Request, mode string) (*models.GpssConsoleArgs, int, error) {
+	if r.Header.Get("generation") == "" {
+		return nil, http.StatusBadRequest, fmt.Errorf("generation header is required")
 	}
 
-	// Make sure it exists
-	if _, err := os.Stat(path); err != nil {
-		logger.WithField("path", path).Error("GPSS Console binary is missing from disk, please make sure you grab it from the latest release")
-		return nil, fmt.Errorf("GPSS Console binary is missing from disk")
+	gen, err := generation.Parse(r.Header.Get("generation"))
+	if err != nil {
+		return nil, http.StatusBadRequest, err
 	}
 
-	cmd := exec.CommandContext(ctx, path, "--mode", args.Mode, "--pokemon", args.Pokemon, "--generation", args.Generation, "--ver", args.Version)
+	version := r.Header.Get("version")
+	if version == "" && mode == "legalize" {
+		return nil, http.StatusBadRequest, fmt.Errorf("version header is required")
+	}
 
-	output, err := cmd.Output()
-	if err != nil {
-		logger.WithError(err).Error("GPSS Console command failed")
-		return nil, err
+	args := models.GpssConsoleArgs{
+		Version:    version,
+		Generation: gen.Console(),
+		Mode:       mode,
 	}
 
-	if strings.Contains(string(output), "\"error\"") {
-		return nil, fmt.Errorf("GPSS Console returned an error")
+	err = r.ParseMultipartForm(2 * 1024 * 1024)
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("failed to parse form")
 	}
 
-	var t T
-	if err := json.Unmarshal(output, &t); err != nil {
-		return nil, err
+	args.Pokemon, err = readPokemonFile(r, "pkmn")
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
 	}
-	return &t, nil
+
+	return &args, http.StatusOK, nil
 }
 
-func PrepareCall(r *http.Request, mode string) (*models.GpssConsoleArgs, int, error) {
-	generation := r.Header.Get("generation")
-	if generation == "" {
-		return nil, http.StatusBadRequest, fmt.Errorf("version header is required")
+// PrepareBatchCall reads the pokemon for a batch call, it follows the same layout as a bundle upload:
+// a count header, a comma separated generations header and the pokemon as pkmn1 through pkmnN.
+func PrepareBatchCall(r *http.Request, mode string, maxCount int) ([]models.GpssConsoleArgs, int, error) {
+	count, err := strconv.Atoi(r.Header.Get("count"))
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("missing or invalid count header")
 	}
 
-	version := r.Header.Get("version")
-	if version == "" && mode == "legalize" {
-		return nil, http.StatusBadRequest, fmt.Errorf("version header is required")
+	if count < 1 || count > maxCount {
+		return nil, http.StatusBadRequest, fmt.Errorf("count must be between 1 and %d", maxCount)
 	}
 
-	args := models.GpssConsoleArgs{
-		Version:    version,
-		Generation: generation,
-		Mode:       mode,
+	generations := strings.Split(r.Header.Get("generations"), ",")
+	if len(generations) != count {
+		return nil, http.StatusBadRequest, fmt.Errorf("missing generations header or invalid amount")
 	}
 
-	err := r.ParseMultipartForm(2 * 1024 * 1024)
-	if err != nil {
+	if err = r.ParseMultipartForm(int64(count) * 2 * 1024 * 1024); err != nil {
 		return nil, http.StatusBadRequest, fmt.Errorf("failed to parse form")
 	}
 
-	pkmn, _, err := r.FormFile("pkmn")
+	batch := make([]models.GpssConsoleArgs, count)
+	for i := range batch {
+		gen, err := generation.Parse(generations[i])
+		if err != nil {
+			return nil, http.StatusBadRequest, fmt.Errorf("pkmn%d: %w", i+1, err)
+		}
+
+		batch[i] = models.GpssConsoleArgs{
+			Mode:       mode,
+			Generation: gen.Console(),
+		}
+
+		batch[i].Pokemon, err = readPokemonFile(r, fmt.Sprintf("pkmn%d", i+1))
+		if err != nil {
+			return nil, http.StatusBadRequest, err
+		}
+	}
+
+	return batch, http.StatusOK, nil
+}
+
+// readPokemonFile reads the form file and returns it base64 encoded.
+func readPokemonFile(r *http.Request, name string) (string, error) {
+	pkmn, _, err := r.FormFile(name)
 	if err != nil {
-		return nil, http.StatusInternalServerError, fmt.Errorf("error reading pkmn file: %w", err)
+		return "", fmt.Errorf("error reading %s file: %w", name, err)
 	}
 
 	defer pkmn.Close()
 
-	// Base64 encode the pokemon
 	var buf bytes.Buffer
 	if _, err := io.Copy(&buf, pkmn); err != nil {
-		return nil, http.StatusInternalServerError, fmt.Errorf("error reading pkmn file from body: %w", err)
+		return "", fmt.Errorf("error reading %s file from body: %w", name, err)
 	}
 
-	pkmn.Close()
-	b64Str := base64.StdEncoding.EncodeToString(buf.Bytes())
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
 
-	args.Pokemon = b64Str
+// WriteLegalityError replies with the status code and machine-readable code matching an error from a legality checker.
+func WriteLegalityError(w http.ResponseWriter, r *http.Request, err error) {
+	consoleErr := console.AsError(err)
+	if consoleErr.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(consoleErr.RetryAfter.Seconds()))))
+	}
 
-	return &args, http.StatusOK, nil
+	chix.JSON(w, r, consoleErr.StatusCode(), chix.M{"error": consoleErr.Error(), "code": consoleErr.Code})
 }
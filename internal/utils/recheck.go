@@ -0,0 +1,201 @@
+package utils
+
+import (
+	"context"
+	"encoding/base64"
+	"sync/atomic"
+	"time"
+
+	"github.com/FlagBrew/local-gpss/internal/checker"
+	"github.com/FlagBrew/local-gpss/internal/database/ent"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundleslot"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/predicate"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/recheckjob"
+	"github.com/FlagBrew/local-gpss/internal/generation"
+	"github.com/FlagBrew/local-gpss/internal/models"
+	"github.com/apex/log"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	recheckBatchSize          = 100
+	defaultRecheckConcurrency = 4
+)
+
+// RecheckLegality re-runs the legality checks of every stored Pokémon when the engine version
+// differs from the one that last checked them. Progress is stored in the database after every batch,
+// so the job continues where it left off if Local GPSS is restarted.
+func RecheckLegality(ctx context.Context, cfg *models.Config, legalityChecker checker.LegalityChecker) {
+	logger := log.FromContext(ctx)
+	db := ent.FromContext(ctx)
+	if db == nil {
+		logger.Error("DB missing from context")
+		return
+	}
+
+	version, err := legalityChecker.Version(ctx)
+	if err != nil {
+		logger.WithError(err).Warn("failed to get the engine version, skipping the legality recheck")
+		return
+	}
+	engineVersion := version.String()
+
+	job, err := db.RecheckJob.Query().Where(recheckjob.EngineVersion(engineVersion)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		logger.WithError(err).Error("failed to read the legality recheck status")
+		return
+	}
+
+	if job != nil && job.Status == recheckjob.StatusDone {
+		return
+	}
+
+	// Anything checked by another engine version (or never checked at all) is out of date.
+	stale := pokemon.Or(pokemon.EngineVersionNEQ(engineVersion), pokemon.EngineVersionIsNil())
+
+	if job == nil {
+		// Only start a recheck when the engine actually changed, a database that has never been checked
+		// by any version (e.g. an import without a recheck) is left alone.
+		changed, err := db.Pokemon.Query().Where(pokemon.EngineVersionNEQ(engineVersion), pokemon.EngineVersionNEQ("")).Exist(ctx)
+		if err != nil {
+			logger.WithError(err).Error("failed to check for pokemon checked by an older engine")
+			return
+		}
+
+		create := db.RecheckJob.Create().
+			SetEngineVersion(engineVersion).
+			SetStartedAt(time.Now())
+
+		if changed {
+			total, err := db.Pokemon.Query().Where(stale).Count(ctx)
+			if err != nil {
+				logger.WithError(err).Error("failed to count pokemon to recheck")
+				return
+			}
+
+			create.SetStatus(recheckjob.StatusRunning).SetTotal(total)
+		} else {
+			create.SetStatus(recheckjob.StatusDone).SetFinishedAt(time.Now())
+		}
+
+		job, err = create.Save(ctx)
+		if err != nil {
+			logger.WithError(err).Error("failed to create the legality recheck job")
+			return
+		}
+
+		if !changed {
+			return
+		}
+
+		logger.WithFields(log.Fields{
+			"engine_version": engineVersion,
+			"total":          job.Total,
+		}).Info("engine version changed, rechecking the legality of all pokemon in the background")
+	} else {
+		logger.WithFields(log.Fields{
+			"engine_version": engineVersion,
+			"checked":        job.Checked,
+			"total":          job.Total,
+		}).Info("resuming the legality recheck")
+	}
+
+	concurrency := cfg.Legality.RecheckConcurrency
+	if concurrency <= 0 {
+		concurrency = defaultRecheckConcurrency
+	}
+
+	for {
+		mons, err := db.Pokemon.Query().
+			Where(pokemon.IDGT(job.LastPokemonID), stale).
+			Order(pokemon.ByID()).
+			Limit(recheckBatchSize).
+			All(ctx)
+		if err != nil {
+			logger.WithError(err).Error("failed to load pokemon to recheck, the recheck will resume on the next start")
+			return
+		}
+
+		if len(mons) == 0 {
+			break
+		}
+
+		failedCount := atomic.Int64{}
+		eg, egCtx := errgroup.WithContext(ctx)
+		eg.SetLimit(concurrency)
+		for _, mon := range mons {
+			eg.Go(func() error {
+				result, err := recheckPokemon(egCtx, legalityChecker, mon)
+				if err != nil {
+					failedCount.Add(1)
+					return nil
+				}
+
+				return db.Pokemon.UpdateOne(mon).
+					SetLegal(result.Legal).
+					SetLegalityReport(result.Report).
+					SetEngineVersion(engineVersion).
+					SetLegalityCheckedAt(time.Now()).
+					Exec(egCtx)
+			})
+		}
+
+		if err = eg.Wait(); err != nil {
+			logger.WithError(err).Error("failed to save rechecked pokemon, the recheck will resume on the next start")
+			return
+		}
+
+		// Shutting down, what we have so far is saved and the rest will be done on the next start.
+		if ctx.Err() != nil {
+			return
+		}
+
+		job, err = job.Update().
+			SetLastPokemonID(mons[len(mons)-1].ID).
+			AddChecked(len(mons) - int(failedCount.Load())).
+			AddFailed(int(failedCount.Load())).
+			Save(ctx)
+		if err != nil {
+			logger.WithError(err).Error("failed to save the legality recheck progress")
+			return
+		}
+
+		logger.Infof("Rechecked: %d/%d, failed: %d", job.Checked+job.Failed, job.Total, job.Failed)
+	}
+
+	// Now the pokemon are up-to-date, the bundles need to follow. A bundle without any slots left has
+	// nothing to vouch for it, so it isn't legal either.
+	hasIllegal := bundle.Or(bundle.HasSlotsWith(bundleslot.HasPokemonWith(pokemon.Legal(false))), bundle.Not(bundle.HasSlots()))
+	for legal, where := range map[bool]predicate.Bundle{false: hasIllegal, true: bundle.Not(hasIllegal)} {
+		if _, err = db.Bundle.Update().Where(where).SetLegal(legal).Save(ctx); err != nil {
+			logger.WithError(err).Error("failed to update bundle legality, the recheck will resume on the next start")
+			return
+		}
+	}
+
+	job, err = job.Update().SetStatus(recheckjob.StatusDone).SetFinishedAt(time.Now()).Save(ctx)
+	if err != nil {
+		logger.WithError(err).Error("failed to mark the legality recheck as done")
+		return
+	}
+
+	logger.WithFields(log.Fields{
+		"checked": job.Checked,
+		"failed":  job.Failed,
+	}).Info("legality recheck complete")
+}
+
+// recheckPokemon checks the pokemon, waiting for a turn when the legality queue is full as the
+// background job is in no rush and shouldn't count busy as a failure.
+func recheckPokemon(ctx context.Context, legalityChecker checker.LegalityChecker, mon *ent.Pokemon) (*models.GpssLegalityCheckReply, error) {
+	gen, err := generation.Parse(mon.Generation)
+	if err != nil {
+		return nil, err
+	}
+
+	return checker.WaitForTurn(ctx, func() (*models.GpssLegalityCheckReply, error) {
+		return legalityChecker.Check(ctx, base64.StdEncoding.EncodeToString(mon.Data), gen.Console())
+	})
+}
@@ -1,9 +1,12 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/FlagBrew/local-gpss/internal/checker"
+	"github.com/FlagBrew/local-gpss/internal/console"
 	"github.com/FlagBrew/local-gpss/internal/database/ent"
 	"github.com/FlagBrew/local-gpss/internal/gui"
 	"github.com/FlagBrew/local-gpss/internal/models"
@@ -20,9 +23,17 @@ var (
 	db     *ent.Client
 	cfg    *models.Config
 	app    *gui.Gui
+	pool   *console.Pool
+	cancel context.CancelFunc
+
+	legalityChecker checker.LegalityChecker
 )
 
 func exit() {
+	if pool != nil {
+		pool.Close()
+	}
+
 	if db != nil {
 		db.Close()
 	}
@@ -30,11 +41,39 @@ func exit() {
 	if app != nil && app.IsRunning() {
 		app.Stop()
 	}
+
+	if cancel != nil {
+		cancel()
+	}
 }
 
 func main() {
 	ctx := setup()
 
+	if cli.Flags.Migrate != "" {
+		if err := runMigrateCommand(ctx); err != nil {
+			logger.WithError(err).Error("failed to run the migration command")
+		}
+		exit()
+		return
+	}
+
+	if cli.Flags.BackfillMetadata {
+		if err := utils.BackfillMetadata(ctx); err != nil {
+			logger.WithError(err).Error("failed to backfill pokemon metadata")
+		}
+		exit()
+		return
+	}
+
+	if cli.Flags.SetVisibility != "" {
+		if err := runVisibilityCommand(ctx); err != nil {
+			logger.WithError(err).Error("failed to change the visibility")
+		}
+		exit()
+		return
+	}
+
 	logger.Infof("Starting HTTP server on %s:%d", cfg.HTTP.ListeningAddr, cfg.HTTP.Port)
 	if err := chix.RunContext(ctx, httpServer(ctx)); err != nil {
 		exit()
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/FlagBrew/local-gpss/internal/database"
+	"github.com/FlagBrew/local-gpss/internal/models"
+)
+
+// devDatabase is the empty database migrations are replayed on by --migrate diff when none is given.
+const devDatabase = "file:dev?mode=memory&cache=shared&_pragma=foreign_keys(1)"
+
+func runMigrateCommand(ctx context.Context) error {
+	switch cli.Flags.Migrate {
+	case "status":
+		migrations, err := database.MigrationStatus(ctx, &cfg.Database)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tDESCRIPTION\tSTATUS\tDESTRUCTIVE")
+		for _, m := range migrations {
+			status := "pending"
+			switch {
+			case m.Error != "":
+				status = "failed: " + m.Error
+			case m.Baseline:
+				status = "baseline"
+			case m.Applied:
+				status = "applied " + m.ExecutedAt.Local().Format("2006-01-02 15:04:05")
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", m.Version, m.Description, status, strings.Join(m.Destructive, ", "))
+		}
+		return w.Flush()
+	case "up":
+		database.Migrate(ctx, &cfg.Database, cli.Flags.ApproveDestructive)
+		return nil
+	case "diff":
+		if len(cli.Args) == 0 {
+			return fmt.Errorf("the name of the migration is required, e.g. --migrate diff add_bundle_titles")
+		}
+
+		dev := models.DatabaseConfig{DBType: cfg.Database.DBType, ConnectionString: cli.Flags.DevDatabase}
+		if dev.ConnectionString == "" {
+			if dev.DBType != "sqlite" {
+				return fmt.Errorf("--dev-database is required for %s", dev.DBType)
+			}
+			dev.ConnectionString = devDatabase
+		}
+
+		path, err := database.Diff(ctx, &dev, cli.Args[0])
+		if err != nil {
+			return err
+		}
+
+		if path == "" {
+			logger.Info("the migrations are up to date with the schema")
+			return nil
+		}
+
+		logger.WithField("path", path).Info("wrote migration, rebuild to include it")
+	}
+
+	return nil
+}
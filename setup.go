@@ -3,6 +3,8 @@ package main
 import (
 	"context"
 
+	"github.com/FlagBrew/local-gpss/internal/checker"
+	"github.com/FlagBrew/local-gpss/internal/console"
 	"github.com/FlagBrew/local-gpss/internal/database"
 	"github.com/FlagBrew/local-gpss/internal/database/ent"
 	"github.com/FlagBrew/local-gpss/internal/gui"
@@ -14,10 +16,13 @@ func setup() context.Context {
 	cli.Parse()
 	logger = cli.Logger
 
-	ctx, cancel := context.WithCancel(context.Background())
+	var ctx context.Context
+	ctx, cancel = context.WithCancel(context.Background())
 	ctx = log.NewContext(ctx, logger)
 	cfg = utils.Setup(ctx, cli.Flags.Mode)
-	if cfg.FancyScreen {
+	// Commands run once and exit, there is no need for the fancy screen.
+	oneShot := cli.Flags.BackfillMetadata || cli.Flags.Migrate != "" || cli.Flags.SetVisibility != ""
+	if cfg.FancyScreen && !oneShot {
 		app = gui.New(cfg, false)
 		cli.Logger = utils.NewLogger(log.InfoLevel, cli.Debug, app.GetLogOutput())
 		logger = cli.Logger
@@ -28,16 +33,51 @@ func setup() context.Context {
 		}()
 	}
 
+	// The migration commands only need the database, which they manage the schema of themselves.
+	if cli.Flags.Migrate != "" {
+		db = database.New(ctx, &cfg.Database)
+		return ent.NewContext(ctx, db)
+	}
+
 	db = database.New(ctx, &cfg.Database)
 	ctx = ent.NewContext(ctx, db)
 
-	database.Migrate(ctx)
+	database.Migrate(ctx, &cfg.Database, cli.Flags.ApproveDestructive)
+
+	// The other commands don't check legality, the workers and background jobs would only get in their
+	// way (and hold up the database while they're at it).
+	if oneShot {
+		return ctx
+	}
+
+	// Only the subprocess engine needs GpssConsole workers
+	if cfg.Legality.Engine == "" || cfg.Legality.Engine == "subprocess" {
+		pool = console.NewPool(ctx, &cfg.GpssConsole)
+		pool.Start()
+
+		go func() {
+			status := console.Diagnose(ctx, pool)
+			if app != nil {
+				app.SetConsoleStatus(status)
+			}
+		}()
+	}
+
+	var err error
+	legalityChecker, err = checker.New(cfg, pool, db)
+	if err != nil {
+		logger.WithError(err).Fatal("failed to set up the legality checker")
+	}
 
 	if cfg.Misc.MigrateOriginalDb {
-		utils.MigrateOriginalDb(ctx, cfg)
+		utils.MigrateOriginalDb(ctx, cfg, legalityChecker)
+	}
+
+	if !cfg.Legality.DisableRecheck {
+		go utils.RecheckLegality(ctx, cfg, legalityChecker)
 	}
 
-	if cfg.FancyScreen {
+	if app != nil {
 		app.SetDb(db)
 	}
 
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/FlagBrew/local-gpss/internal/database"
+	"github.com/FlagBrew/local-gpss/internal/database/ent"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/bundle"
+	"github.com/FlagBrew/local-gpss/internal/database/ent/pokemon"
+)
+
+// runVisibilityCommand changes the visibility of every download code given, the ones that fail are
+// logged and skipped.
+func runVisibilityCommand(ctx context.Context) error {
+	if len(cli.Args) < 2 {
+		return errors.New("the entity type and at least one download code are required, e.g. --set-visibility hidden pokemon 1234567890")
+	}
+
+	entityType, codes := cli.Args[0], cli.Args[1:]
+	if entityType != "pokemon" && entityType != "bundle" {
+		return fmt.Errorf("unknown entity type %q, it must be pokemon or bundle", entityType)
+	}
+
+	var failed int
+	for _, code := range codes {
+		codeLogger := logger.WithField("code", code)
+
+		var err error
+		if entityType == "pokemon" {
+			var hidden int
+			hidden, err = database.SetPokemonVisibility(ctx, db, code, pokemon.Visibility(cli.Flags.SetVisibility), cli.Flags.Reason)
+			if hidden > 0 {
+				codeLogger = codeLogger.WithField("hidden_bundles", hidden)
+			}
+		} else {
+			err = database.SetBundleVisibility(ctx, db, code, bundle.Visibility(cli.Flags.SetVisibility), cli.Flags.Reason)
+		}
+
+		switch {
+		case ent.IsNotFound(err):
+			failed++
+			codeLogger.Errorf("%s not found", entityType)
+		case err != nil:
+			failed++
+			codeLogger.WithError(err).Errorf("failed to change the visibility of the %s", entityType)
+		default:
+			codeLogger.Infof("%s is now %s", entityType, cli.Flags.SetVisibility)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to change the visibility of %d of the %d download codes", failed, len(codes))
+	}
+
+	return nil
+}